@@ -85,6 +85,7 @@ const PreviewAction: React.FunctionComponent<React.PropsWithChildren<PreviewActi
         case ChangesetSpecOperation.UPDATE:
             return <PreviewActionUpdate className={className} />
         case ChangesetSpecOperation.PUSH:
+        case ChangesetSpecOperation.REBASE:
             return <PreviewActionPush className={className} />
         case ChangesetSpecOperation.DETACH:
             return <PreviewActionDetach className={className} />
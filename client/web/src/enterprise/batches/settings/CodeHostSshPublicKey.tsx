@@ -18,15 +18,16 @@ const configInstructionLinks: Record<ExternalServiceKind, string> = {
     [ExternalServiceKind.AZUREDEVOPS]: 'unsupported',
     [ExternalServiceKind.BITBUCKETCLOUD]: 'unsupported',
     [ExternalServiceKind.GERRIT]: 'unsupported',
-    [ExternalServiceKind.GITOLITE]: 'unsupported',
+    [ExternalServiceKind.GITEA]: 'https://docs.gitea.com/usage/authentication',
+    [ExternalServiceKind.GITOLITE]: 'https://gitolite.com/gitolite/basic-admin.html#addremove-users',
     [ExternalServiceKind.GOMODULES]: 'unsupported',
     [ExternalServiceKind.JVMPACKAGES]: 'unsupported',
     [ExternalServiceKind.NPMPACKAGES]: 'unsupported',
     [ExternalServiceKind.OTHER]: 'unsupported',
     [ExternalServiceKind.LOCALGIT]: 'unsupported',
     [ExternalServiceKind.PERFORCE]: 'unsupported',
-    [ExternalServiceKind.PAGURE]: 'unsupported',
-    [ExternalServiceKind.PHABRICATOR]: 'unsupported',
+    [ExternalServiceKind.PAGURE]: 'https://docs.pagure.org/pagure/usage/first_steps.html',
+    [ExternalServiceKind.PHABRICATOR]: 'https://secure.phabricator.com/book/phabricator/article/diffusion_hosting/',
     [ExternalServiceKind.PYTHONPACKAGES]: 'unsupported',
     [ExternalServiceKind.RUSTPACKAGES]: 'unsupported',
     [ExternalServiceKind.RUBYPACKAGES]: 'unsupported',
@@ -66,6 +66,8 @@ export const getCodeHostName = (codeHostType: ExternalServiceKind | null): strin
             return 'Gitolite'
         case ExternalServiceKind.GERRIT:
             return 'Gerrit'
+        case ExternalServiceKind.GITEA:
+            return 'Gitea'
         case ExternalServiceKind.AZUREDEVOPS:
             return 'Azure DevOps'
         default:
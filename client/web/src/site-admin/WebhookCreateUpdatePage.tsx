@@ -297,6 +297,8 @@ function supportedExternalServiceKind(kind: ExternalServiceKind): boolean {
             return true
         case ExternalServiceKind.AZUREDEVOPS:
             return true
+        case ExternalServiceKind.GITEA:
+            return true
         default:
             return false
     }
@@ -221,7 +221,17 @@ type ReenqueueChangesetsArgs struct {
 
 type MergeChangesetsArgs struct {
 	BulkOperationBaseArgs
-	Squash bool
+	Squash  bool
+	Rollout *MergeRolloutInput
+}
+
+type MergeRolloutInput struct {
+	Waves           []int32
+	MaxFailedChecks int32
+}
+
+type ResumeMergeRolloutArgs struct {
+	BulkOperation graphql.ID
 }
 
 type CloseChangesetsArgs struct {
@@ -282,6 +292,7 @@ type BatchChangesResolver interface {
 	CreateChangesetComments(ctx context.Context, args *CreateChangesetCommentsArgs) (BulkOperationResolver, error)
 	ReenqueueChangesets(ctx context.Context, args *ReenqueueChangesetsArgs) (BulkOperationResolver, error)
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
+	ResumeMergeRollout(ctx context.Context, args *ResumeMergeRolloutArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
 
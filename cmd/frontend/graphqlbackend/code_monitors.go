@@ -51,6 +51,7 @@ type MonitorTrigger interface {
 type MonitorQueryResolver interface {
 	ID() graphql.ID
 	Query() string
+	APIChangesOnly() bool
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
 }
 
@@ -159,7 +160,8 @@ type CreateCodeMonitorArgs struct {
 }
 
 type CreateTriggerArgs struct {
-	Query string
+	Query          string
+	APIChangesOnly bool
 }
 
 type CreateActionArgs struct {
@@ -21,17 +21,19 @@ type ContextResultResolver interface {
 	ToFileChunkContext() (*FileChunkContextResolver, bool)
 }
 
-func NewFileChunkContextResolver(gitTreeEntryResolver *GitTreeEntryResolver, startLine, endLine int) *FileChunkContextResolver {
+func NewFileChunkContextResolver(gitTreeEntryResolver *GitTreeEntryResolver, startLine, endLine int, symbol string) *FileChunkContextResolver {
 	return &FileChunkContextResolver{
 		treeEntry: gitTreeEntryResolver,
 		startLine: int32(startLine),
 		endLine:   int32(endLine),
+		symbol:    symbol,
 	}
 }
 
 type FileChunkContextResolver struct {
 	treeEntry          *GitTreeEntryResolver
 	startLine, endLine int32
+	symbol             string
 	debugSource        string
 }
 
@@ -40,6 +42,13 @@ var _ ContextResultResolver = (*FileChunkContextResolver)(nil)
 func (f *FileChunkContextResolver) Blob() *GitTreeEntryResolver { return f.treeEntry }
 func (f *FileChunkContextResolver) StartLine() int32            { return f.startLine }
 func (f *FileChunkContextResolver) EndLine() int32              { return f.endLine }
+
+func (f *FileChunkContextResolver) Symbol() *string {
+	if f.symbol == "" {
+		return nil
+	}
+	return &f.symbol
+}
 func (f *FileChunkContextResolver) ToFileChunkContext() (*FileChunkContextResolver, bool) {
 	return f, true
 }
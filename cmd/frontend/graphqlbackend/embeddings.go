@@ -53,6 +53,7 @@ type EmbeddingsSearchResultResolver interface {
 	FileName(ctx context.Context) string
 	StartLine(ctx context.Context) int32
 	EndLine(ctx context.Context) int32
+	Symbol(ctx context.Context) *string
 	Content(ctx context.Context) string
 }
 
@@ -279,17 +279,21 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 			GitLabSyncWebhook:               enterprise.ReposGitLabWebhook,
 			BitbucketServerSyncWebhook:      enterprise.ReposBitbucketServerWebhook,
 			BitbucketCloudSyncWebhook:       enterprise.ReposBitbucketCloudWebhook,
+			GiteaSyncWebhook:                enterprise.ReposGiteaWebhook,
 			PermissionsGitHubWebhook:        enterprise.PermissionsGitHubWebhook,
 			BatchesGitHubWebhook:            enterprise.BatchesGitHubWebhook,
 			BatchesGitLabWebhook:            enterprise.BatchesGitLabWebhook,
 			BatchesBitbucketServerWebhook:   enterprise.BatchesBitbucketServerWebhook,
 			BatchesBitbucketCloudWebhook:    enterprise.BatchesBitbucketCloudWebhook,
 			BatchesAzureDevOpsWebhook:       enterprise.BatchesAzureDevOpsWebhook,
+			BatchesGiteaWebhook:             enterprise.BatchesGiteaWebhook,
 			BatchesChangesFileGetHandler:    enterprise.BatchesChangesFileGetHandler,
 			BatchesChangesFileExistsHandler: enterprise.BatchesChangesFileExistsHandler,
 			BatchesChangesFileUploadHandler: enterprise.BatchesChangesFileUploadHandler,
+			BatchesChangesPatchHandler:      enterprise.BatchesChangesPatchHandler,
 			SCIMHandler:                     enterprise.SCIMHandler,
 			NewCodeIntelUploadHandler:       enterprise.NewCodeIntelUploadHandler,
+			CodeIntelSBOMHandler:            enterprise.CodeIntelSBOMHandler,
 			NewComputeStreamHandler:         enterprise.NewComputeStreamHandler,
 			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
 			NewDotcomLicenseCheckHandler:    enterprise.NewDotcomLicenseCheckHandler,
@@ -48,6 +48,7 @@ type Handlers struct {
 	GitLabSyncWebhook          webhooks.Registerer
 	BitbucketServerSyncWebhook webhooks.Registerer
 	BitbucketCloudSyncWebhook  webhooks.Registerer
+	GiteaSyncWebhook           webhooks.Registerer
 
 	// Permissions
 	PermissionsGitHubWebhook webhooks.Registerer
@@ -58,15 +59,18 @@ type Handlers struct {
 	BatchesBitbucketServerWebhook   webhooks.RegistererHandler
 	BatchesBitbucketCloudWebhook    webhooks.RegistererHandler
 	BatchesAzureDevOpsWebhook       webhooks.Registerer
+	BatchesGiteaWebhook             webhooks.Registerer
 	BatchesChangesFileGetHandler    http.Handler
 	BatchesChangesFileExistsHandler http.Handler
 	BatchesChangesFileUploadHandler http.Handler
+	BatchesChangesPatchHandler      http.Handler
 
 	// SCIM
 	SCIMHandler http.Handler
 
 	// Code intel
 	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	CodeIntelSBOMHandler      http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
@@ -134,6 +138,8 @@ func NewHandler(
 	handlers.GitLabSyncWebhook.Register(&wh)
 	handlers.PermissionsGitHubWebhook.Register(&wh)
 	handlers.BatchesAzureDevOpsWebhook.Register(&wh)
+	handlers.GiteaSyncWebhook.Register(&wh)
+	handlers.BatchesGiteaWebhook.Register(&wh)
 	// 🚨 SECURITY: This handler implements its own secret-based auth
 	webhookHandler := webhooks.NewHandler(logger, db, &wh)
 
@@ -151,9 +157,11 @@ func NewHandler(
 	m.Get(apirouter.BatchesFileGet).Handler(trace.Route(handlers.BatchesChangesFileGetHandler))
 	m.Get(apirouter.BatchesFileExists).Handler(trace.Route(handlers.BatchesChangesFileExistsHandler))
 	m.Get(apirouter.BatchesFileUpload).Handler(trace.Route(handlers.BatchesChangesFileUploadHandler))
+	m.Get(apirouter.BatchesChangesetPatch).Handler(trace.Route(handlers.BatchesChangesPatchHandler))
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.CodeIntelSBOM).Handler(trace.Route(handlers.CodeIntelSBOMHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.ChatCompletionsStream).Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Get(apirouter.CodeCompletions).Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
@@ -13,6 +13,7 @@ const (
 	LSIFUpload       = "lsif.upload"
 	SCIPUpload       = "scip.upload"
 	SCIPUploadExists = "scip.upload.exists"
+	CodeIntelSBOM    = "codeintel.sbom"
 
 	SearchStream          = "search.stream"
 	ComputeStream         = "compute.stream"
@@ -41,6 +42,8 @@ const (
 	BatchesFileExists = "batches.file.exists"
 	BatchesFileUpload = "batches.file.upload"
 
+	BatchesChangesetPatch = "batches.changeset.patch"
+
 	CodeInsightsDataExport = "insights.data.export"
 
 	ExternalURL            = "internal.app-url"
@@ -77,9 +80,11 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("GET").Name(BatchesFileGet)
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("HEAD").Name(BatchesFileExists)
 	base.Path("/files/batch-changes/{spec}").Methods("POST").Name(BatchesFileUpload)
+	base.Path("/batches/changesets/{changeset}/patch").Methods("GET").Name(BatchesChangesetPatch)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
+	base.Path("/codeintel/sbom").Methods("GET").Name(CodeIntelSBOM)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
 	base.Path("/compute/stream").Methods("GET", "POST").Name(ComputeStream)
 	base.Path("/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/stream/{Path:.*}").Methods("GET").Name(GitBlameStream)
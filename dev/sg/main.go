@@ -271,6 +271,7 @@ var sg = &cli.App{
 		telemetryCommand,
 		monitoringCommand,
 		contextCommand,
+		codyContextCommand,
 
 		// Dev environment
 		secretCommand,
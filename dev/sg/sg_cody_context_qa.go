@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/embeddings/qa"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var codyContextCommand = &cli.Command{
+	Name:        "cody-context-qa",
+	Usage:       "Calculate recall and MRR for Cody context retrieval",
+	Description: "Records the embeddings search and keyword search results for the labelled queries of the embeddings QA test data, then reports the recall and mean reciprocal rank of the top k results of each retriever and of their hybrid fusion. The command requires a running embeddings service and a Sourcegraph instance with embeddings and an index of the Sourcegraph repository. Pass --from to evaluate a previous recording instead.",
+	Category:    CategoryDev,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "embeddings-url",
+			Value: "http://localhost:9991/search",
+			Usage: "Record embeddings results from this endpoint",
+		},
+		&cli.StringFlag{
+			Name:  "sourcegraph-url",
+			Value: "https://sourcegraph.test:3443",
+			Usage: "Record keyword results from this Sourcegraph instance",
+		},
+		&cli.StringFlag{
+			Name:    "token",
+			EnvVars: []string{"SRC_ACCESS_TOKEN"},
+			Usage:   "Access token for the Sourcegraph instance",
+		},
+		&cli.IntFlag{
+			Name:  "k",
+			Value: 10,
+			Usage: "Evaluate the top k results of each retriever",
+		},
+		&cli.StringFlag{
+			Name:  "record",
+			Usage: "Write the recorded results to this file",
+		},
+		&cli.StringFlag{
+			Name:  "from",
+			Usage: "Evaluate the results recorded in this file instead of querying the services",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		k := ctx.Int("k")
+		if k <= 0 {
+			return errors.New("k must be positive")
+		}
+
+		if from := ctx.String("from"); from != "" {
+			queries, err := qa.LoadContext(from)
+			if err != nil {
+				return err
+			}
+			qa.EvaluateContext(queries, k)
+			return nil
+		}
+
+		// Each retriever contributes up to twice the evaluated results to the
+		// fusion, as it does when serving Cody context.
+		queries, err := qa.RecordContext(
+			qa.NewClient(ctx.String("embeddings-url")),
+			qa.NewSearchClient(ctx.String("sourcegraph-url"), ctx.String("token")),
+			2*k,
+		)
+		if err != nil {
+			return err
+		}
+
+		if record := ctx.String("record"); record != "" {
+			b, err := json.MarshalIndent(queries, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(record, b, 0644); err != nil {
+				return errors.Wrap(err, "failed to write recorded results")
+			}
+		}
+
+		qa.EvaluateContext(queries, k)
+		return nil
+	},
+}
@@ -96,7 +96,15 @@ func Post(
 
 	// Render and evaluate outputs.
 	step := executionInput.Steps[stepIdx]
-	if err = batcheslib.SetOutputs(step.Outputs, outputs, &stepContext); err != nil {
+	if step.HasForeach() {
+		stepContexts, err := getForeachStepContexts(workingDirectory, stepIdx, stepContext)
+		if err != nil {
+			return err
+		}
+		if err = batcheslib.SetForeachOutputs(step.Outputs, outputs, stepContexts); err != nil {
+			return errors.Wrap(err, "setting outputs")
+		}
+	} else if err = batcheslib.SetOutputs(step.Outputs, outputs, &stepContext); err != nil {
 		return errors.Wrap(err, "setting outputs")
 	}
 	for k, v := range outputs {
@@ -157,6 +165,34 @@ func Post(
 	return cleanupWorkspace(workingDirectory, stepIdx, workspaceFilesPath)
 }
 
+// getForeachStepContexts returns the step context of every foreach element of the step
+// written by the pre step. The stdout of the step is replaced with the stdout of the
+// element.
+func getForeachStepContexts(workingDirectory string, stepIdx int, stepContext template.StepContext) ([]*template.StepContext, error) {
+	b, err := os.ReadFile(filepath.Join(workingDirectory, util.ForeachJSONFile(stepIdx)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read foreach file")
+	}
+	var items []any
+	if err = json.Unmarshal(b, &items); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal foreach file")
+	}
+
+	stepContexts := make([]*template.StepContext, len(items))
+	for i := range items {
+		stdout, err := os.ReadFile(filepath.Join(workingDirectory, util.ForeachStdoutFile(stepIdx, i)))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read foreach stdout file")
+		}
+
+		itemContext := batcheslib.ForeachStepContext(stepContext, items, i)
+		itemContext.Step.Stdout = string(stdout)
+		stepContexts[i] = &itemContext
+	}
+
+	return stepContexts, nil
+}
+
 type fileMetadataRetriever struct {
 	workingDirectory string
 }
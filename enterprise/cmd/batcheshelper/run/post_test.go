@@ -92,6 +92,54 @@ func TestPost(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "Foreach",
+			setupFunc: func(t *testing.T, dir string, workspaceFileDir string, executionInput batcheslib.WorkspacesExecutionInput) {
+				err := os.WriteFile(filepath.Join(dir, "foreach0.json"), []byte(`["service-a", "service-b"]`), os.ModePerm)
+				require.NoError(t, err)
+				err = os.WriteFile(filepath.Join(dir, "stdout0.0.log"), []byte("a"), os.ModePerm)
+				require.NoError(t, err)
+				err = os.WriteFile(filepath.Join(dir, "stdout0.1.log"), []byte("b"), os.ModePerm)
+				require.NoError(t, err)
+			},
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("Git", mock.Anything, "", []string{"config", "--global", "--add", "safe.directory", "/job/repository"}).
+					Return("", nil)
+				runner.On("Git", mock.Anything, "repository", []string{"add", "--all"}).
+					Return("", nil)
+				runner.On("Git", mock.Anything, "repository", []string{"diff", "--cached", "--no-prefix", "--binary"}).
+					Return("git diff", nil)
+			},
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{
+						Run:     "echo ${{ foreach.item }}",
+						Foreach: []any{"service-a", "service-b"},
+						Outputs: batcheslib.Outputs{
+							"results": batcheslib.Output{Value: "${{ foreach.item }}=${{ step.stdout }}"},
+						},
+					},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			stdoutLogs:     "ab",
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string, runner *fakeCmdRunner) {
+				require.Len(t, logEntries, 2)
+
+				b, err := os.ReadFile(filepath.Join(dir, "step0.json"))
+				require.NoError(t, err)
+				var result execution.AfterStepResult
+				err = json.Unmarshal(b, &result)
+				require.NoError(t, err)
+				assert.Equal(t, "ab", result.Stdout)
+				assert.Equal(
+					t,
+					map[string]interface{}{"results": []interface{}{"service-a=a", "service-b=b"}},
+					result.Outputs,
+				)
+			},
+		},
 		{
 			name: "File Mounts",
 			setupFunc: func(t *testing.T, dir string, workspaceFileDir string, executionInput batcheslib.WorkspacesExecutionInput) {
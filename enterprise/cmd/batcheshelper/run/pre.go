@@ -3,6 +3,8 @@ package run
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -43,9 +45,6 @@ func Pre(
 		return err
 	}
 
-	// Configures copying of the files to be used by the step.
-	var fileMountsPreamble string
-
 	// Check if the step needs to be skipped.
 	cond, err := template.EvalStepCondition(step.IfCondition(), &stepContext)
 	if err != nil {
@@ -89,10 +88,98 @@ func Pre(
 		return nil
 	}
 
+	if !step.HasForeach() {
+		script, env, err := renderStepScript(workingDirectory, workspaceFilesPath, stepIdx, step, stepEnv, &stepContext)
+		if err != nil {
+			return err
+		}
+
+		// Write the event to the log. Ensure environment variables will be rendered.
+		if err = logger.WriteEvent(batcheslib.LogEventOperationTaskStep, batcheslib.LogEventStatusStarted, &batcheslib.TaskStepMetadata{
+			Step: stepIdx + 1,
+			Env:  env,
+		}); err != nil {
+			return err
+		}
+
+		stepScriptPath := filepath.Join(workingDirectory, fmt.Sprintf("step%d.sh", stepIdx))
+		if err = os.WriteFile(stepScriptPath, []byte(script), os.ModePerm); err != nil {
+			return errors.Wrap(err, "failed to write step script file")
+		}
+
+		return nil
+	}
+
+	// The step runs once per foreach element. Every element gets its own script and the
+	// step script invokes them one after another in the same container and workspace, so
+	// the changes made for all elements end up in a single diff.
+	items, err := step.ForeachItems(&stepContext)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate step foreach")
+	}
+	itemsBytes, err := json.Marshal(items)
+	if err != nil {
+		return errors.Wrap(err, "marshalling foreach elements")
+	}
+	if err = os.WriteFile(filepath.Join(workingDirectory, util.ForeachJSONFile(stepIdx)), itemsBytes, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write foreach file")
+	}
+
+	var env map[string]string
+	// The stdout of every element is captured separately, so that the outputs can be
+	// rendered per element in the post step.
+	stepScript := "dir=$(dirname \"$0\")\n"
+	for i := range items {
+		itemContext := batcheslib.ForeachStepContext(stepContext, items, i)
+		script, itemEnv, err := renderStepScript(workingDirectory, workspaceFilesPath, stepIdx, step, stepEnv, &itemContext)
+		if err != nil {
+			return errors.Wrapf(err, "foreach element %d", i)
+		}
+		if i == 0 {
+			env = itemEnv
+		}
+
+		if err = os.WriteFile(filepath.Join(workingDirectory, util.ForeachScriptFile(stepIdx, i)), []byte(script), os.ModePerm); err != nil {
+			return errors.Wrap(err, "failed to write foreach script file")
+		}
+		stepScript += fmt.Sprintf("\"$dir/%s\" > \"$dir/%s\" || exit $?\n", util.ForeachScriptFile(stepIdx, i), util.ForeachStdoutFile(stepIdx, i))
+		stepScript += fmt.Sprintf("cat \"$dir/%s\"\n", util.ForeachStdoutFile(stepIdx, i))
+	}
+
+	// Write the event to the log. The environment of the first element is representative
+	// for all of them.
+	if err = logger.WriteEvent(batcheslib.LogEventOperationTaskStep, batcheslib.LogEventStatusStarted, &batcheslib.TaskStepMetadata{
+		Step: stepIdx + 1,
+		Env:  env,
+	}); err != nil {
+		return err
+	}
+
+	stepScriptPath := filepath.Join(workingDirectory, fmt.Sprintf("step%d.sh", stepIdx))
+	if err = os.WriteFile(stepScriptPath, []byte(stepScript), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write step script file")
+	}
+
+	return nil
+}
+
+// renderStepScript renders the script that runs the given step in the given context and
+// returns it along with the rendered environment of the step.
+func renderStepScript(
+	workingDirectory string,
+	workspaceFilesPath string,
+	stepIdx int,
+	step batcheslib.Step,
+	stepEnv map[string]string,
+	stepContext *template.StepContext,
+) (string, map[string]string, error) {
+	// Configures copying of the files to be used by the step.
+	var fileMountsPreamble string
+
 	// Parse and render the step.Files.
-	filesToMount, err := createFilesToMount(workingDirectory, stepIdx, step, &stepContext)
+	filesToMount, err := createFilesToMount(workingDirectory, stepIdx, step, stepContext)
 	if err != nil {
-		return errors.Wrap(err, "failed to create files to mount")
+		return "", nil, errors.Wrap(err, "failed to create files to mount")
 	}
 	if len(filesToMount) > 0 {
 		// Sort the keys for consistent unit testing.
@@ -115,30 +202,22 @@ func Pre(
 		workspaceFilePath, err := getAbsoluteMountPath(workspaceFilesPath, mount.Path)
 
 		if err != nil {
-			return errors.Wrap(err, "getAbsoluteMountPath")
+			return "", nil, errors.Wrap(err, "getAbsoluteMountPath")
 		}
 		fileMountsPreamble += fmt.Sprintf("%s\n", shellquote.Join("cp", "-r", workspaceFilePath, mount.Mountpoint))
 		fileMountsPreamble += fmt.Sprintf("%s\n", shellquote.Join("chmod", "-R", "+x", mount.Mountpoint))
 	}
 
 	// Render the step.Env template.
-	env, err := template.RenderStepMap(stepEnv, &stepContext)
+	env, err := template.RenderStepMap(stepEnv, stepContext)
 	if err != nil {
-		return errors.Wrap(err, "failed to render step env")
-	}
-
-	// Write the event to the log. Ensure environment variables will be rendered.
-	if err = logger.WriteEvent(batcheslib.LogEventOperationTaskStep, batcheslib.LogEventStatusStarted, &batcheslib.TaskStepMetadata{
-		Step: stepIdx + 1,
-		Env:  env,
-	}); err != nil {
-		return err
+		return "", nil, errors.Wrap(err, "failed to render step env")
 	}
 
 	// Render the step.Run template.
 	var runScript bytes.Buffer
-	if err = template.RenderStepTemplate("step-run", step.Run, &runScript, &stepContext); err != nil {
-		return errors.Wrap(err, "failed to render step.run")
+	if err = template.RenderStepTemplate("step-run", step.Run, &runScript, stepContext); err != nil {
+		return "", nil, errors.Wrap(err, "failed to render step.run")
 	}
 
 	// Create the environment preamble for the step script.
@@ -148,13 +227,7 @@ func Pre(
 		envPreamble += "\n"
 	}
 
-	stepScriptPath := filepath.Join(workingDirectory, fmt.Sprintf("step%d.sh", stepIdx))
-	fullScript := []byte(envPreamble + fileMountsPreamble + runScript.String())
-	if err = os.WriteFile(stepScriptPath, fullScript, os.ModePerm); err != nil {
-		return errors.Wrap(err, "failed to write step script file")
-	}
-
-	return nil
+	return envPreamble + fileMountsPreamble + runScript.String(), env, nil
 }
 
 func getStepContext(executionInput batcheslib.WorkspacesExecutionInput, previousResult execution.AfterStepResult) (template.StepContext, error) {
@@ -184,7 +257,7 @@ func getStepContext(executionInput batcheslib.WorkspacesExecutionInput, previous
 	return stepContext, nil
 }
 
-// createFilesToMount creates temporary files with the contents of Step.Files
+// createFilesToMount creates files with the contents of Step.Files
 // that are to be mounted into the container that executes the step.
 // TODO: Remove these files in the `after` step.
 func createFilesToMount(workingDirectory string, stepIdx int, step batcheslib.Step, stepContext *template.StepContext) (map[string]string, error) {
@@ -198,30 +271,26 @@ func createFilesToMount(workingDirectory string, stepIdx int, step batcheslib.St
 		return nil, nil
 	}
 
+	// Steps with a foreach list create files once per element, so the directory may
+	// already exist.
 	tempDir := util.FilesMountPath(workingDirectory, stepIdx)
-	if err = os.Mkdir(tempDir, os.ModePerm); err != nil {
+	if err = os.MkdirAll(tempDir, os.ModePerm); err != nil {
 		return nil, errors.Wrap(err, "failed to create directory for file mounts")
 	}
 
-	// Create temp files with the rendered content of step.Files so that we
-	// can mount them into the container.
-	//filesToMount := make(map[string]*os.File, len(files))
+	// Write files with the rendered content of step.Files so that we can mount them into
+	// the container. The files are named after their content rather than given random
+	// names, so that the rendered step script is the same for identical inputs and the
+	// step can be served from the step cache of the executor.
 	filesToMount := make(map[string]string, len(files))
 	for name, content := range files {
-		fp, err := os.CreateTemp(tempDir, "")
-		if err != nil {
-			return nil, errors.Wrap(err, "creating temporary file")
-		}
-
-		if _, err = fp.WriteString(content); err != nil {
-			return nil, errors.Wrap(err, "writing to temporary file")
-		}
-
-		if err = fp.Close(); err != nil {
-			return nil, errors.Wrap(err, "closing temporary file")
+		hash := sha256.Sum256([]byte(name + "\x00" + content))
+		path := filepath.Join(tempDir, hex.EncodeToString(hash[:]))
+		if err = os.WriteFile(path, []byte(content), os.ModePerm); err != nil {
+			return nil, errors.Wrap(err, "writing file to mount")
 		}
 
-		filesToMount[name] = fp.Name()
+		filesToMount[name] = path
 	}
 
 	return filesToMount, nil
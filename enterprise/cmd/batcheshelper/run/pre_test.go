@@ -3,6 +3,8 @@ package run_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -104,6 +106,52 @@ func TestPre(t *testing.T) {
 				assert.Equal(t, os.FileMode(0755), stat.Mode().Perm())
 			},
 		},
+		{
+			name: "Foreach step",
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{
+						Run:     "cd ${{ foreach.item }} && echo ${{ foreach.index }}",
+						Foreach: []any{"service-a", "service-b"},
+					},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string) {
+				require.Len(t, logEntries, 1)
+				assert.Equal(t, batcheslib.LogEventOperationTaskStep, logEntries[0].Operation)
+				assert.Equal(t, batcheslib.LogEventStatusStarted, logEntries[0].Status)
+
+				dirEntries, err := os.ReadDir(dir)
+				require.NoError(t, err)
+				require.Len(t, dirEntries, 4)
+
+				b, err := os.ReadFile(filepath.Join(dir, "step0.sh"))
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					"dir=$(dirname \"$0\")\n"+
+						"\"$dir/step0.0.sh\" > \"$dir/stdout0.0.log\" || exit $?\n"+
+						"cat \"$dir/stdout0.0.log\"\n"+
+						"\"$dir/step0.1.sh\" > \"$dir/stdout0.1.log\" || exit $?\n"+
+						"cat \"$dir/stdout0.1.log\"\n",
+					string(b),
+				)
+
+				b, err = os.ReadFile(filepath.Join(dir, "step0.0.sh"))
+				require.NoError(t, err)
+				assert.Equal(t, "cd service-a && echo 0", string(b))
+
+				b, err = os.ReadFile(filepath.Join(dir, "step0.1.sh"))
+				require.NoError(t, err)
+				assert.Equal(t, "cd service-b && echo 1", string(b))
+
+				b, err = os.ReadFile(filepath.Join(dir, "foreach0.json"))
+				require.NoError(t, err)
+				assert.JSONEq(t, `["service-a", "service-b"]`, string(b))
+			},
+		},
 		{
 			name: "File mounts",
 			step: 0,
@@ -129,6 +177,19 @@ func TestPre(t *testing.T) {
 				stepFiles, err := os.ReadDir(filepath.Join(dir, "step0files"))
 				require.NoError(t, err)
 				require.Len(t, stepFiles, 2)
+
+				// The files are named after their content, so the script is stable.
+				b, err := os.ReadFile(filepath.Join(dir, "step0.sh"))
+				require.NoError(t, err)
+				for name, content := range map[string]string{"file1.sh": "echo file1", "file2.sh": "echo file2"} {
+					hash := sha256.Sum256([]byte(name + "\x00" + content))
+					path := filepath.Join(dir, "step0files", hex.EncodeToString(hash[:]))
+					assert.Contains(t, string(b), fmt.Sprintf("cp %s %s\n", path, name))
+
+					mounted, err := os.ReadFile(path)
+					require.NoError(t, err)
+					assert.Equal(t, content, string(mounted))
+				}
 			},
 		},
 		{
@@ -16,6 +16,21 @@ func StepJSONFile(step int) string {
 	return fmt.Sprintf("step%d.json", step)
 }
 
+// ForeachJSONFile returns the path to the JSON file with the foreach elements of the step.
+func ForeachJSONFile(step int) string {
+	return fmt.Sprintf("foreach%d.json", step)
+}
+
+// ForeachScriptFile returns the path to the script of the step for a single foreach element.
+func ForeachScriptFile(step int, item int) string {
+	return fmt.Sprintf("step%d.%d.sh", step, item)
+}
+
+// ForeachStdoutFile returns the path to the stdout log of the step for a single foreach element.
+func ForeachStdoutFile(step int, item int) string {
+	return fmt.Sprintf("stdout%d.%d.log", step, item)
+}
+
 // FilesMountPath returns the path to the directory where the mount files for the step will be stored.
 func FilesMountPath(workingDirectory string, step int) string {
 	return filepath.Join(workingDirectory, fmt.Sprintf("step%dfiles", step))
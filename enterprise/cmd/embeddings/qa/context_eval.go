@@ -0,0 +1,257 @@
+package qa
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	codycontext "github.com/sourcegraph/sourcegraph/enterprise/internal/codycontext"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const contextRepoName = api.RepoName("github.com/sourcegraph/sourcegraph")
+
+type keywordSearcher interface {
+	SearchKeyword(query string, limit int) ([]codycontext.FileChunkContext, error)
+}
+
+// RecordContext runs embeddings search and keyword search for each query of the test data
+// and returns the labelled queries along with the top count results of each retriever.
+func RecordContext(embeddingsSearcher embeddingsSearcher, keywordSearcher keywordSearcher, count int) ([]codycontext.LabelledQuery, error) {
+	queries, err := loadLabelledQueries()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, q := range queries {
+		results, err := embeddingsSearcher.Search(embeddings.EmbeddingsSearchParameters{
+			RepoNames:        []api.RepoName{contextRepoName},
+			RepoIDs:          []api.RepoID{0},
+			Query:            q.Query,
+			CodeResultsCount: count,
+			TextResultsCount: count,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "embeddings search failed")
+		}
+		for _, result := range append(results.CodeResults, results.TextResults...) {
+			queries[i].Embeddings = append(queries[i].Embeddings, codycontext.FileChunkContext{
+				RepoName:  result.RepoName,
+				CommitID:  result.Revision,
+				Path:      result.FileName,
+				StartLine: result.StartLine,
+				EndLine:   result.EndLine,
+				Symbol:    result.Symbol,
+			})
+		}
+
+		codeQuery, textQuery := codycontext.KeywordQueries([]api.RepoName{contextRepoName}, q.Query)
+		for _, keywordQuery := range []string{codeQuery, textQuery} {
+			results, err := keywordSearcher.SearchKeyword(keywordQuery, count)
+			if err != nil {
+				return nil, errors.Wrap(err, "keyword search failed")
+			}
+			queries[i].Keyword = append(queries[i].Keyword, results...)
+		}
+	}
+
+	return queries, nil
+}
+
+// LoadContext reads queries previously recorded with RecordContext.
+func LoadContext(path string) ([]codycontext.LabelledQuery, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	return codycontext.LoadLabelledQueries(file)
+}
+
+// EvaluateContext prints the recall and mean reciprocal rank of the top k results of
+// embeddings search, keyword search, and their fusion over the recorded queries.
+func EvaluateContext(queries []codycontext.LabelledQuery, k int) codycontext.Evaluation {
+	evaluation := codycontext.Evaluate(queries, k, codycontext.DefaultHybridOptions)
+
+	fmt.Printf("Queries: %d, k: %d\n", len(queries), k)
+	for _, retriever := range []struct {
+		name    string
+		metrics codycontext.RetrievalMetrics
+	}{
+		{"embeddings", evaluation.Embeddings},
+		{"keyword", evaluation.Keyword},
+		{"hybrid", evaluation.Hybrid},
+	} {
+		fmt.Printf("%-10s Recall: %f MRR: %f\n", retriever.name, retriever.metrics.Recall, retriever.metrics.MRR)
+	}
+
+	return evaluation
+}
+
+// loadLabelledQueries groups the relevant files of the test data by query.
+func loadLabelledQueries() ([]codycontext.LabelledQuery, error) {
+	file, err := fs.Open("context_data.tsv")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	var queries []codycontext.LabelledQuery
+	indexes := map[string]int{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 2 {
+			continue
+		}
+		query, relevantFile := fields[0], fields[1]
+
+		i, ok := indexes[query]
+		if !ok {
+			i = len(queries)
+			indexes[query] = i
+			queries = append(queries, codycontext.LabelledQuery{Query: query})
+		}
+		queries[i].RelevantPaths = append(queries[i].RelevantPaths, relevantFile)
+	}
+
+	return queries, scanner.Err()
+}
+
+type searchClient struct {
+	httpClient *http.Client
+	url        string
+	token      string
+}
+
+// NewSearchClient returns a keyword searcher that queries the GraphQL API of the
+// Sourcegraph instance at the given URL.
+func NewSearchClient(url, token string) *searchClient {
+	return &searchClient{
+		httpClient: http.DefaultClient,
+		url:        strings.TrimSuffix(url, "/") + "/.api/graphql",
+		token:      token,
+	}
+}
+
+const keywordSearchQuery = `
+query KeywordSearch($query: String!) {
+	search(query: $query, version: V3, patternType: keyword) {
+		results {
+			results {
+				... on FileMatch {
+					repository {
+						name
+					}
+					file {
+						path
+						commit {
+							oid
+						}
+					}
+					chunkMatches {
+						contentStart {
+							line
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *searchClient) SearchKeyword(query string, limit int) ([]codycontext.FileChunkContext, error) {
+	b, err := json.Marshal(map[string]any{
+		"query":     keywordSearchQuery,
+		"variables": map[string]any{"query": fmt.Sprintf("%s count:%d", query, limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send request %+v", req)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Newf("unexpected status code: %d", response.StatusCode)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Data struct {
+			Search struct {
+				Results struct {
+					Results []struct {
+						Repository struct{ Name string }
+						File       struct {
+							Path   string
+							Commit struct{ OID string }
+						}
+						ChunkMatches []struct {
+							ContentStart struct{ Line int }
+						}
+					}
+				}
+			}
+		}
+		Errors []struct{ Message string }
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response")
+	}
+	if len(res.Errors) > 0 {
+		return nil, errors.Newf("graphql error: %s", res.Errors[0].Message)
+	}
+
+	// Mirror the chunk selection of keyword context: the first chunk of each file
+	// with 4 lines of leading context.
+	var results []codycontext.FileChunkContext
+	for _, fm := range res.Data.Search.Results.Results {
+		if len(fm.ChunkMatches) == 0 {
+			continue
+		}
+		startLine := fm.ChunkMatches[0].ContentStart.Line - 4
+		if startLine < 0 {
+			startLine = 0
+		}
+
+		results = append(results, codycontext.FileChunkContext{
+			RepoName:  api.RepoName(fm.Repository.Name),
+			CommitID:  api.CommitID(fm.File.Commit.OID),
+			Path:      fm.File.Path,
+			StartLine: startLine,
+			EndLine:   startLine + 8,
+		})
+		if len(results) >= limit {
+			break
+		}
+	}
+
+	return results, nil
+}
@@ -19,6 +19,9 @@ type Config struct {
 
 	EmbeddingsCacheSize int64
 
+	ApproximateSearchProbes int
+	ExactSearchMaxRows      int
+
 	WeaviateURL *url.URL
 }
 
@@ -35,6 +38,9 @@ func (c *Config) Load() {
 	}
 
 	c.EmbeddingsCacheSize = int64(c.GetInt("EMBEDDINGS_CACHE_SIZE", strconv.Itoa(defaultEmbeddingsCacheSize), "The size of the in-memory cache for embeddings indexes"))
+
+	c.ApproximateSearchProbes = c.GetInt("EMBEDDINGS_ANN_SEARCH_PROBES", "32", "The number of lists of approximate nearest neighbor indexes to search. Higher values improve recall at the cost of latency. Set to 0 to always search embeddings indexes exhaustively.")
+	c.ExactSearchMaxRows = c.GetInt("EMBEDDINGS_EXACT_SEARCH_MAX_ROWS", strconv.Itoa(emb.DefaultExactSearchMaxRows), "The number of rows up to which embeddings indexes are searched exhaustively, even if they have an approximate nearest neighbor index.")
 }
 
 func (c *Config) Validate() error {
@@ -44,5 +50,16 @@ func (c *Config) Validate() error {
 	if c.EmbeddingsCacheSize < 0 {
 		errs = errors.Append(errs, errors.New("embeddings cache size cannot be negative"))
 	}
+	if c.ApproximateSearchProbes < 0 {
+		errs = errors.Append(errs, errors.New("approximate search probes cannot be negative"))
+	}
 	return errs
 }
+
+// SearchOptions returns the approximate nearest neighbor search options.
+func (c *Config) SearchOptions() emb.SearchOptions {
+	return emb.SearchOptions{
+		ApproximateSearchProbes: c.ApproximateSearchProbes,
+		ExactSearchMaxRows:      c.ExactSearchMaxRows,
+	}
+}
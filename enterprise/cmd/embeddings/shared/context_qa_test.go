@@ -67,6 +67,7 @@ func TestRecall(t *testing.T) {
 			getRepoEmbeddingIndex,
 			lookupQueryEmbedding,
 			weaviate,
+			embeddings.SearchOptions{},
 		)
 	}
 
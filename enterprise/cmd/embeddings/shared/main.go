@@ -89,7 +89,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 	)
 
 	// Create HTTP server
-	handler := NewHandler(logger, indexGetter.Get, getQueryEmbedding, weaviate)
+	handler := NewHandler(logger, indexGetter.Get, getQueryEmbedding, weaviate, config.SearchOptions())
 	handler = handlePanic(logger, handler)
 	handler = featureflag.Middleware(db.FeatureFlags(), handler)
 	handler = trace.HTTPMiddleware(logger, handler, conf.DefaultClient())
@@ -114,6 +114,7 @@ func NewHandler(
 	getRepoEmbeddingIndex getRepoEmbeddingIndexFn,
 	getQueryEmbedding getQueryEmbeddingFn,
 	weaviate *weaviateClient,
+	searchOpts embeddings.SearchOptions,
 ) http.Handler {
 	// Initialize the legacy JSON API server
 	mux := http.NewServeMux()
@@ -130,7 +131,7 @@ func NewHandler(
 			return
 		}
 
-		res, err := searchRepoEmbeddingIndexes(r.Context(), args, getRepoEmbeddingIndex, getQueryEmbedding, weaviate)
+		res, err := searchRepoEmbeddingIndexes(r.Context(), args, getRepoEmbeddingIndex, getQueryEmbedding, weaviate, searchOpts)
 		if errcode.IsNotFound(err) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
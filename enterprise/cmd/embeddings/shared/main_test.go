@@ -88,6 +88,7 @@ func TestEmbeddingsSearch(t *testing.T) {
 		getRepoEmbeddingIndex,
 		getMockQueryEmbedding,
 		nil,
+		embeddings.SearchOptions{},
 	))
 
 	server2 := httptest.NewServer(NewHandler(
@@ -95,6 +96,7 @@ func TestEmbeddingsSearch(t *testing.T) {
 		getRepoEmbeddingIndex,
 		getMockQueryEmbedding,
 		nil,
+		embeddings.SearchOptions{},
 	))
 
 	client := embeddings.NewClient(endpoint.Static(server1.URL, server2.URL), http.DefaultClient)
@@ -291,6 +293,7 @@ func TestEmbeddingModelMismatch(t *testing.T) {
 		getRepoEmbeddingIndex,
 		getQueryEmbedding,
 		nil,
+		embeddings.SearchOptions{},
 	))
 
 	client := embeddings.NewClient(endpoint.Static(server.URL), http.DefaultClient)
@@ -23,6 +23,7 @@ func searchRepoEmbeddingIndexes(
 	getRepoEmbeddingIndex getRepoEmbeddingIndexFn,
 	getQueryEmbedding getQueryEmbeddingFn,
 	weaviate *weaviateClient,
+	searchOpts embeddings.SearchOptions,
 ) (_ *embeddings.EmbeddingCombinedSearchResults, err error) {
 	tr, ctx := trace.New(ctx, "searchRepoEmbeddingIndexes", "", params.Attrs()...)
 	defer tr.FinishWithErr(&err)
@@ -38,9 +39,7 @@ func searchRepoEmbeddingIndexes(
 		MinRowsToSplit: similaritySearchMinRowsToSplit,
 	}
 
-	searchOpts := embeddings.SearchOptions{
-		UseDocumentRanks: params.UseDocumentRanks,
-	}
+	searchOpts.UseDocumentRanks = params.UseDocumentRanks
 
 	searchRepo := func(repoID api.RepoID, repoName api.RepoName) (codeResults, textResults []embeddings.EmbeddingSearchResult, err error) {
 		tr, ctx := trace.New(ctx, "searchRepo", "",
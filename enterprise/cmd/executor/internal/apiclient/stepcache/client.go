@@ -0,0 +1,93 @@
+package stepcache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Client interacts with the step cache stored by the frontend.
+type Client struct {
+	client     *apiclient.BaseClient
+	logger     log.Logger
+	operations *operations
+}
+
+// Compile time validation.
+var _ cache.Store = &Client{}
+
+// New creates a new Client based on the provided Options.
+func New(observationCtx *observation.Context, options apiclient.BaseClientOptions) (*Client, error) {
+	logger := log.Scoped("executor-api-step-cache-client", "The API client adapter for executors to interact with the step cache over HTTP")
+	client, err := apiclient.NewBaseClient(logger, options)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		client:     client,
+		logger:     logger,
+		operations: newOperations(observationCtx),
+	}, nil
+}
+
+func (c *Client) Get(ctx context.Context, job types.Job, key string) (entry []byte, found bool, err error) {
+	ctx, _, endObservation := c.operations.get.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("queue", job.Queue),
+		attribute.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewRequest(job.ID, job.Token, http.MethodGet, fmt.Sprintf("%s/%s", job.Queue, key), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasContent, body, err := c.client.Do(ctx, req)
+	if err != nil {
+		var unexpectedStatusCodeError *apiclient.UnexpectedStatusCodeErr
+		if errors.As(err, &unexpectedStatusCodeError) {
+			if unexpectedStatusCodeError.StatusCode == http.StatusNotFound {
+				return nil, false, nil
+			}
+		}
+		return nil, false, err
+	}
+	if !hasContent {
+		// An entry for a step that did not change the workspace.
+		return nil, true, nil
+	}
+	defer body.Close()
+
+	entry, err = io.ReadAll(body)
+	if err != nil {
+		return nil, false, err
+	}
+	return entry, true, nil
+}
+
+func (c *Client) Set(ctx context.Context, job types.Job, key string, entry []byte) (err error) {
+	ctx, _, endObservation := c.operations.set.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("queue", job.Queue),
+		attribute.String("key", key),
+		attribute.Int("size", len(entry)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewRequest(job.ID, job.Token, http.MethodPut, fmt.Sprintf("%s/%s", job.Queue, key), bytes.NewReader(entry))
+	if err != nil {
+		return err
+	}
+
+	return c.client.DoAndDrop(ctx, req)
+}
@@ -0,0 +1,124 @@
+package stepcache_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/stepcache"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestClient_Get(t *testing.T) {
+	tests := []struct {
+		name string
+
+		handler func(t *testing.T) http.Handler
+
+		expectedDiff  string
+		expectedFound bool
+		expectedErr   error
+	}{
+		{
+			name: "Entry exists",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodGet, r.Method)
+					assert.Equal(t, "/.executors/step-cache/codeintel/some-key", r.URL.Path)
+					assert.Equal(t, "Bearer sometoken", r.Header.Get("Authorization"))
+					assert.Equal(t, "42", r.Header.Get("X-Sourcegraph-Job-ID"))
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte("diff --git a/foo b/foo"))
+				})
+			},
+			expectedDiff:  "diff --git a/foo b/foo",
+			expectedFound: true,
+		},
+		{
+			name: "Empty entry",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				})
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Entry does not exist",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				})
+			},
+		},
+		{
+			name: "Unexpected error",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+			},
+			expectedErr: errors.New("unexpected status code 500"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(test.handler(t))
+			defer srv.Close()
+
+			client := newClient(t, srv.URL)
+
+			diff, found, err := client.Get(context.Background(), types.Job{ID: 42, Token: "sometoken", Queue: "codeintel"}, "some-key")
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expectedDiff, string(diff))
+		})
+	}
+}
+
+func TestClient_Set(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/.executors/step-cache/batches/some-key", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "some diff", string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := newClient(t, srv.URL)
+
+	err := client.Set(context.Background(), types.Job{ID: 42, Token: "sometoken", Queue: "batches"}, "some-key", []byte("some diff"))
+	require.NoError(t, err)
+}
+
+func newClient(t *testing.T, url string) *stepcache.Client {
+	t.Helper()
+
+	options := apiclient.BaseClientOptions{
+		ExecutorName: "test-executor",
+		EndpointOptions: apiclient.EndpointOptions{
+			URL:        url,
+			PathPrefix: "/.executors/step-cache",
+			Token:      "hunter2",
+		},
+	}
+
+	client, err := stepcache.New(&observation.TestContext, options)
+	require.NoError(t, err)
+	return client
+}
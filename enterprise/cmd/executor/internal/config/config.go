@@ -38,6 +38,7 @@ type Config struct {
 	VMStartupScriptPath                            string
 	VMPrefix                                       string
 	KeepWorkspaces                                 bool
+	StepCacheEnabled                               bool
 	DockerHostMountPath                            string
 	UseFirecracker                                 bool
 	JobNumCPUs                                     int
@@ -63,6 +64,7 @@ type Config struct {
 	KubernetesPodAffinity                          []corev1.PodAffinityTerm
 	KubernetesPodAntiAffinity                      []corev1.PodAffinityTerm
 	KubernetesNodeTolerations                      []corev1.Toleration
+	KubernetesQueueScheduling                      map[string]KubernetesQueueScheduling
 	KubernetesNamespace                            string
 	KubernetesPersistenceVolumeName                string
 	KubernetesResourceLimitCPU                     string
@@ -96,6 +98,8 @@ type Config struct {
 	kubernetesPodAntiAffinityUnmarshalError                      error
 	kubernetesNodeTolerations                                    string
 	kubernetesNodeTolerationsUnmarshalError                      error
+	kubernetesQueueScheduling                                    string
+	kubernetesQueueSchedulingUnmarshalError                      error
 	kubernetesAdditionalJobVolumeMounts                          string
 	kubernetesAdditionalJobVolumeMountsUnmarshalError            error
 	kubernetesAdditionalJobVolumes                               string
@@ -124,6 +128,7 @@ func (c *Config) Load() {
 	c.VMStartupScriptPath = c.GetOptional("EXECUTOR_VM_STARTUP_SCRIPT_PATH", "A path to a file on the host that is loaded into a fresh virtual machine and executed on startup.")
 	c.VMPrefix = c.Get("EXECUTOR_VM_PREFIX", "executor", "A name prefix for virtual machines controlled by this instance.")
 	c.KeepWorkspaces = c.GetBool("EXECUTOR_KEEP_WORKSPACES", "false", "Whether to skip deletion of workspaces after a job completes (or fails). Note that when Firecracker is enabled that the workspace is initially copied into the VM, so modifications will not be observed.")
+	c.StepCacheEnabled = c.GetBool("EXECUTOR_STEP_CACHE_ENABLED", "false", "Whether to skip steps whose image, command, environment and workspace are identical to a previously executed step, restoring its changes instead. Not supported with Firecracker or Kubernetes.")
 	c.DockerHostMountPath = c.GetOptional("EXECUTOR_DOCKER_HOST_MOUNT_PATH", "The target workspace as it resides on the Docker host (used to enable Docker-in-Docker).")
 	c.JobNumCPUs = c.GetInt(env.ChooseFallbackVariableName("EXECUTOR_JOB_NUM_CPUS", "EXECUTOR_FIRECRACKER_NUM_CPUS"), "4", "How many CPUs to allocate to each virtual machine or container. A value of zero sets no resource bound (in Docker, but not VMs).")
 	c.JobMemory = c.Get(env.ChooseFallbackVariableName("EXECUTOR_JOB_MEMORY", "EXECUTOR_FIRECRACKER_MEMORY"), "12G", "How much memory to allocate to each virtual machine or container. A value of zero sets no resource bound (in Docker, but not VMs).")
@@ -145,6 +150,7 @@ func (c *Config) Load() {
 	c.kubernetesPodAffinity = c.GetOptional("EXECUTOR_KUBERNETES_POD_AFFINITY", "The JSON encoded pod affinity for Kubernetes Jobs. e.g. [{\"labelSelector\": {\"matchExpressions\": [{\"key\": \"foo\", \"operator\": \"In\", \"values\": [\"bar\"]}]}, \"topologyKey\": \"kubernetes.io/hostname\"}]")
 	c.kubernetesPodAntiAffinity = c.GetOptional("EXECUTOR_KUBERNETES_POD_ANTI_AFFINITY", "The JSON encoded pod anti-affinity for Kubernetes Jobs. e.g. [{\"labelSelector\": {\"matchExpressions\": [{\"key\": \"foo\", \"operator\": \"In\", \"values\": [\"bar\"]}]}, \"topologyKey\": \"kubernetes.io/hostname\"}]")
 	c.kubernetesNodeTolerations = c.GetOptional("EXECUTOR_KUBERNETES_NODE_TOLERATIONS", "The JSON encoded tolerations for Kubernetes Jobs. e.g. [{\"key\": \"foo\", \"operator\": \"Equal\", \"value\": \"bar\", \"effect\": \"NoSchedule\"}]")
+	c.kubernetesQueueScheduling = c.GetOptional("EXECUTOR_KUBERNETES_QUEUE_SCHEDULING", "The JSON encoded node selectors and tolerations to add to Kubernetes Jobs of specific queues. e.g. {\"batches\": {\"nodeSelector\": {\"pool\": \"batches\"}, \"tolerations\": [{\"key\": \"foo\", \"operator\": \"Exists\", \"effect\": \"NoSchedule\"}]}}")
 	c.KubernetesNamespace = c.Get("EXECUTOR_KUBERNETES_NAMESPACE", "default", "The namespace to run executor jobs in.")
 	c.KubernetesPersistenceVolumeName = c.Get("EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_NAME", "sg-executor-pvc", "The name of the Kubernetes persistence volume to use for executor jobs.")
 	c.KubernetesResourceLimitCPU = c.GetOptional("EXECUTOR_KUBERNETES_RESOURCE_LIMIT_CPU", "The maximum CPU resource for Kubernetes Jobs.")
@@ -189,6 +195,9 @@ func (c *Config) Load() {
 	if c.kubernetesNodeTolerations != "" {
 		c.kubernetesNodeTolerationsUnmarshalError = json.Unmarshal([]byte(c.kubernetesNodeTolerations), &c.KubernetesNodeTolerations)
 	}
+	if c.kubernetesQueueScheduling != "" {
+		c.kubernetesQueueSchedulingUnmarshalError = json.Unmarshal([]byte(c.kubernetesQueueScheduling), &c.KubernetesQueueScheduling)
+	}
 	if c.kubernetesAdditionalJobVolumes != "" {
 		c.kubernetesAdditionalJobVolumesUnmarshalError = json.Unmarshal([]byte(c.kubernetesAdditionalJobVolumes), &c.KubernetesAdditionalJobVolumes)
 	}
@@ -205,6 +214,13 @@ func (c *Config) Load() {
 	c.WorkerHostname = hn + "-" + uuid.New().String()
 }
 
+// KubernetesQueueScheduling contains the scheduling constraints added to the Kubernetes Jobs
+// of a single queue.
+type KubernetesQueueScheduling struct {
+	NodeSelector map[string]string   `json:"nodeSelector"`
+	Tolerations  []corev1.Toleration `json:"tolerations"`
+}
+
 func getKubeConfigPath() string {
 	if home := homedir.HomeDir(); home != "" {
 		return filepath.Join(home, ".kube", "config")
@@ -293,6 +309,19 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.kubernetesNodeTolerationsUnmarshalError, "invalid EXECUTOR_KUBERNETES_NODE_TOLERATIONS, failed to parse"))
 	}
 
+	if c.kubernetesQueueSchedulingUnmarshalError != nil {
+		c.AddError(errors.Wrap(c.kubernetesQueueSchedulingUnmarshalError, "invalid EXECUTOR_KUBERNETES_QUEUE_SCHEDULING, failed to parse"))
+	}
+
+	for queueName := range c.KubernetesQueueScheduling {
+		if !slices.Contains(types.ValidQueueNames, queueName) {
+			c.AddError(errors.Newf("EXECUTOR_KUBERNETES_QUEUE_SCHEDULING contains invalid queue name '%s', valid names are '%v'",
+				queueName,
+				strings.Join(types.ValidQueueNames, ", "),
+			))
+		}
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
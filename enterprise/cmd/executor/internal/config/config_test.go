@@ -26,6 +26,8 @@ func TestConfig_Load(t *testing.T) {
 			return "true"
 		case "EXECUTOR_KEEP_WORKSPACES":
 			return "true"
+		case "EXECUTOR_STEP_CACHE_ENABLED":
+			return "true"
 		case "EXECUTOR_JOB_NUM_CPUS":
 			return "8"
 		case "EXECUTOR_FIRECRACKER_BANDWIDTH_INGRESS":
@@ -56,6 +58,8 @@ func TestConfig_Load(t *testing.T) {
 			return `[{"labelSelector": {"matchExpressions": [{"key": "foo", "operator": "In", "values": ["bar"]}]}, "topologyKey": "kubernetes.io/hostname"}]`
 		case "EXECUTOR_KUBERNETES_NODE_TOLERATIONS":
 			return `[{"key": "foo", "operator": "Equal", "value": "bar", "effect": "NoSchedule"}]`
+		case "EXECUTOR_KUBERNETES_QUEUE_SCHEDULING":
+			return `{"batches": {"nodeSelector": {"pool": "batches"}, "tolerations": [{"key": "dedicated", "operator": "Exists", "effect": "NoSchedule"}]}}`
 		case "KUBERNETES_SINGLE_JOB_POD":
 			return "true"
 		case "KUBERNETES_JOB_VOLUME_TYPE":
@@ -87,6 +91,7 @@ func TestConfig_Load(t *testing.T) {
 	assert.Equal(t, "EXECUTOR_VM_STARTUP_SCRIPT_PATH", cfg.VMStartupScriptPath)
 	assert.Equal(t, "EXECUTOR_VM_PREFIX", cfg.VMPrefix)
 	assert.True(t, cfg.KeepWorkspaces)
+	assert.True(t, cfg.StepCacheEnabled)
 	assert.Equal(t, "EXECUTOR_DOCKER_HOST_MOUNT_PATH", cfg.DockerHostMountPath)
 	assert.Equal(t, 8, cfg.JobNumCPUs)
 	assert.Equal(t, "EXECUTOR_JOB_MEMORY", cfg.JobMemory)
@@ -154,6 +159,16 @@ func TestConfig_Load(t *testing.T) {
 		[]corev1.Toleration{{Key: "foo", Operator: corev1.TolerationOpEqual, Value: "bar", Effect: corev1.TaintEffectNoSchedule}},
 		cfg.KubernetesNodeTolerations,
 	)
+	assert.Equal(
+		t,
+		map[string]config.KubernetesQueueScheduling{
+			"batches": {
+				NodeSelector: map[string]string{"pool": "batches"},
+				Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+			},
+		},
+		cfg.KubernetesQueueScheduling,
+	)
 	assert.True(t, cfg.KubernetesSingleJobPod)
 	assert.Equal(t, "pvc", cfg.KubernetesJobVolumeType)
 	assert.Equal(t, "10Gi", cfg.KubernetesJobVolumeSize)
@@ -186,6 +201,7 @@ func TestConfig_Load_Defaults(t *testing.T) {
 	assert.Empty(t, cfg.VMStartupScriptPath)
 	assert.Equal(t, "executor", cfg.VMPrefix)
 	assert.False(t, cfg.KeepWorkspaces)
+	assert.False(t, cfg.StepCacheEnabled)
 	assert.Empty(t, cfg.DockerHostMountPath)
 	assert.Equal(t, 4, cfg.JobNumCPUs)
 	assert.Equal(t, "12G", cfg.JobMemory)
@@ -214,6 +230,7 @@ func TestConfig_Load_Defaults(t *testing.T) {
 	assert.Equal(t, -1, cfg.KubernetesSecurityContextRunAsUser)
 	assert.Equal(t, -1, cfg.KubernetesSecurityContextRunAsGroup)
 	assert.Equal(t, 1000, cfg.KubernetesSecurityContextFSGroup)
+	assert.Nil(t, cfg.KubernetesQueueScheduling)
 	assert.False(t, cfg.KubernetesSingleJobPod)
 	assert.Equal(t, "emptyDir", cfg.KubernetesJobVolumeType)
 	assert.Equal(t, "5Gi", cfg.KubernetesJobVolumeSize)
@@ -265,6 +282,24 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			expectedErr: errors.New("invalid EXECUTOR_DOCKER_AUTH_CONFIG, failed to parse: unexpected end of JSON input"),
 		},
+		{
+			name: "Invalid EXECUTOR_KUBERNETES_QUEUE_SCHEDULING queue name",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_KUBERNETES_QUEUE_SCHEDULING":
+					return `{"foo": {"nodeSelector": {"pool": "foo"}}}`
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("EXECUTOR_KUBERNETES_QUEUE_SCHEDULING contains invalid queue name 'foo', valid names are 'batches, codeintel'"),
+		},
 		{
 			name: "Invalid frontend URL",
 			getterFunc: func(name string, defaultValue, description string) string {
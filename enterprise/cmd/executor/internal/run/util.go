@@ -71,9 +71,11 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 			FirecrackerOptions: firecrackerOptions(c),
 			KubernetesOptions:  kubernetesOptions(c),
 		},
-		GitServicePath: "/.executors/git",
-		QueueOptions:   queueOptions(c, queueTelemetryOptions),
-		FilesOptions:   filesOptions(c),
+		GitServicePath:   "/.executors/git",
+		QueueOptions:     queueOptions(c, queueTelemetryOptions),
+		FilesOptions:     filesOptions(c),
+		StepCacheEnabled: c.StepCacheEnabled,
+		StepCacheOptions: baseClientOptions(c, "/.executors/step-cache"),
 		RedactedValues: map[string]string{
 			// 🚨 SECURITY: Catch uses of the shared frontend token used to clone
 			// git repositories that make it into commands or stdout/stderr streams.
@@ -205,6 +207,13 @@ func kubernetesOptions(c *config.Config) runner.KubernetesOptions {
 	}
 	fsGroup := pointer.Int64(int64(c.KubernetesSecurityContextFSGroup))
 	deadline := pointer.Int64(int64(c.KubernetesJobDeadline))
+	queueScheduling := make(map[string]command.KubernetesScheduling, len(c.KubernetesQueueScheduling))
+	for queueName, scheduling := range c.KubernetesQueueScheduling {
+		queueScheduling[queueName] = command.KubernetesScheduling{
+			NodeSelector: scheduling.NodeSelector,
+			Tolerations:  scheduling.Tolerations,
+		}
+	}
 	return runner.KubernetesOptions{
 		Enabled:    config.IsKubernetes(),
 		ConfigPath: c.KubernetesConfigPath,
@@ -223,6 +232,7 @@ func kubernetesOptions(c *config.Config) runner.KubernetesOptions {
 			PodAffinity:           c.KubernetesPodAffinity,
 			PodAntiAffinity:       c.KubernetesPodAntiAffinity,
 			Tolerations:           c.KubernetesNodeTolerations,
+			QueueScheduling:       queueScheduling,
 			Namespace:             c.KubernetesNamespace,
 			PersistenceVolumeName: c.KubernetesPersistenceVolumeName,
 			ResourceLimit:         resourceLimit,
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/workspace"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	executorutil "github.com/sourcegraph/sourcegraph/enterprise/internal/executor/util"
+)
+
+// runCachedStep invokes run for the given spec unless a step with identical inputs has been
+// run before, in which case the changes it made to the workspace are restored instead.
+//
+// The step cache is best-effort: any error while computing keys, reading or writing entries
+// is logged and the step is run as if no cache were configured.
+func (h *handler) runCachedStep(
+	ctx context.Context,
+	logger log.Logger,
+	commandLogger cmdlogger.Logger,
+	job types.Job,
+	ws workspace.Workspace,
+	spec runner.Spec,
+	run func() error,
+) error {
+	if !h.isCacheable(job, spec) {
+		return run()
+	}
+
+	if job.Queue == "" {
+		job.Queue = h.options.QueueName
+	}
+	repoDir := filepath.Join(ws.WorkingDirectory(), job.RepositoryDirectory)
+	stepKey := spec.CommandSpecs[0].Key
+	step := cache.Step{
+		Image:            spec.Image,
+		ScriptPath:       filepath.Join(ws.WorkingDirectory(), files.ScriptsPath, spec.ScriptPath),
+		Dir:              spec.CommandSpecs[0].Dir,
+		Env:              spec.CommandSpecs[0].Env,
+		WorkingDirectory: ws.WorkingDirectory(),
+	}
+	if index, ok := executorutil.ParseRunKey(stepKey); ok {
+		// The script of a native batch changes run step only invokes the script rendered
+		// by the pre step, and the post step reads the logs of the run step.
+		step.Inputs = executorutil.RunStepInputs(index)
+		step.Outputs = executorutil.RunStepOutputs(index)
+	}
+
+	key, err := h.stepCache.Key(ctx, repoDir, step)
+	if err != nil {
+		logger.Warn("Failed to compute step cache key", log.String("key", stepKey), log.Error(err))
+		return run()
+	}
+
+	found, err := h.stepCache.Restore(ctx, job, repoDir, step, key)
+	if err != nil {
+		logger.Warn("Failed to restore step from cache", log.String("key", stepKey), log.Error(err))
+	} else if found {
+		logger.Info("Restored step from cache", log.String("key", stepKey))
+
+		handle := commandLogger.LogEntry(stepKey, nil)
+		fmt.Fprintf(handle, "Restored the result of an identical previous execution (tree %s)\n", key.TreeHash)
+		handle.Finalize(0)
+		handle.Close()
+		return nil
+	}
+
+	if err := run(); err != nil {
+		return err
+	}
+
+	if err := h.stepCache.Save(ctx, job, repoDir, step, key); err != nil {
+		logger.Warn("Failed to save step to cache", log.String("key", stepKey), log.Error(err))
+	}
+
+	return nil
+}
+
+// isCacheable returns true if the result of the given spec can be served from the step cache.
+// Only steps that run a script against a cloned repository are cached. Pre and post steps of
+// native batch changes execution are bookkeeping steps and always run.
+func (h *handler) isCacheable(job types.Job, spec runner.Spec) bool {
+	if h.stepCache == nil || job.RepositoryName == "" || spec.ScriptPath == "" || len(spec.CommandSpecs) != 1 {
+		return false
+	}
+
+	key := spec.CommandSpecs[0].Key
+	return !executorutil.IsPreStepKey(key) && !executorutil.IsPostStepKey(key)
+}
@@ -0,0 +1,335 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Store persists the results of previously executed steps, addressed by the hash of
+// everything that went into running them.
+type Store interface {
+	// Get returns the entry recorded for the given key. If no entry exists, a false-valued
+	// flag is returned.
+	Get(ctx context.Context, job types.Job, key string) (entry []byte, found bool, err error)
+	// Set records the entry of the step with the given key.
+	Set(ctx context.Context, job types.Job, key string, entry []byte) error
+}
+
+// entry is the result of a step as recorded in the store.
+type entry struct {
+	// Diff holds the changes the step made to the repository.
+	Diff []byte `json:"diff,omitempty"`
+	// Outputs holds the contents of the files the step wrote outside of the repository,
+	// by their path relative to the working directory.
+	Outputs map[string][]byte `json:"outputs,omitempty"`
+}
+
+// Key identifies the inputs of a single step. Two steps with the same key are expected to
+// produce the same changes to the workspace.
+type Key struct {
+	// Image is the image reference as written in the step.
+	Image string
+	// ImageDigest is the resolved identifier of the image, so that moving tags such as
+	// latest do not produce stale hits.
+	ImageDigest string
+	// Script is the hash of the script invoked by the step.
+	Script string
+	// Inputs is the hash of the files read by the step outside of the repository.
+	Inputs string
+	// Dir is the directory the step is invoked in, relative to the workspace.
+	Dir string
+	// Env is the sorted environment of the step.
+	Env []string
+	// TreeHash is the git tree of the workspace before the step is run.
+	TreeHash string
+}
+
+// Hash returns the content address of the key.
+func (k Key) Hash() (string, error) {
+	env := append([]string(nil), k.Env...)
+	sort.Strings(env)
+	k.Env = env
+
+	raw, err := json.Marshal(k)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(raw)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Cache computes step keys and snapshots of a workspace, and restores the changes of
+// previously executed steps from a Store.
+//
+// The workspace is required to be a git repository. Snapshots are written to a temporary
+// index so that the index of the repository itself is never touched by the cache.
+type Cache struct {
+	store         Store
+	cmdRunner     util.CmdRunner
+	resolveDigest bool
+}
+
+// New creates a new Cache. When resolveDigest is true, image references are resolved to
+// their digest via the local docker daemon, and steps whose image cannot be resolved are
+// not cached.
+func New(store Store, cmdRunner util.CmdRunner, resolveDigest bool) *Cache {
+	return &Cache{
+		store:         store,
+		cmdRunner:     cmdRunner,
+		resolveDigest: resolveDigest,
+	}
+}
+
+// Step holds the information about a step required to compute its key.
+type Step struct {
+	Image      string
+	ScriptPath string
+	Dir        string
+	Env        []string
+
+	// WorkingDirectory is the directory that Inputs and Outputs are relative to.
+	WorkingDirectory string
+	// Inputs are glob patterns of files and directories outside of the repository that
+	// are read by the step, such as scripts the step script delegates to.
+	Inputs []string
+	// Outputs are glob patterns of files outside of the repository that are written by
+	// the step and consumed by later steps. They are recorded and restored along with the
+	// changes to the repository.
+	Outputs []string
+}
+
+// Key returns the key for the given step when invoked in the given repository directory.
+func (c *Cache) Key(ctx context.Context, repoDir string, step Step) (Key, error) {
+	script, err := os.ReadFile(step.ScriptPath)
+	if err != nil {
+		return Key{}, errors.Wrap(err, "reading step script")
+	}
+	scriptHash := sha256.Sum256(script)
+
+	inputsHash, err := hashInputs(step.WorkingDirectory, step.Inputs)
+	if err != nil {
+		return Key{}, errors.Wrap(err, "hashing step inputs")
+	}
+
+	var digest string
+	if c.resolveDigest && step.Image != "" {
+		out, err := c.cmdRunner.CombinedOutput(ctx, "docker", "image", "inspect", "--format", "{{.Id}}", step.Image)
+		if err != nil {
+			return Key{}, errors.Wrapf(err, "resolving image digest: %s", out)
+		}
+		digest = strings.TrimSpace(string(out))
+	}
+
+	treeHash, err := c.TreeHash(ctx, repoDir)
+	if err != nil {
+		return Key{}, err
+	}
+
+	return Key{
+		Image:       step.Image,
+		ImageDigest: digest,
+		Script:      hex.EncodeToString(scriptHash[:]),
+		Inputs:      inputsHash,
+		Dir:         step.Dir,
+		Env:         step.Env,
+		TreeHash:    treeHash,
+	}, nil
+}
+
+// Restore applies the changes recorded for the given key to the repository directory and
+// writes the recorded outputs of the step. If there is no entry for the key, a false-valued
+// flag is returned and the workspace is left untouched.
+func (c *Cache) Restore(ctx context.Context, job types.Job, repoDir string, step Step, key Key) (bool, error) {
+	hash, err := key.Hash()
+	if err != nil {
+		return false, err
+	}
+
+	raw, found, err := c.store.Get(ctx, job, hash)
+	if err != nil || !found {
+		return false, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return false, errors.Wrap(err, "decoding cache entry")
+	}
+
+	if len(e.Diff) > 0 {
+		cmd := c.cmdRunner.CommandContext(ctx, "git", "apply", "--binary", "--whitespace=nowarn", "-")
+		cmd.Dir = repoDir
+		cmd.Stdin = bytes.NewReader(e.Diff)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return false, errors.Wrapf(err, "applying cached diff: %s", out)
+		}
+	}
+
+	for name, content := range e.Outputs {
+		path := filepath.Join(step.WorkingDirectory, filepath.FromSlash(name))
+		if !strings.HasPrefix(path, filepath.Clean(step.WorkingDirectory)+string(filepath.Separator)) {
+			return false, errors.Newf("cached output %q is outside of the working directory", name)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return false, errors.Wrap(err, "creating cached output directory")
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return false, errors.Wrap(err, "writing cached output")
+		}
+	}
+
+	return true, nil
+}
+
+// Save records the changes made to the repository directory since the snapshot in the key
+// was taken, along with the outputs of the step.
+func (c *Cache) Save(ctx context.Context, job types.Job, repoDir string, step Step, key Key) error {
+	hash, err := key.Hash()
+	if err != nil {
+		return err
+	}
+
+	treeHash, err := c.TreeHash(ctx, repoDir)
+	if err != nil {
+		return err
+	}
+
+	var e entry
+	if treeHash != key.TreeHash {
+		if e.Diff, err = c.git(ctx, repoDir, nil, "diff", "--binary", "--no-color", "--no-ext-diff", key.TreeHash, treeHash); err != nil {
+			return errors.Wrap(err, "computing step diff")
+		}
+	}
+
+	outputs, err := glob(step.WorkingDirectory, step.Outputs)
+	if err != nil {
+		return err
+	}
+	for _, name := range outputs {
+		content, err := os.ReadFile(filepath.Join(step.WorkingDirectory, name))
+		if err != nil {
+			return errors.Wrap(err, "reading step output")
+		}
+		if e.Outputs == nil {
+			e.Outputs = map[string][]byte{}
+		}
+		e.Outputs[filepath.ToSlash(name)] = content
+	}
+
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return c.store.Set(ctx, job, hash, raw)
+}
+
+// hashInputs returns the hash of the paths and contents of all files matching the given
+// patterns. Matched directories are hashed recursively.
+func hashInputs(dir string, patterns []string) (string, error) {
+	matches, err := glob(dir, patterns)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, match := range matches {
+		err := filepath.Walk(filepath.Join(dir, match), func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			// Length-prefix both values so that different splits never collide.
+			fmt.Fprintf(h, "%d:%s%d:", len(rel), filepath.ToSlash(rel), len(content))
+			h.Write(content)
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// glob returns the sorted paths relative to dir that match any of the given patterns.
+func glob(dir string, patterns []string) ([]string, error) {
+	seen := map[string]struct{}{}
+	var matches []string
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %q", pattern)
+		}
+		for _, path := range paths {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := seen[rel]; ok {
+				continue
+			}
+			seen[rel] = struct{}{}
+			matches = append(matches, rel)
+		}
+	}
+
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// TreeHash writes the current state of the repository directory to the object database and
+// returns the hash of the resulting tree. Scripts written by the executor are excluded.
+func (c *Cache) TreeHash(ctx context.Context, repoDir string) (string, error) {
+	indexDir, err := os.MkdirTemp("", "executor-step-cache-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(indexDir)
+
+	env := []string{"GIT_INDEX_FILE=" + filepath.Join(indexDir, "index")}
+	if _, err := c.git(ctx, repoDir, env, "add", "--all", "--", ".", ":(exclude)"+files.ScriptsPath); err != nil {
+		return "", errors.Wrap(err, "staging workspace")
+	}
+	out, err := c.git(ctx, repoDir, env, "write-tree")
+	if err != nil {
+		return "", errors.Wrap(err, "writing workspace tree")
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (c *Cache) git(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	cmd := c.cmdRunner.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "git %s: %s", args[0], stderr.String())
+	}
+	return out, nil
+}
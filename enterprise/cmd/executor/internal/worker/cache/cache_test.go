@@ -0,0 +1,213 @@
+package cache_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+)
+
+func TestKey_Hash(t *testing.T) {
+	key := cache.Key{
+		Image:    "alpine:3",
+		Script:   "abc",
+		Env:      []string{"B=2", "A=1"},
+		TreeHash: "def",
+	}
+
+	hash, err := key.Hash()
+	require.NoError(t, err)
+
+	reordered := key
+	reordered.Env = []string{"A=1", "B=2"}
+	reorderedHash, err := reordered.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, hash, reorderedHash)
+
+	changed := key
+	changed.TreeHash = "ghi"
+	changedHash, err := changed.Hash()
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changedHash)
+}
+
+func TestCache_SaveRestore(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+	store := &memoryStore{entries: map[string][]byte{}}
+	c := cache.New(store, &util.RealCmdRunner{}, false)
+	job := types.Job{ID: 42}
+
+	step := cache.Step{
+		Image: "alpine:3",
+		Dir:   ".",
+		Env:   []string{"FOO=bar"},
+	}
+
+	// First run: no entry, run the "step" and record its changes.
+	first := newRepo(t)
+	step.ScriptPath = writeScript(t, first, "echo hello > hello.txt")
+	key, err := c.Key(ctx, first, step)
+	require.NoError(t, err)
+
+	found, err := c.Restore(ctx, job, first, step, key)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, os.WriteFile(filepath.Join(first, "hello.txt"), []byte("hello\n"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(first, "README.md")))
+	require.NoError(t, c.Save(ctx, job, first, step, key))
+	require.Len(t, store.entries, 1)
+
+	// Second run: identical inputs in a fresh workspace restore the changes.
+	second := newRepo(t)
+	step.ScriptPath = writeScript(t, second, "echo hello > hello.txt")
+	key, err = c.Key(ctx, second, step)
+	require.NoError(t, err)
+
+	found, err = c.Restore(ctx, job, second, step, key)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	content, err := os.ReadFile(filepath.Join(second, "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", string(content))
+	_, err = os.Stat(filepath.Join(second, "README.md"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Changing the script invalidates the entry.
+	third := newRepo(t)
+	step.ScriptPath = writeScript(t, third, "echo goodbye > hello.txt")
+	key, err = c.Key(ctx, third, step)
+	require.NoError(t, err)
+
+	found, err = c.Restore(ctx, job, third, step, key)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestCache_InputsOutputs(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+	store := &memoryStore{entries: map[string][]byte{}}
+	c := cache.New(store, &util.RealCmdRunner{}, false)
+	job := types.Job{ID: 42}
+
+	// newWorkspace creates a workspace in which the step script only delegates to a script
+	// outside of the repository, like the run steps of native batch changes execution.
+	newWorkspace := func(script string) (string, string, cache.Step) {
+		workingDir := t.TempDir()
+		repoDir := filepath.Join(workingDir, "repository")
+		require.NoError(t, os.Rename(newRepo(t), repoDir))
+		require.NoError(t, os.WriteFile(filepath.Join(workingDir, "step0.sh"), []byte(script), 0755))
+
+		return workingDir, repoDir, cache.Step{
+			Image:            "alpine:3",
+			ScriptPath:       writeScript(t, repoDir, `exec "../step0.sh" | tee ../stdout0.log`),
+			Dir:              ".",
+			WorkingDirectory: workingDir,
+			Inputs:           []string{"step0.sh", "step0files"},
+			Outputs:          []string{"stdout0.log", "stderr0.log", "outputs/*.json"},
+		}
+	}
+
+	first, firstRepo, step := newWorkspace("echo hello > hello.txt")
+	key, err := c.Key(ctx, firstRepo, step)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(firstRepo, "hello.txt"), []byte("hello\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(first, "stdout0.log"), []byte("out"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(first, "stderr0.log"), nil, 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(first, "outputs"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(first, "outputs", "result.json"), []byte("{}"), 0644))
+	require.NoError(t, c.Save(ctx, job, firstRepo, step, key))
+
+	// A different delegated script with the same step script does not hit the entry.
+	_, otherRepo, otherStep := newWorkspace("echo goodbye > hello.txt")
+	otherKey, err := c.Key(ctx, otherRepo, otherStep)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+	found, err := c.Restore(ctx, job, otherRepo, otherStep, otherKey)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// The same delegated script restores the changes and the outputs of the step.
+	second, secondRepo, step := newWorkspace("echo hello > hello.txt")
+	key, err = c.Key(ctx, secondRepo, step)
+	require.NoError(t, err)
+	found, err = c.Restore(ctx, job, secondRepo, step, key)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	content, err := os.ReadFile(filepath.Join(secondRepo, "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", string(content))
+	content, err = os.ReadFile(filepath.Join(second, "stdout0.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "out", string(content))
+	_, err = os.Stat(filepath.Join(second, "stderr0.log"))
+	assert.NoError(t, err)
+
+	// Outputs in nested directories are restored without write access for other users.
+	info, err := os.Stat(filepath.Join(second, "outputs", "result.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+}
+
+type memoryStore struct {
+	entries map[string][]byte
+}
+
+func (s *memoryStore) Get(_ context.Context, _ types.Job, key string) ([]byte, bool, error) {
+	diff, ok := s.entries[key]
+	return diff, ok, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, _ types.Job, key string, diff []byte) error {
+	s.entries[key] = diff
+	return nil
+}
+
+func newRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# test\n"), 0644))
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "README.md"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return dir
+}
+
+func writeScript(t *testing.T, dir, content string) string {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, files.ScriptsPath), os.ModePerm))
+	path := filepath.Join(dir, files.ScriptsPath, "step.sh")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0755))
+	return path
+}
@@ -0,0 +1,119 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runtime"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	executorutil "github.com/sourcegraph/sourcegraph/enterprise/internal/executor/util"
+)
+
+func TestHandler_RunCachedStep_NativeRunStep(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	h := &handler{stepCache: cache.New(&memoryStepStore{entries: map[string][]byte{}}, &util.RealCmdRunner{}, false)}
+	job := types.Job{ID: 42, RepositoryName: "my-repo", RepositoryDirectory: "repository"}
+
+	// runStep runs the first step of a native batch spec whose pre step rendered the given
+	// script. The script of the run step itself only delegates to the rendered script, so it
+	// is the same for every batch spec.
+	runStep := func(rendered string) (workingDir string, ran bool) {
+		workingDir = t.TempDir()
+		repoDir := filepath.Join(workingDir, job.RepositoryDirectory)
+		initRepo(t, repoDir)
+		require.NoError(t, os.WriteFile(filepath.Join(workingDir, "step0.sh"), []byte(rendered), 0755))
+		require.NoError(t, os.MkdirAll(filepath.Join(workingDir, files.ScriptsPath), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(workingDir, files.ScriptsPath, "step.0.sh"), []byte(`exec "../step0.sh" > ../stdout0.log`), 0755))
+
+		ws := NewMockWorkspace()
+		ws.WorkingDirectoryFunc.SetDefaultReturn(workingDir)
+		commandLogger := NewMockLogger()
+		commandLogger.LogEntryFunc.SetDefaultReturn(NewMockLogEntry())
+
+		spec := runner.Spec{
+			CommandSpecs: []command.Spec{{
+				Key: runtime.CommandKey(runtime.NameDocker, executorutil.FormatRunKey(0), 0),
+				Dir: job.RepositoryDirectory,
+			}},
+			Image:      "alpine:3",
+			ScriptPath: "step.0.sh",
+		}
+		run := func() error {
+			ran = true
+			if err := os.WriteFile(filepath.Join(repoDir, "hello.txt"), []byte(rendered+"\n"), 0644); err != nil {
+				return err
+			}
+			return os.WriteFile(filepath.Join(workingDir, "stdout0.log"), []byte(rendered), 0644)
+		}
+
+		require.NoError(t, h.runCachedStep(ctx, logger, commandLogger, job, ws, spec, run))
+		return workingDir, ran
+	}
+
+	_, ran := runStep("echo hello")
+	assert.True(t, ran)
+
+	// Another batch spec renders a different script for the same step and must not hit
+	// the entry of the first one.
+	_, ran = runStep("echo goodbye")
+	assert.True(t, ran)
+
+	// The same rendered script restores the changes and the logs read by the post step.
+	workingDir, ran := runStep("echo hello")
+	assert.False(t, ran)
+
+	content, err := os.ReadFile(filepath.Join(workingDir, job.RepositoryDirectory, "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "echo hello\n", string(content))
+	content, err = os.ReadFile(filepath.Join(workingDir, "stdout0.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "echo hello", string(content))
+}
+
+type memoryStepStore struct {
+	entries map[string][]byte
+}
+
+func (s *memoryStepStore) Get(_ context.Context, _ types.Job, key string) ([]byte, bool, error) {
+	entry, ok := s.entries[key]
+	return entry, ok, nil
+}
+
+func (s *memoryStepStore) Set(_ context.Context, _ types.Job, key string, entry []byte) error {
+	s.entries[key] = entry
+	return nil
+}
+
+func initRepo(t *testing.T, dir string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# test\n"), 0644))
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "README.md"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+}
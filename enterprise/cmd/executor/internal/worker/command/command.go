@@ -51,6 +51,11 @@ type Spec struct {
 	Env       []string
 	Image     string
 	Operation *observation.Operation
+
+	// ResourceLimit and ResourceRequest override the configured resources of the
+	// container running this spec. Only used by the Kubernetes runtime.
+	ResourceLimit   KubernetesResource
+	ResourceRequest KubernetesResource
 }
 
 func (c *RealCommand) Run(ctx context.Context, cmdLogger cmdlogger.Logger, spec Spec) (err error) {
@@ -50,6 +50,7 @@ type KubernetesContainerOptions struct {
 	PodAffinity           []corev1.PodAffinityTerm
 	PodAntiAffinity       []corev1.PodAffinityTerm
 	Tolerations           []corev1.Toleration
+	QueueScheduling       map[string]KubernetesScheduling
 	PersistenceVolumeName string
 	ResourceLimit         KubernetesResource
 	ResourceRequest       KubernetesResource
@@ -75,6 +76,42 @@ type KubernetesNodeAffinity struct {
 	MatchFields      []corev1.NodeSelectorRequirement
 }
 
+// KubernetesScheduling contains additional scheduling constraints for the Jobs of a single queue.
+type KubernetesScheduling struct {
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
+}
+
+// ForQueue returns a copy of the options with the scheduling constraints configured for the given
+// queue merged into the global node selector and tolerations. Queue specific node selector values
+// take precedence over global values with the same key.
+func (o KubernetesContainerOptions) ForQueue(queueName string) KubernetesContainerOptions {
+	scheduling, ok := o.QueueScheduling[queueName]
+	if !ok {
+		return o
+	}
+
+	if len(scheduling.NodeSelector) > 0 {
+		nodeSelector := make(map[string]string, len(o.NodeSelector)+len(scheduling.NodeSelector))
+		for k, v := range o.NodeSelector {
+			nodeSelector[k] = v
+		}
+		for k, v := range scheduling.NodeSelector {
+			nodeSelector[k] = v
+		}
+		o.NodeSelector = nodeSelector
+	}
+
+	if len(scheduling.Tolerations) > 0 {
+		tolerations := make([]corev1.Toleration, 0, len(o.Tolerations)+len(scheduling.Tolerations))
+		tolerations = append(tolerations, o.Tolerations...)
+		tolerations = append(tolerations, scheduling.Tolerations...)
+		o.Tolerations = tolerations
+	}
+
+	return o
+}
+
 // KubernetesResource contains the CPU and memory resources for a Kubernetes Job.
 type KubernetesResource struct {
 	CPU    resource.Quantity
@@ -431,8 +468,8 @@ func NewKubernetesJob(name string, image string, spec Spec, path string, options
 	jobEnvs := newEnvVars(spec.Env)
 
 	affinity := newAffinity(options)
-	resourceLimit := newResourceLimit(options)
-	resourceRequest := newResourceRequest(options)
+	resourceLimit := newResourceList(options.ResourceLimit, spec.ResourceLimit)
+	resourceRequest := newResourceList(options.ResourceRequest, spec.ResourceRequest)
 
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -512,8 +549,8 @@ func NewKubernetesSingleJob(
 ) *batchv1.Job {
 	affinity := newAffinity(options)
 
-	resourceLimit := newResourceLimit(options)
-	resourceRequest := newResourceRequest(options)
+	resourceLimit := newResourceList(options.ResourceLimit, KubernetesResource{})
+	resourceRequest := newResourceList(options.ResourceRequest, KubernetesResource{})
 
 	volumes := make([]corev1.Volume, len(options.JobVolume.Volumes)+1)
 	switch options.JobVolume.Type {
@@ -637,8 +674,8 @@ func NewKubernetesSingleJob(
 			Env:        jobEnvs,
 			WorkingDir: filepath.Join(KubernetesJobMountPath, step.Dir),
 			Resources: corev1.ResourceRequirements{
-				Limits:   resourceLimit,
-				Requests: resourceRequest,
+				Limits:   newResourceList(options.ResourceLimit, step.ResourceLimit),
+				Requests: newResourceList(options.ResourceRequest, step.ResourceRequest),
 			},
 			VolumeMounts: mounts,
 		}
@@ -748,24 +785,25 @@ func newAffinity(options KubernetesContainerOptions) *corev1.Affinity {
 	return affinity
 }
 
-func newResourceLimit(options KubernetesContainerOptions) corev1.ResourceList {
-	resourceLimit := corev1.ResourceList{
-		corev1.ResourceMemory: options.ResourceLimit.Memory,
+// newResourceList returns the resource list for a container. Values set on the step take
+// precedence over the configured defaults.
+func newResourceList(defaults KubernetesResource, step KubernetesResource) corev1.ResourceList {
+	memory := defaults.Memory
+	if !step.Memory.IsZero() {
+		memory = step.Memory
 	}
-	if !options.ResourceLimit.CPU.IsZero() {
-		resourceLimit[corev1.ResourceCPU] = options.ResourceLimit.CPU
+	cpu := defaults.CPU
+	if !step.CPU.IsZero() {
+		cpu = step.CPU
 	}
-	return resourceLimit
-}
 
-func newResourceRequest(options KubernetesContainerOptions) corev1.ResourceList {
-	resourceRequest := corev1.ResourceList{
-		corev1.ResourceMemory: options.ResourceRequest.Memory,
+	resources := corev1.ResourceList{
+		corev1.ResourceMemory: memory,
 	}
-	if !options.ResourceRequest.CPU.IsZero() {
-		resourceRequest[corev1.ResourceCPU] = options.ResourceRequest.CPU
+	if !cpu.IsZero() {
+		resources[corev1.ResourceCPU] = cpu
 	}
-	return resourceRequest
+	return resources
 }
 
 func formatContent(content string) string {
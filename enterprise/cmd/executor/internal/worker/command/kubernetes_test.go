@@ -623,6 +623,12 @@ func TestNewKubernetesSingleJob(t *testing.T) {
 			Env:     []string{"FOO=baz"},
 			Dir:     "repository",
 			Image:   "my-image:latest",
+			ResourceLimit: command.KubernetesResource{
+				CPU: resource.MustParse("2"),
+			},
+			ResourceRequest: command.KubernetesResource{
+				Memory: resource.MustParse("4Gi"),
+			},
 		},
 	}
 	workspaceFiles := []files.WorkspaceFile{
@@ -758,6 +764,23 @@ func TestNewKubernetesSingleJob(t *testing.T) {
 	require.Len(t, job.Spec.Template.Spec.InitContainers[2].Env, 1)
 	assert.Equal(t, "FOO", job.Spec.Template.Spec.InitContainers[2].Env[0].Name)
 	assert.Equal(t, "baz", job.Spec.Template.Spec.InitContainers[2].Env[0].Value)
+
+	assert.Equal(
+		t,
+		corev1.ResourceRequirements{
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("10Gi")},
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+		job.Spec.Template.Spec.InitContainers[1].Resources,
+	)
+	assert.Equal(
+		t,
+		corev1.ResourceRequirements{
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("10Gi")},
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("4Gi")},
+		},
+		job.Spec.Template.Spec.InitContainers[2].Resources,
+	)
 	require.Len(t, job.Spec.Template.Spec.InitContainers[2].VolumeMounts, 1)
 	assert.Equal(t, "job-data", job.Spec.Template.Spec.InitContainers[2].VolumeMounts[0].Name)
 	assert.Equal(t, "/job", job.Spec.Template.Spec.InitContainers[2].VolumeMounts[0].MountPath)
@@ -775,3 +798,40 @@ func TestNewKubernetesSingleJob(t *testing.T) {
 	assert.Equal(t, resource.MustParse("1"), *job.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu())
 	assert.Equal(t, resource.MustParse("1Gi"), *job.Spec.Template.Spec.Containers[0].Resources.Requests.Memory())
 }
+
+func TestKubernetesContainerOptions_ForQueue(t *testing.T) {
+	options := command.KubernetesContainerOptions{
+		NodeSelector: map[string]string{"app": "executor", "pool": "default"},
+		Tolerations:  []corev1.Toleration{{Key: "foo", Operator: corev1.TolerationOpExists}},
+		QueueScheduling: map[string]command.KubernetesScheduling{
+			"batches": {
+				NodeSelector: map[string]string{"pool": "batches"},
+				Tolerations:  []corev1.Toleration{{Key: "dedicated", Value: "batches", Operator: corev1.TolerationOpEqual}},
+			},
+		},
+	}
+
+	t.Run("Queue with scheduling", func(t *testing.T) {
+		actual := options.ForQueue("batches")
+
+		assert.Equal(t, map[string]string{"app": "executor", "pool": "batches"}, actual.NodeSelector)
+		assert.Equal(
+			t,
+			[]corev1.Toleration{
+				{Key: "foo", Operator: corev1.TolerationOpExists},
+				{Key: "dedicated", Value: "batches", Operator: corev1.TolerationOpEqual},
+			},
+			actual.Tolerations,
+		)
+		// The global options are not modified.
+		assert.Equal(t, "default", options.NodeSelector["pool"])
+		assert.Len(t, options.Tolerations, 1)
+	})
+
+	t.Run("Queue without scheduling", func(t *testing.T) {
+		actual := options.ForQueue("codeintel")
+
+		assert.Equal(t, options.NodeSelector, actual.NodeSelector)
+		assert.Equal(t, options.Tolerations, actual.Tolerations)
+	})
+}
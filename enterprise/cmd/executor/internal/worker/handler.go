@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/ignite"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cmdlogger"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
@@ -35,6 +36,7 @@ type handler struct {
 	cloneOptions workspace.CloneOptions
 	operations   *command.Operations
 	jobRuntime   runtime.Runtime
+	stepCache    *cache.Cache
 }
 
 var (
@@ -146,7 +148,9 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 
 	// Get the commands we will execute.
 	logger.Info("Creating commands")
-	job.Queue = h.options.QueueName
+	if job.Queue == "" {
+		job.Queue = h.options.QueueName
+	}
 	commands, err := h.jobRuntime.NewRunnerSpecs(ws, job)
 	if err != nil {
 		return errors.Wrap(err, "creating commands")
@@ -162,7 +166,14 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 			// We have a match, so reset the skip key.
 			skipKey = ""
 		}
-		if err := runtimeRunner.Run(ctx, spec); err != nil {
+		run := func() error { return runtimeRunner.Run(ctx, spec) }
+		if h.jobRuntime.Name() == runtime.NameDocker || h.jobRuntime.Name() == runtime.NameShell {
+			// The workspace of these runtimes lives on the host, so it can be snapshotted
+			// and restored from the step cache.
+			if err := h.runCachedStep(ctx, logger, commandLogger, job, ws, spec, run); err != nil {
+				return errors.Wrapf(err, "running command %q", spec.CommandSpecs[0].Key)
+			}
+		} else if err := run(); err != nil {
 			return errors.Wrapf(err, "running command %q", spec.CommandSpecs[0].Key)
 		}
 		if executorutil.IsPreStepKey(spec.CommandSpecs[0].Key) {
@@ -273,7 +284,13 @@ func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger c
 
 		logger.Info(fmt.Sprintf("Running docker step #%d", i))
 
-		if err = jobRunner.Run(ctx, dockerStepCommand); err != nil {
+		run := func() error { return jobRunner.Run(ctx, dockerStepCommand) }
+		if !h.options.RunnerOptions.FirecrackerOptions.Enabled {
+			err = h.runCachedStep(ctx, logger, commandLogger, job, ws, dockerStepCommand, run)
+		} else {
+			err = run()
+		}
+		if err != nil {
 			return errors.Wrap(err, "failed to perform docker step")
 		}
 	}
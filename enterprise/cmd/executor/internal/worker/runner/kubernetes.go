@@ -115,6 +115,8 @@ func (r *kubernetesRunner) Teardown(ctx context.Context) error {
 }
 
 func (r *kubernetesRunner) Run(ctx context.Context, spec Spec) error {
+	options := r.options.ForQueue(spec.Job.Queue)
+
 	var job *batchv1.Job
 	if r.options.SingleJobPod {
 		workspaceFiles, err := files.GetWorkspaceFiles(ctx, r.filesStore, spec.Job, command.KubernetesJobMountPath)
@@ -155,7 +157,7 @@ func (r *kubernetesRunner) Run(ctx context.Context, spec Spec) error {
 			secrets,
 			r.volumeName,
 			repoOptions,
-			r.options,
+			options,
 		)
 	} else {
 		job = command.NewKubernetesJob(
@@ -163,7 +165,7 @@ func (r *kubernetesRunner) Run(ctx context.Context, spec Spec) error {
 			spec.Image,
 			spec.CommandSpecs[0],
 			r.dir,
-			r.options,
+			options,
 		)
 	}
 	r.internalLogger.Debug("Creating job", log.Int("jobID", spec.Job.ID))
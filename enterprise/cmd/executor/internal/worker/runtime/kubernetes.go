@@ -6,12 +6,15 @@ import (
 	"path/filepath"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cmdlogger"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/files"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/workspace"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type kubernetesRuntime struct {
@@ -62,6 +65,11 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 		for i, step := range job.DockerSteps {
 			scriptName := files.ScriptNameFromJobStep(job, i)
 
+			resourceLimit, resourceRequest, err := newStepResources(step)
+			if err != nil {
+				return nil, err
+			}
+
 			key := kubernetesKey(step.Key, i)
 			specs[i] = command.Spec{
 				Key:  key,
@@ -70,9 +78,11 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 					"/bin/sh -c " +
 						filepath.Join(command.KubernetesJobMountPath, files.ScriptsPath, scriptName),
 				},
-				Dir:   step.Dir,
-				Env:   step.Env,
-				Image: step.Image,
+				Dir:             step.Dir,
+				Env:             step.Env,
+				Image:           step.Image,
+				ResourceLimit:   resourceLimit,
+				ResourceRequest: resourceRequest,
 			}
 		}
 		spec.CommandSpecs = specs
@@ -81,6 +91,11 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 	} else {
 		runnerSpecs := make([]runner.Spec, len(job.DockerSteps))
 		for i, step := range job.DockerSteps {
+			resourceLimit, resourceRequest, err := newStepResources(step)
+			if err != nil {
+				return nil, err
+			}
+
 			key := kubernetesKey(step.Key, i)
 			runnerSpecs[i] = runner.Spec{
 				Job: job,
@@ -93,9 +108,11 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 							"-c",
 							filepath.Join(command.KubernetesJobMountPath, files.ScriptsPath, ws.ScriptFilenames()[i]),
 						},
-						Dir:       step.Dir,
-						Env:       step.Env,
-						Operation: r.operations.Exec,
+						Dir:             step.Dir,
+						Env:             step.Env,
+						Operation:       r.operations.Exec,
+						ResourceLimit:   resourceLimit,
+						ResourceRequest: resourceRequest,
 					},
 				},
 				Image: step.Image,
@@ -111,3 +128,33 @@ func kubernetesKey(stepKey string, index int) string {
 	}
 	return fmt.Sprintf("step.kubernetes.%d", index)
 }
+
+// newStepResources parses the resources declared by the given step. Resources that are not
+// declared are left as zero values so the configured defaults are used.
+func newStepResources(step types.DockerStep) (limit command.KubernetesResource, request command.KubernetesResource, err error) {
+	if step.Resources == nil {
+		return limit, request, nil
+	}
+
+	for _, r := range []struct {
+		name     string
+		value    string
+		quantity *resource.Quantity
+	}{
+		{name: "cpuLimit", value: step.Resources.CPULimit, quantity: &limit.CPU},
+		{name: "memoryLimit", value: step.Resources.MemoryLimit, quantity: &limit.Memory},
+		{name: "cpuRequest", value: step.Resources.CPURequest, quantity: &request.CPU},
+		{name: "memoryRequest", value: step.Resources.MemoryRequest, quantity: &request.Memory},
+	} {
+		if r.value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(r.value)
+		if err != nil {
+			return limit, request, errors.Wrapf(err, "invalid %s for step %q", r.name, step.Key)
+		}
+		*r.quantity = q
+	}
+
+	return limit, request, nil
+}
@@ -5,11 +5,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 )
 
 func TestKubernetesRuntime_Name(t *testing.T) {
@@ -193,6 +196,68 @@ func TestKubernetesRuntime_NewRunnerSpecs(t *testing.T) {
 				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
 			},
 		},
+		{
+			name:      "Single job with step resources",
+			singleJob: true,
+			job: types.Job{
+				ID:             42,
+				RepositoryName: "github.com/sourcegraph/sourcegraph",
+				Commit:         "deadbeef",
+				DockerSteps: []types.DockerStep{
+					{
+						Key:      "my-key",
+						Image:    "my-image",
+						Commands: []string{"echo", "hello"},
+						Dir:      ".",
+						Env:      []string{"FOO=bar"},
+						Resources: &executor.StepResources{
+							CPURequest:    "500m",
+							MemoryRequest: "2Gi",
+							MemoryLimit:   "4Gi",
+						},
+					},
+				},
+			},
+			expected: []runner.Spec{{
+				CommandSpecs: []command.Spec{
+					{
+						Key:     "step.kubernetes.my-key",
+						Name:    "step-kubernetes-my-key",
+						Command: []string{"/bin/sh -c /job/.sourcegraph-executor/42.0_github.com_sourcegraph_sourcegraph@deadbeef.sh"},
+						Dir:     ".",
+						Env:     []string{"FOO=bar"},
+						Image:   "my-image",
+						ResourceLimit: command.KubernetesResource{
+							Memory: resource.MustParse("4Gi"),
+						},
+						ResourceRequest: command.KubernetesResource{
+							CPU:    resource.MustParse("500m"),
+							Memory: resource.MustParse("2Gi"),
+						},
+					},
+				},
+			}},
+			assertMockFunc: func(t *testing.T, ws *MockWorkspace) {
+				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
+			},
+		},
+		{
+			name: "Invalid step resources",
+			job: types.Job{
+				DockerSteps: []types.DockerStep{
+					{
+						Key:       "key-1",
+						Image:     "my-image",
+						Commands:  []string{"echo", "hello"},
+						Resources: &executor.StepResources{CPULimit: "lots"},
+					},
+				},
+			},
+			expectedErr: errors.New("invalid cpuLimit for step \"key-1\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"),
+			assertMockFunc: func(t *testing.T, ws *MockWorkspace) {
+				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -14,9 +14,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/files"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/queue"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/stepcache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runtime"
@@ -64,6 +66,13 @@ type Options struct {
 	// FilesOptions configures the client that interacts with the files API.
 	FilesOptions apiclient.BaseClientOptions
 
+	// StepCacheEnabled determines whether steps with identical inputs to a previously
+	// executed step are restored from the step cache instead of being run.
+	StepCacheEnabled bool
+
+	// StepCacheOptions configures the client that interacts with the step cache API.
+	StepCacheOptions apiclient.BaseClientOptions
+
 	RunnerOptions runner.Options
 
 	// NodeExporterEndpoint is the URL of the local node_exporter endpoint, without
@@ -114,6 +123,16 @@ func NewWorker(observationCtx *observation.Context, nameSet *janitor.NameSet, op
 		return nil, err
 	}
 
+	var stepCache *cache.Cache
+	if options.StepCacheEnabled {
+		stepCacheClient, err := stepcache.New(observationCtx, options.StepCacheOptions)
+		if err != nil {
+			return nil, errors.Wrap(err, "building step cache store")
+		}
+		// The shell runtime runs steps directly on the host, so there is no image to resolve.
+		stepCache = cache.New(stepCacheClient, cmdRunner, !util.HasShellBuildTag())
+	}
+
 	h := &handler{
 		nameSet:      nameSet,
 		cmdRunner:    cmdRunner,
@@ -124,6 +143,7 @@ func NewWorker(observationCtx *observation.Context, nameSet *janitor.NameSet, op
 		cloneOptions: cloneOptions,
 		operations:   commandOps,
 		jobRuntime:   jobRuntime,
+		stepCache:    stepCache,
 	}
 
 	return workerutil.NewWorker[types.Job](context.Background(), queueClient, h, options.WorkerOptions), nil
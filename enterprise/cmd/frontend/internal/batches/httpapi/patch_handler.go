@@ -0,0 +1,118 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PatchHandler serves the mailbox of changesets that are published as patch
+// series, on code hosts that have no pull request API.
+type PatchHandler struct {
+	logger     sglog.Logger
+	db         database.DB
+	store      PatchStore
+	operations *Operations
+}
+
+type PatchStore interface {
+	GetChangeset(context.Context, store.GetChangesetOpts) (*btypes.Changeset, error)
+	GetChangesetSpecByID(context.Context, int64) (*btypes.ChangesetSpec, error)
+}
+
+// NewPatchHandler creates a new PatchHandler.
+func NewPatchHandler(db database.DB, store PatchStore, operations *Operations) *PatchHandler {
+	return &PatchHandler{
+		logger:     sglog.Scoped("PatchHandler", "Batch Changes patch series REST API handler"),
+		db:         db,
+		store:      store,
+		operations: operations,
+	}
+}
+
+// Get retrieves the patch series of a changeset in mailbox format.
+func (h *PatchHandler) Get() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mbox, filename, statusCode, err := h.get(r)
+
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/mbox")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(statusCode)
+
+		if _, err := w.Write(mbox); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *PatchHandler) get(r *http.Request) (_ []byte, filename string, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.patch.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	var changesetID int64
+	if err := relay.UnmarshalSpec(graphql.ID(mux.Vars(r)["changeset"]), &changesetID); err != nil || changesetID == 0 {
+		return nil, "", http.StatusBadRequest, errors.New("invalid changeset ID")
+	}
+
+	changeset, err := h.store.GetChangeset(ctx, store.GetChangesetOpts{ID: changesetID})
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, "", http.StatusNotFound, errors.New("changeset does not exist")
+		}
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "looking up changeset")
+	}
+
+	// 🚨 SECURITY: The patch contains the changes to the repository, so only
+	// users with access to the repository may download it. Repos().Get filters
+	// out repositories the current user can't see.
+	if _, err := h.db.Repos().Get(ctx, changeset.RepoID); err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, "", http.StatusNotFound, errors.New("changeset does not exist")
+		}
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "looking up repository")
+	}
+
+	series, ok := changeset.Metadata.(*patch.Series)
+	if !ok || changeset.CurrentSpecID == 0 {
+		return nil, "", http.StatusNotFound, errors.New("changeset is not published as a patch series")
+	}
+
+	spec, err := h.store.GetChangesetSpecByID(ctx, changeset.CurrentSpecID)
+	if err != nil {
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "looking up changeset spec")
+	}
+
+	mbox := patch.FormatMailbox([]patch.Commit{{
+		Oid:         series.HeadRefOid,
+		AuthorName:  spec.CommitAuthorName,
+		AuthorEmail: spec.CommitAuthorEmail,
+		Date:        spec.CreatedAt,
+		Message:     spec.CommitMessage,
+		Diff:        spec.Diff,
+	}})
+
+	return mbox, series.Branch + ".patch", http.StatusOK, nil
+}
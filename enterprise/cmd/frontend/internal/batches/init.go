@@ -53,12 +53,14 @@ func Init(
 	enterpriseServices.BatchesBitbucketCloudWebhook = webhooks.NewBitbucketCloudWebhook(bstore, gitserverClient, logger)
 	enterpriseServices.BatchesGitLabWebhook = webhooks.NewGitLabWebhook(bstore, gitserverClient, logger)
 	enterpriseServices.BatchesAzureDevOpsWebhook = webhooks.NewAzureDevOpsWebhook(bstore, gitserverClient, logger)
+	enterpriseServices.BatchesGiteaWebhook = webhooks.NewGiteaWebhook(bstore, gitserverClient, logger)
 
 	operations := httpapi.NewOperations(observationCtx)
 	fileHandler := httpapi.NewFileHandler(db, bstore, operations)
 	enterpriseServices.BatchesChangesFileGetHandler = fileHandler.Get()
 	enterpriseServices.BatchesChangesFileExistsHandler = fileHandler.Exists()
 	enterpriseServices.BatchesChangesFileUploadHandler = fileHandler.Upload()
+	enterpriseServices.BatchesChangesPatchHandler = httpapi.NewPatchHandler(db, bstore, operations).Get()
 
 	return nil
 }
@@ -1444,26 +1444,69 @@ func (r *Resolver) MergeChangesets(ctx context.Context, args *graphqlbackend.Mer
 		return nil, err
 	}
 
-	// 🚨 SECURITY: CreateChangesetJobs checks whether current user is authorized.
+	// 🚨 SECURITY: CreateChangesetJobs and CreateMergeRolloutJobs check whether
+	// current user is authorized.
 	svc := service.New(r.store)
 	published := btypes.ChangesetPublicationStatePublished
 	openState := btypes.ChangesetExternalStateOpen
-	bulkGroupID, err := svc.CreateChangesetJobs(
-		ctx,
-		batchChangeID,
-		changesetIDs,
-		btypes.ChangesetJobTypeMerge,
-		&btypes.ChangesetJobMergePayload{Squash: args.Squash},
-		store.ListChangesetsOpts{
-			PublicationState: &published,
-			ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateCompleted},
-			ExternalStates:   []btypes.ChangesetExternalState{openState},
-		},
-	)
+	listOpts := store.ListChangesetsOpts{
+		PublicationState: &published,
+		ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateCompleted},
+		ExternalStates:   []btypes.ChangesetExternalState{openState},
+	}
+
+	var bulkGroupID string
+	if args.Rollout != nil {
+		bulkGroupID, err = svc.CreateMergeRolloutJobs(
+			ctx,
+			batchChangeID,
+			changesetIDs,
+			service.MergeRolloutOpts{
+				Squash:          args.Squash,
+				Waves:           args.Rollout.Waves,
+				MaxFailedChecks: args.Rollout.MaxFailedChecks,
+			},
+			listOpts,
+		)
+	} else {
+		bulkGroupID, err = svc.CreateChangesetJobs(
+			ctx,
+			batchChangeID,
+			changesetIDs,
+			btypes.ChangesetJobTypeMerge,
+			&btypes.ChangesetJobMergePayload{Squash: args.Squash},
+			listOpts,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.bulkOperationByIDString(ctx, bulkGroupID)
+}
+
+func (r *Resolver) ResumeMergeRollout(ctx context.Context, args *graphqlbackend.ResumeMergeRolloutArgs) (_ graphqlbackend.BulkOperationResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.ResumeMergeRollout", fmt.Sprintf("BulkOperation: %q", args.BulkOperation))
+	defer tr.FinishWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	bulkGroupID, err := unmarshalBulkOperationID(args.BulkOperation)
 	if err != nil {
 		return nil, err
 	}
 
+	// 🚨 SECURITY: ResumeMergeRollout checks whether current user is authorized.
+	svc := service.New(r.store)
+	if err := svc.ResumeMergeRollout(ctx, bulkGroupID); err != nil {
+		return nil, err
+	}
+
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
@@ -2414,11 +2414,34 @@ func TestMergeChangesets(t *testing.T) {
 			t.Fatalf("expected bulk operation to be created, but was not")
 		}
 	})
+
+	t.Run("invalid rollout fails", func(t *testing.T) {
+		input := generateInput()
+		input["rollout"] = map[string]any{"waves": []int{50, 10}}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationMergeChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "wave percentages must be in ascending order"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("runs successfully with rollout", func(t *testing.T) {
+		input := generateInput()
+		input["rollout"] = map[string]any{"waves": []int{10, 50}, "maxFailedChecks": 1}
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationMergeChangesets)
+
+		if response.MergeChangesets.ID == "" {
+			t.Fatalf("expected bulk operation to be created, but was not")
+		}
+	})
 }
 
 const mutationMergeChangesets = `
-mutation($batchChange: ID!, $changesets: [ID!]!, $squash: Boolean = false) {
-    mergeChangesets(batchChange: $batchChange, changesets: $changesets, squash: $squash) { id }
+mutation($batchChange: ID!, $changesets: [ID!]!, $squash: Boolean = false, $rollout: MergeRolloutInput) {
+    mergeChangesets(batchChange: $batchChange, changesets: $changesets, squash: $squash, rollout: $rollout) { id }
 }
 `
 
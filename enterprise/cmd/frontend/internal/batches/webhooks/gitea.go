@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/sourcegraph/log"
+
+	fewebhooks "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var giteaEvents = []string{
+	gitea.PullRequestEventType,
+	gitea.PullRequestSyncEventType,
+	gitea.PullRequestReviewApprovedType,
+	gitea.PullRequestReviewRejectedType,
+	gitea.PullRequestReviewCommentEventType,
+}
+
+type GiteaWebhook struct {
+	*webhook
+}
+
+func NewGiteaWebhook(store *store.Store, gitserverClient gitserver.Client, logger log.Logger) *GiteaWebhook {
+	return &GiteaWebhook{
+		webhook: &webhook{store, gitserverClient, logger, extsvc.VariantGitea.AsType()},
+	}
+}
+
+func (h *GiteaWebhook) Register(router *fewebhooks.Router) {
+	router.Register(
+		h.handleEvent,
+		extsvc.VariantGitea.AsKind(),
+		giteaEvents...,
+	)
+}
+
+// handleEvent handles Gitea pull request and review events. Gitea payloads
+// don't carry the reviews and commit statuses we derive the changeset state
+// from, so instead of converting the event we enqueue a sync of the changeset.
+func (h *GiteaWebhook) handleEvent(ctx context.Context, db database.DB, codeHostURN extsvc.CodeHostBaseURL, event any) error {
+	ctx = actor.WithInternalActor(ctx)
+
+	e, ok := event.(*gitea.PullRequestEvent)
+	if !ok {
+		return errors.Newf("unknown event type: %T", event)
+	}
+	if e.PullRequest == nil || e.Repository == nil {
+		h.logger.Warn("Dropping Gitea webhook event without pull request")
+		return nil
+	}
+
+	if err := h.enqueueGiteaChangesetSyncFromEvent(ctx, codeHostURN, e); err != nil {
+		return &httpError{
+			code: http.StatusInternalServerError,
+			err:  err,
+		}
+	}
+	return nil
+}
+
+// enqueueGiteaChangesetSyncFromEvent enqueues a sync request in repo-updater
+// for the changeset the pull request event refers to.
+func (h *GiteaWebhook) enqueueGiteaChangesetSyncFromEvent(ctx context.Context, esID extsvc.CodeHostBaseURL, event *gitea.PullRequestEvent) error {
+	pr := PR{
+		ID:             event.PullRequest.Index,
+		RepoExternalID: strconv.FormatInt(event.Repository.ID, 10),
+	}
+	repo, err := h.getRepoForPR(ctx, h.Store, pr, esID)
+	if err != nil {
+		return errors.Wrap(err, "getting repo")
+	}
+
+	c, err := h.Store.GetChangeset(ctx, store.GetChangesetOpts{
+		RepoID:              repo.ID,
+		ExternalID:          strconv.FormatInt(pr.ID, 10),
+		ExternalServiceType: h.ServiceType,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			// Not a changeset created by Batch Changes.
+			return nil
+		}
+		return errors.Wrap(err, "getting changeset")
+	}
+
+	if err := repoupdater.DefaultClient.EnqueueChangesetSync(ctx, []int64{c.ID}); err != nil {
+		return errors.Wrap(err, "enqueuing changeset sync")
+	}
+
+	return nil
+}
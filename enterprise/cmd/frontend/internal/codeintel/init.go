@@ -14,6 +14,7 @@ import (
 	policiesgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/transport/graphql"
 	rankinggraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/transport/graphql"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/transport/graphql"
+	sentinelhttp "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/transport/http"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers/gitresolvers"
@@ -133,6 +134,7 @@ func Init(
 		rankingRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.CodeIntelSBOMHandler = sentinelhttp.GetSBOMHandler(codeIntelServices.SentinelService, db, codeIntelServices.GitserverClient)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
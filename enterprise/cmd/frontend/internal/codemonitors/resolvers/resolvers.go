@@ -145,7 +145,7 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		}
 
 		// Create trigger.
-		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query, args.Trigger.APIChangesOnly)
 		if err != nil {
 			return err
 		}
@@ -556,7 +556,7 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	// Update trigger.
-	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query, args.Trigger.Update.APIChangesOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -884,6 +884,10 @@ func (q *monitorQuery) Query() string {
 	return q.QueryString
 }
 
+func (q *monitorQuery) APIChangesOnly() bool {
+	return q.QueryTrigger.APIChangesOnly
+}
+
 func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorTriggerEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
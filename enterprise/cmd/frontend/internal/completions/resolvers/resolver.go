@@ -61,6 +61,7 @@ func (c *completionsResolver) Completions(ctx context.Context, args graphqlbacke
 		completionsConfig.Endpoint,
 		completionsConfig.Provider,
 		completionsConfig.AccessToken,
+		completionsConfig.PromptTemplates,
 	)
 	if err != nil {
 		return "", errors.Wrap(err, "GetCompletionStreamClient")
@@ -34,6 +34,7 @@ func Init(
 		edb.NewEnterpriseDB(db),
 		embeddingsClient,
 		searchClient,
+		services.RankingService,
 	)
 	enterpriseServices.CodyContextResolver = resolvers.NewResolver(
 		db,
@@ -88,5 +88,5 @@ func (r *Resolver) fileChunkToResolver(ctx context.Context, chunk *codycontext.F
 
 	// Populate content ahead of time so we can do it concurrently
 	gitTreeEntryResolver.Content(ctx, &graphqlbackend.GitTreeContentPageArgs{})
-	return graphqlbackend.NewFileChunkContextResolver(gitTreeEntryResolver, chunk.StartLine, chunk.EndLine), nil
+	return graphqlbackend.NewFileChunkContextResolver(gitTreeEntryResolver, chunk.StartLine, chunk.EndLine, chunk.Symbol), nil
 }
@@ -132,6 +132,7 @@ func TestContextResolver(t *testing.T) {
 		db,
 		mockEmbeddingsClient,
 		mockSearchClient,
+		nil,
 	)
 
 	resolver := NewResolver(
@@ -290,6 +290,13 @@ func (r *embeddingsSearchResultResolver) EndLine(ctx context.Context) int32 {
 	return int32(r.result.EndLine)
 }
 
+func (r *embeddingsSearchResultResolver) Symbol(ctx context.Context) *string {
+	if r.result.Symbol == "" {
+		return nil
+	}
+	return &r.result.Symbol
+}
+
 func (r *embeddingsSearchResultResolver) Content(ctx context.Context) string {
 	return r.content
 }
@@ -1,6 +1,8 @@
 package executorqueue
 
 import (
+	"context"
+
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/conf/confdefaults"
@@ -32,6 +34,14 @@ func Init(
 
 	logger := log.Scoped("executorqueue", "")
 
+	if err := StepCacheUploadStoreConfigInst.Validate(); err != nil {
+		return err
+	}
+	stepCacheStore, err := newStepCacheUploadStore(context.Background(), observationCtx, StepCacheUploadStoreConfigInst)
+	if err != nil {
+		return err
+	}
+
 	queueHandler := newExecutorQueuesHandler(
 		observationCtx,
 		db,
@@ -40,6 +50,7 @@ func Init(
 		codeintelUploadHandler,
 		batchesWorkspaceFileGetHandler,
 		batchesWorkspaceFileExistsHandler,
+		stepCacheHandler(logger, stepCacheStore),
 	)
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
@@ -31,6 +31,7 @@ func newExecutorQueuesHandler(
 	uploadHandler http.Handler,
 	batchesWorkspaceFileGetHandler http.Handler,
 	batchesWorkspaceFileExistsHandler http.Handler,
+	stepCacheHandler http.Handler,
 ) func() http.Handler {
 	metricsStore := metricsstore.NewDistributedStore("executors:")
 	executorStore := db.Executors()
@@ -117,6 +118,13 @@ func newExecutorQueuesHandler(
 		// The files route are treated as an internal actor and require the executor access token to authenticate.
 		filesRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeFiles, jobTokenStore, executorStore))
 
+		// Read and write the results of previously executed steps.
+		stepCacheRouter := base.PathPrefix("/step-cache").Name("executor-step-cache").Subrouter()
+		stepCacheRouter.Path("/{queueName}/{key}").Methods(http.MethodGet, http.MethodPut).Handler(stepCacheHandler)
+		// The step cache routes are treated as internal actor. Additionally, each job comes with a short-lived token that is
+		// checked by jobAuthMiddleware.
+		stepCacheRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeStepCache, jobTokenStore, executorStore))
+
 		return base
 	}
 
@@ -126,9 +134,10 @@ func newExecutorQueuesHandler(
 type routeName string
 
 const (
-	routeFiles = "files"
-	routeGit   = "git"
-	routeQueue = "queue"
+	routeFiles     = "files"
+	routeGit       = "git"
+	routeQueue     = "queue"
+	routeStepCache = "step-cache"
 )
 
 // withInternalActor ensures that the request handling is running as an internal actor.
@@ -265,7 +274,7 @@ func validateJobRequest(
 		queue = "batches"
 	case routeGit:
 		repo = mux.Vars(r)["RepoName"]
-	case routeQueue:
+	case routeQueue, routeStepCache:
 		queue = mux.Vars(r)["queueName"]
 	default:
 		logger.Error("unsupported route", log.String("route", string(routeName)))
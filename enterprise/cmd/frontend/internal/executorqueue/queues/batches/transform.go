@@ -103,6 +103,29 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 		return apiclient.Job{}, errors.Wrap(err, "fetching repo")
 	}
 
+	spec := batchSpec.Spec
+	// src-cli runs every step once, so steps with a foreach list are expanded into
+	// one step per element when the workspace is not executed natively.
+	if job.Version != 2 && spec.HasForeachSteps() {
+		expanded := *spec
+		expanded.Steps, err = batcheslib.ExpandForeachSteps(spec.Steps, &template.StepContext{
+			BatchChange: template.BatchChangeAttributes{
+				Name:        spec.Name,
+				Description: spec.Description,
+			},
+			Repository: template.Repository{
+				Name:        string(repo.Name),
+				Branch:      workspace.Branch,
+				FileMatches: workspace.FileMatches,
+			},
+			Steps: template.StepsContext{Path: workspace.Path},
+		})
+		if err != nil {
+			return apiclient.Job{}, errors.Wrap(err, "expanding foreach steps")
+		}
+		spec = &expanded
+	}
+
 	executionInput := batcheslib.WorkspacesExecutionInput{
 		Repository: batcheslib.WorkspaceRepo{
 			ID:   string(graphqlbackend.MarshalRepositoryID(repo.ID)),
@@ -114,7 +137,7 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 		},
 		Path:               workspace.Path,
 		OnlyFetchWorkspace: workspace.OnlyFetchWorkspace,
-		Steps:              batchSpec.Spec.Steps,
+		Steps:              spec.Steps,
 		SearchResultPaths:  workspace.FileMatches,
 		BatchChangeAttributes: template.BatchChangeAttributes{
 			Name:        batchSpec.Spec.Name,
@@ -141,7 +164,7 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 		}
 	}
 
-	skipped, err := batcheslib.SkippedStepsForRepo(batchSpec.Spec, string(repo.Name), workspace.FileMatches)
+	skipped, err := batcheslib.SkippedStepsForRepo(spec, string(repo.Name), workspace.FileMatches)
 	if err != nil {
 		return apiclient.Job{}, err
 	}
@@ -260,9 +283,10 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 			})
 
 			dockerSteps = append(dockerSteps, apiclient.DockerStep{
-				Key:   executorutil.FormatRunKey(i),
-				Image: step.Container,
-				Dir:   runDir,
+				Key:       executorutil.FormatRunKey(i),
+				Image:     step.Container,
+				Dir:       runDir,
+				Resources: step.Resources,
 				// Invoke the script file but also write stdout and stderr to separate files, which will then be
 				// consumed by the post step to build the AfterStepResult.
 				Commands: []string{
@@ -22,6 +22,7 @@ import (
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -395,4 +396,89 @@ steps:
 		mockassert.CalledN(t, secs.ListFunc, 9)
 		mockassert.CalledN(t, sal.CreateFunc, 5)
 	})
+
+	t.Run("native execution with step resources", func(t *testing.T) {
+		spec := batcheslib.BatchSpec{}
+		err := yaml.Unmarshal([]byte(`
+steps:
+  - run: echo lol >> readme.md
+    container: alpine:3
+    resources:
+      cpuRequest: 500m
+      memoryLimit: 4Gi
+  - run: echo more lol >> readme.md
+    container: alpine:3
+`), &spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Copy.
+		batchSpec := *batchSpec
+		batchSpec.Spec = &spec
+		store.GetBatchSpecFunc.PushReturn(&batchSpec, nil)
+		workspace := *workspace
+		workspace.StepCacheResults = map[int]btypes.StepCacheResult{}
+		store.GetBatchSpecWorkspaceFunc.PushReturn(&workspace, nil)
+		workspaceExecutionJob := *workspaceExecutionJob
+		workspaceExecutionJob.Version = 2
+
+		job, err := transformRecord(context.Background(), logtest.Scoped(t), store, &workspaceExecutionJob, "0.0.0-dev")
+		if err != nil {
+			t.Fatalf("unexpected error transforming record: %s", err)
+		}
+
+		resources := map[string]*executor.StepResources{}
+		for _, step := range job.DockerSteps {
+			resources[step.Key] = step.Resources
+		}
+		expected := map[string]*executor.StepResources{
+			"step.0.pre":  nil,
+			"step.0.run":  {CPURequest: "500m", MemoryLimit: "4Gi"},
+			"step.0.post": nil,
+			"step.1.pre":  nil,
+			"step.1.run":  nil,
+			"step.1.post": nil,
+		}
+		if diff := cmp.Diff(expected, resources); diff != "" {
+			t.Errorf("unexpected step resources (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("foreach without native execution", func(t *testing.T) {
+		spec := batcheslib.BatchSpec{}
+		err := yaml.Unmarshal([]byte(`
+steps:
+  - run: echo ${{ foreach.item }} >> readme.md
+    container: alpine:3
+    foreach: [a, b]
+`), &spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Copy.
+		batchSpec := *batchSpec
+		batchSpec.Spec = &spec
+		store.GetBatchSpecFunc.PushReturn(&batchSpec, nil)
+
+		job, err := transformRecord(context.Background(), logtest.Scoped(t), store, workspaceExecutionJob, "0.0.0-dev")
+		if err != nil {
+			t.Fatalf("unexpected error transforming record: %s", err)
+		}
+
+		var input batcheslib.WorkspacesExecutionInput
+		if err := json.Unmarshal(job.VirtualMachineFiles["input.json"].Content, &input); err != nil {
+			t.Fatal(err)
+		}
+		var runs []string
+		for _, step := range input.Steps {
+			runs = append(runs, step.Run)
+		}
+		expected := []string{
+			`echo ${{ "a" }} >> readme.md`,
+			`echo ${{ "b" }} >> readme.md`,
+		}
+		if diff := cmp.Diff(expected, runs); diff != "" {
+			t.Errorf("unexpected steps (-want +got):\n%s", diff)
+		}
+	})
 }
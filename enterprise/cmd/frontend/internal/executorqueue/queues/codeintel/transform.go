@@ -76,21 +76,23 @@ func transformRecord(ctx context.Context, db database.DB, index uploadsshared.In
 	dockerSteps := make([]apiclient.DockerStep, 0, len(index.DockerSteps)+2)
 	for i, dockerStep := range index.DockerSteps {
 		dockerSteps = append(dockerSteps, apiclient.DockerStep{
-			Key:      fmt.Sprintf("pre-index.%d", i),
-			Image:    dockerStep.Image,
-			Commands: dockerStep.Commands,
-			Dir:      dockerStep.Root,
-			Env:      envVars,
+			Key:       fmt.Sprintf("pre-index.%d", i),
+			Image:     dockerStep.Image,
+			Commands:  dockerStep.Commands,
+			Dir:       dockerStep.Root,
+			Env:       envVars,
+			Resources: dockerStep.Resources,
 		})
 	}
 
 	if index.Indexer != "" {
 		dockerSteps = append(dockerSteps, apiclient.DockerStep{
-			Key:      "indexer",
-			Image:    index.Indexer,
-			Commands: append(index.LocalSteps, shellquote.Join(index.IndexerArgs...)),
-			Dir:      index.Root,
-			Env:      envVars,
+			Key:       "indexer",
+			Image:     index.Indexer,
+			Commands:  append(index.LocalSteps, shellquote.Join(index.IndexerArgs...)),
+			Dir:       index.Root,
+			Env:       envVars,
+			Resources: index.IndexerResources,
 		})
 	}
 
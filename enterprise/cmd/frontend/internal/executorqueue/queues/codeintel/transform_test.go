@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	srccli "github.com/sourcegraph/sourcegraph/internal/src-cli"
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -73,7 +74,8 @@ func TestTransformRecord(t *testing.T) {
 					// Verify args are properly shell quoted.
 					"-author", "Test User",
 				},
-				Outfile: "",
+				Outfile:          "",
+				IndexerResources: &executor.StepResources{CPURequest: "2", MemoryLimit: "8Gi"},
 			}
 			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{ExternalURL: "https://test.io"}})
 			t.Cleanup(func() {
@@ -101,11 +103,12 @@ func TestTransformRecord(t *testing.T) {
 						Env:      testCase.expected,
 					},
 					{
-						Key:      "indexer",
-						Image:    "lsif-node",
-						Commands: []string{"index -p . -author 'Test User'"},
-						Dir:      "web",
-						Env:      testCase.expected,
+						Key:       "indexer",
+						Image:     "lsif-node",
+						Commands:  []string{"index -p . -author 'Test User'"},
+						Dir:       "web",
+						Env:       testCase.expected,
+						Resources: &executor.StepResources{CPURequest: "2", MemoryLimit: "8Gi"},
 					},
 					{
 						Key:   "upload",
@@ -161,9 +164,10 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 				Root:     "web",
 			},
 			{
-				Image:    "lsif-node",
-				Commands: []string{"index", "-p", "."},
-				Root:     "web",
+				Image:     "lsif-node",
+				Commands:  []string{"index", "-p", "."},
+				Root:      "web",
+				Resources: &executor.StepResources{CPULimit: "4", MemoryRequest: "16Gi"},
 			},
 		},
 		Root:        "",
@@ -208,6 +212,7 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 					// Default resource variables
 					"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
 				},
+				Resources: &executor.StepResources{CPULimit: "4", MemoryRequest: "16Gi"},
 			},
 			{
 				Key:   "upload",
@@ -0,0 +1,78 @@
+package executorqueue
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// maxStepCacheEntrySize is the maximum size of a single cached step result. Larger results
+// are rejected, and the step will simply be run again by the next job.
+const maxStepCacheEntrySize = 100 * 1024 * 1024
+
+// stepCacheHandler serves the results of previously executed steps, keyed by the content
+// address computed by the executor. Entries are namespaced by queue so that a job token
+// only grants access to the entries of its own queue.
+func stepCacheHandler(logger log.Logger, store uploadstore.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		objectKey := fmt.Sprintf("step-cache/%s/%s", vars["queueName"], vars["key"])
+
+		switch r.Method {
+		case http.MethodGet:
+			rc, err := store.Get(r.Context(), objectKey)
+			if err != nil {
+				logger.Error("failed to read step cache entry", log.String("key", objectKey), log.Error(err))
+				http.Error(w, "failed to read step cache entry", http.StatusInternalServerError)
+				return
+			}
+			defer rc.Close()
+
+			// The upload store does not distinguish a missing object from a failed read
+			// until the content is consumed, so buffer the entry and treat failures as
+			// a miss. The executor will then run the step and rewrite the entry.
+			content, err := io.ReadAll(rc)
+			if err != nil {
+				logger.Debug("step cache miss", log.String("key", objectKey), log.Error(err))
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			if len(content) == 0 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(content); err != nil {
+				logger.Error("failed to write step cache entry", log.Error(err))
+			}
+
+		case http.MethodPut:
+			content, err := io.ReadAll(io.LimitReader(r.Body, maxStepCacheEntrySize+1))
+			if err != nil {
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			if len(content) > maxStepCacheEntrySize {
+				http.Error(w, "step cache entry too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			if _, err := store.Upload(r.Context(), objectKey, bytes.NewReader(content)); err != nil {
+				logger.Error("failed to write step cache entry", log.String("key", objectKey), log.Error(err))
+				http.Error(w, "failed to write step cache entry", http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package executorqueue
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestStepCacheHandler(t *testing.T) {
+	store := uploadstoremocks.NewMockStore()
+	entries := map[string][]byte{}
+	store.GetFunc.SetDefaultHook(func(_ context.Context, key string) (io.ReadCloser, error) {
+		content, ok := entries[key]
+		if !ok {
+			return io.NopCloser(errReader{}), nil
+		}
+		return io.NopCloser(bytes.NewReader(content)), nil
+	})
+	store.UploadFunc.SetDefaultHook(func(_ context.Context, key string, r io.Reader) (int64, error) {
+		content, err := io.ReadAll(r)
+		entries[key] = content
+		return int64(len(content)), err
+	})
+
+	router := mux.NewRouter()
+	router.Path("/step-cache/{queueName}/{key}").Handler(stepCacheHandler(logtest.Scoped(t), store))
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rw
+	}
+
+	rw := do(http.MethodGet, "/step-cache/batches/abc", "")
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+
+	rw = do(http.MethodPut, "/step-cache/batches/abc", "some diff")
+	require.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, []byte("some diff"), entries["step-cache/batches/abc"])
+
+	rw = do(http.MethodGet, "/step-cache/batches/abc", "")
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "some diff", rw.Body.String())
+
+	// Entries are scoped to a queue.
+	rw = do(http.MethodGet, "/step-cache/codeintel/abc", "")
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+
+	rw = do(http.MethodPut, "/step-cache/codeintel/empty", "")
+	require.Equal(t, http.StatusOK, rw.Code)
+	rw = do(http.MethodGet, "/step-cache/codeintel/empty", "")
+	assert.Equal(t, http.StatusNoContent, rw.Code)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("object does not exist")
+}
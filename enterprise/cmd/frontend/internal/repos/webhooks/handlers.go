@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
@@ -37,6 +38,7 @@ func Init(
 	enterpriseServices.ReposGitLabWebhook = NewGitLabHandler()
 	enterpriseServices.ReposBitbucketServerWebhook = NewBitbucketServerHandler()
 	enterpriseServices.ReposBitbucketCloudWebhook = NewBitbucketCloudHandler()
+	enterpriseServices.ReposGiteaWebhook = NewGiteaHandler()
 
 	enterpriseServices.WebhooksResolver = resolvers.NewWebhooksResolver(db)
 	return nil
@@ -161,6 +163,36 @@ func bitbucketCloudCloneURLFromEvent(event *bitbucketcloud.PushEvent) (string, e
 	return href, nil
 }
 
+type GiteaHandler struct {
+	logger log.Logger
+}
+
+func NewGiteaHandler() *GiteaHandler {
+	return &GiteaHandler{
+		logger: log.Scoped("webhooks.GiteaHandler", "gitea webhook handler"),
+	}
+}
+
+func (g *GiteaHandler) Register(router *webhooks.Router) {
+	router.Register(func(ctx context.Context, db database.DB, _ extsvc.CodeHostBaseURL, payload any) error {
+		return g.handlePushEvent(ctx, db, payload)
+	}, extsvc.VariantGitea.AsKind(), gitea.PushEventType)
+}
+
+func (g *GiteaHandler) handlePushEvent(ctx context.Context, db database.DB, payload any) error {
+	return handlePushEvent[*gitea.PushEvent](ctx, db, g.logger, payload, giteaCloneURLFromEvent)
+}
+
+func giteaCloneURLFromEvent(event *gitea.PushEvent) (string, error) {
+	if event == nil || event.Repository == nil {
+		return "", errors.New("nil PushEvent received")
+	}
+	if event.Repository.CloneURL == "" {
+		return "", errors.New("clone url is empty")
+	}
+	return event.Repository.CloneURL, nil
+}
+
 // handlePushEvent takes a push payload and a function to extract the repo
 // clone URL from the event. It then uses the clone URL to find a repo and queues
 // a repo update.
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // NewBulkOperationWorker creates a dbworker.Worker that fetches enqueued changeset_jobs
@@ -25,7 +26,7 @@ func NewBulkOperationWorker(
 	workerStore dbworkerstore.Store[*btypes.ChangesetJob],
 	sourcer sources.Sourcer,
 ) *workerutil.Worker[*btypes.ChangesetJob] {
-	r := &bulkProcessorWorker{sourcer: sourcer, store: s}
+	r := &bulkProcessorWorker{sourcer: sourcer, store: s, workerStore: workerStore}
 
 	options := workerutil.WorkerOptions{
 		Name:              "batches_bulk_processor",
@@ -43,10 +44,20 @@ func NewBulkOperationWorker(
 // bulkProcessorWorker is a wrapper for the workerutil handlerfunc to create a
 // bulkProcessor with a source and store.
 type bulkProcessorWorker struct {
-	store   *store.Store
-	sourcer sources.Sourcer
+	store       *store.Store
+	workerStore dbworkerstore.Store[*btypes.ChangesetJob]
+	sourcer     sources.Sourcer
 }
 
+// mergeRolloutRequeueDelay is the time after which a merge job of a merge
+// rollout, whose previous waves haven't finished yet, is retried.
+const mergeRolloutRequeueDelay = 1 * time.Minute
+
+// mergeRolloutPausedRequeueDelay is the time after which a merge job of a paused
+// merge rollout is checked again. Resuming the rollout makes its jobs run right
+// away.
+const mergeRolloutPausedRequeueDelay = 10 * time.Minute
+
 func (b *bulkProcessorWorker) HandlerFunc() workerutil.HandlerFunc[*btypes.ChangesetJob] {
 	return func(ctx context.Context, logger log.Logger, job *btypes.ChangesetJob) (err error) {
 		tx, err := b.store.Transact(ctx)
@@ -56,6 +67,18 @@ func (b *bulkProcessorWorker) HandlerFunc() workerutil.HandlerFunc[*btypes.Chang
 
 		p := processor.New(logger, tx, b.sourcer)
 		afterDone, err := p.Process(ctx, job)
+		if errors.IsAny(err, processor.ErrMergeRolloutWaveNotReady, processor.ErrMergeRolloutPaused) {
+			// Commit the transaction, it pauses the rollout if needed.
+			if err := tx.Done(nil); err != nil {
+				return err
+			}
+			delay := mergeRolloutRequeueDelay
+			if errors.Is(err, processor.ErrMergeRolloutPaused) {
+				delay = mergeRolloutPausedRequeueDelay
+			}
+			// Requeued records are not marked as completed by the worker.
+			return b.workerStore.Requeue(ctx, job.RecordID(), time.Now().Add(delay))
+		}
 
 		defer func() {
 			err = tx.Done(err)
@@ -0,0 +1,37 @@
+package codeintel
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/shared/init/codeintel"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type codenavDeadCodeReporterJob struct{}
+
+func NewCodenavDeadCodeReporterJob() job.Job {
+	return &codenavDeadCodeReporterJob{}
+}
+
+func (j *codenavDeadCodeReporterJob) Description() string {
+	return "code-intel dead code reporter"
+}
+
+func (j *codenavDeadCodeReporterJob) Config() []env.Config {
+	return []env.Config{
+		codenav.DeadCodeReporterConfigInst,
+	}
+}
+
+func (j *codenavDeadCodeReporterJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	services, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return codenav.NewDeadCodeReporter(observationCtx, services.CodenavService), nil
+}
@@ -25,6 +25,7 @@ func (j *sentinelCVEScannerJob) Config() []env.Config {
 	return []env.Config{
 		sentinel.DownloaderConfigInst,
 		sentinel.MatcherConfigInst,
+		sentinel.ReachabilityConfigInst,
 	}
 }
 
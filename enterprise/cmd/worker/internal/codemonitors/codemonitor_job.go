@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/shared/init/codeintel"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/background"
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/search"
@@ -33,5 +34,10 @@ func (j *codeMonitorJob) Routines(_ context.Context, observationCtx *observation
 		return nil, err
 	}
 
-	return background.NewBackgroundJobs(observationCtx, edb.NewEnterpriseDB(db), search.NewEnterpriseSearchJobs()), nil
+	services, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return background.NewBackgroundJobs(observationCtx, edb.NewEnterpriseDB(db), search.NewEnterpriseSearchJobs(), services.CodenavService), nil
 }
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/log"
 
@@ -30,6 +31,7 @@ type handler struct {
 	gitserverClient        gitserver.Client
 	contextService         embed.ContextService
 	repoEmbeddingJobsStore bgrepo.RepoEmbeddingJobsStore
+	annIndexOptions        embeddings.ANNIndexOptions
 }
 
 var _ workerutil.Handler[*bgrepo.RepoEmbeddingJob] = &handler{}
@@ -78,6 +80,11 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *bgrepo.
 			logger.Info("Embeddings model has changed in config. Performing a full index")
 			lastSuccessfulJobRevision, previousIndex = "", nil
 		}
+
+		if previousIndex != nil && h.isFullIndexDue(ctx, logger, repo, embeddingsConfig.FullIndexInterval, time.Now()) {
+			logger.Info("Last full index is older than the full index interval. Performing a full index")
+			lastSuccessfulJobRevision, previousIndex = "", nil
+		}
 	}
 
 	fetcher := &revisionFetcher{
@@ -90,9 +97,10 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *bgrepo.
 		RepoName:          repo.Name,
 		Revision:          record.Revision,
 		ExcludePatterns:   getExcludedFilePathPatterns(embeddingsConfig),
-		SplitOptions:      splitOptions,
+		SplitOptions:      getSplitOptions(embeddingsConfig),
 		MaxCodeEmbeddings: embeddingsConfig.MaxCodeEmbeddingsPerRepo,
 		MaxTextEmbeddings: embeddingsConfig.MaxTextEmbeddingsPerRepo,
+		BatchSize:         embeddingsConfig.BatchSize,
 		IndexedRevision:   lastSuccessfulJobRevision,
 	}
 
@@ -132,12 +140,29 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *bgrepo.
 
 	indexName := string(embeddings.GetRepoEmbeddingIndexName(repo.Name))
 	if stats.IsIncremental {
-		return embeddings.UpdateRepoEmbeddingIndex(ctx, h.uploadStore, indexName, previousIndex, repoEmbeddingIndex, toRemove, ranks)
+		return embeddings.UpdateRepoEmbeddingIndex(ctx, h.uploadStore, indexName, previousIndex, repoEmbeddingIndex, toRemove, ranks, h.annIndexOptions)
 	} else {
+		repoEmbeddingIndex.BuildANNIndexes(h.annIndexOptions)
 		return embeddings.UploadRepoEmbeddingIndex(ctx, h.uploadStore, indexName, repoEmbeddingIndex)
 	}
 }
 
+// getSplitOptions returns the default split options, scaled down to fit into the maximum
+// number of input tokens of the configured model if necessary.
+func getSplitOptions(embeddingsConfig *conftypes.EmbeddingsConfig) codeintelContext.SplitOptions {
+	maxInputTokens := embeddingsConfig.MaxInputTokens
+	if maxInputTokens <= 0 || maxInputTokens >= embedEntireFileTokensThreshold {
+		return splitOptions
+	}
+
+	chunkTokensThreshold := maxInputTokens * embeddingChunkTokensThreshold / embedEntireFileTokensThreshold
+	return codeintelContext.SplitOptions{
+		NoSplitTokensThreshold:         maxInputTokens,
+		ChunkTokensThreshold:           chunkTokensThreshold,
+		ChunkEarlySplitTokensThreshold: chunkTokensThreshold * embeddingChunkEarlySplitTokensThreshold / embeddingChunkTokensThreshold,
+	}
+}
+
 func getExcludedFilePathPatterns(embeddingsConfig *conftypes.EmbeddingsConfig) []*paths.GlobPattern {
 	var excludedGlobPatterns []*paths.GlobPattern
 	if embeddingsConfig != nil && len(embeddingsConfig.ExcludedFilePathPatterns) != 0 {
@@ -148,6 +173,30 @@ func getExcludedFilePathPatterns(embeddingsConfig *conftypes.EmbeddingsConfig) [
 	return excludedGlobPatterns
 }
 
+// isFullIndexDue returns true if the last full (non-incremental) index of the repository was computed more than
+// the given interval ago. Incremental indexes are periodically replaced by a full index, so that any errors made
+// while updating indexes incrementally do not accumulate. A non-positive interval disables periodic full indexes.
+// If the last full index cannot be determined, the incremental index is kept.
+func (h *handler) isFullIndexDue(ctx context.Context, logger log.Logger, repo *types.Repo, interval time.Duration, now time.Time) bool {
+	if interval <= 0 {
+		return false
+	}
+
+	lastFullJob, err := h.repoEmbeddingJobsStore.GetLastCompletedFullRepoEmbeddingJob(ctx, repo.ID)
+	if err != nil {
+		var notFoundErr *bgrepo.RepoEmbeddingJobNotFoundErr
+		if errors.As(err, &notFoundErr) {
+			return true
+		}
+
+		// Do not re-embed the entire repository because of a transient error
+		logger.Error("Error getting last full embeddings job. Continuing with incremental index", log.Error(err))
+		return false
+	}
+
+	return lastFullJob.FinishedAt == nil || now.Sub(*lastFullJob.FinishedAt) >= interval
+}
+
 // getPreviousEmbeddingIndex checks the last successfully indexed revision and returns its embeddings index. If there
 // is no previous revision, or if there's a problem downloading the index, then it returns a nil index. This means we
 // need to do a full (non-incremental) reindex.
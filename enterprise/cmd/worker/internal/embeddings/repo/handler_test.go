@@ -9,12 +9,18 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
 
+	codeintelContext "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/context"
+	bgrepo "github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 func TestDiff(t *testing.T) {
@@ -154,3 +160,83 @@ func TestGetExcludedFilePathPatterns(t *testing.T) {
 		t.Fatalf("Expected false, got true")
 	}
 }
+
+func TestGetSplitOptions(t *testing.T) {
+	// No token limit
+	if diff := cmp.Diff(splitOptions, getSplitOptions(&conftypes.EmbeddingsConfig{})); diff != "" {
+		t.Errorf("unexpected split options (-want +got):\n%s", diff)
+	}
+
+	// Token limit larger than the default thresholds
+	if diff := cmp.Diff(splitOptions, getSplitOptions(&conftypes.EmbeddingsConfig{MaxInputTokens: 8192})); diff != "" {
+		t.Errorf("unexpected split options (-want +got):\n%s", diff)
+	}
+
+	// Token limit smaller than the default thresholds
+	expected := codeintelContext.SplitOptions{
+		NoSplitTokensThreshold:         192,
+		ChunkTokensThreshold:           128,
+		ChunkEarlySplitTokensThreshold: 112,
+	}
+	if diff := cmp.Diff(expected, getSplitOptions(&conftypes.EmbeddingsConfig{MaxInputTokens: 192})); diff != "" {
+		t.Errorf("unexpected split options (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsFullIndexDue(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	repo := &types.Repo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+	now := time.Unix(1587396557, 0).UTC()
+
+	testCases := []struct {
+		name     string
+		job      *bgrepo.RepoEmbeddingJob
+		err      error
+		interval time.Duration
+		expected bool
+	}{
+		{
+			name:     "recent full index",
+			job:      &bgrepo.RepoEmbeddingJob{FinishedAt: pointers.Ptr(now.Add(-time.Hour))},
+			interval: 24 * time.Hour,
+			expected: false,
+		},
+		{
+			name:     "stale full index",
+			job:      &bgrepo.RepoEmbeddingJob{FinishedAt: pointers.Ptr(now.Add(-48 * time.Hour))},
+			interval: 24 * time.Hour,
+			expected: true,
+		},
+		{
+			name:     "no full index",
+			err:      &bgrepo.RepoEmbeddingJobNotFoundErr{},
+			interval: 24 * time.Hour,
+			expected: true,
+		},
+		{
+			name:     "store error",
+			err:      errors.New("connection refused"),
+			interval: 24 * time.Hour,
+			expected: false,
+		},
+		{
+			name:     "disabled",
+			job:      &bgrepo.RepoEmbeddingJob{FinishedAt: pointers.Ptr(now.Add(-48 * time.Hour))},
+			interval: 0,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := bgrepo.NewMockRepoEmbeddingJobsStore()
+			store.GetLastCompletedFullRepoEmbeddingJobFunc.SetDefaultReturn(testCase.job, testCase.err)
+			h := &handler{repoEmbeddingJobsStore: store}
+
+			if due := h.isFullIndexDue(ctx, logger, repo, testCase.interval, now); due != testCase.expected {
+				t.Errorf("unexpected result. want=%v have=%v", testCase.expected, due)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ func (s *repoEmbeddingJob) Description() string {
 }
 
 func (s *repoEmbeddingJob) Config() []env.Config {
-	return []env.Config{embeddings.EmbeddingsUploadStoreConfigInst}
+	return []env.Config{embeddings.EmbeddingsUploadStoreConfigInst, embeddings.EmbeddingsANNIndexConfigInst}
 }
 
 func (s *repoEmbeddingJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
@@ -63,6 +63,7 @@ func (s *repoEmbeddingJob) Routines(_ context.Context, observationCtx *observati
 			gitserver.NewClient(),
 			services.ContextService,
 			repoembeddingsbg.NewRepoEmbeddingJobsStore(db),
+			embeddings.EmbeddingsANNIndexConfigInst.Options(),
 		),
 	}, nil
 }
@@ -76,6 +77,7 @@ func newRepoEmbeddingJobWorker(
 	gitserverClient gitserver.Client,
 	contextService embed.ContextService,
 	repoEmbeddingJobsStore repoembeddingsbg.RepoEmbeddingJobsStore,
+	annIndexOptions embeddings.ANNIndexOptions,
 ) *workerutil.Worker[*repoembeddingsbg.RepoEmbeddingJob] {
 	handler := &handler{
 		db:                     db,
@@ -83,6 +85,7 @@ func newRepoEmbeddingJobWorker(
 		gitserverClient:        gitserverClient,
 		contextService:         contextService,
 		repoEmbeddingJobsStore: repoEmbeddingJobsStore,
+		annIndexOptions:        annIndexOptions,
 	}
 	return dbworker.NewWorker[*repoembeddingsbg.RepoEmbeddingJob](ctx, workerStore, handler, workerutil.WorkerOptions{
 		Name:              "repo_embedding_job_worker",
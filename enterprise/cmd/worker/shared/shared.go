@@ -65,6 +65,7 @@ var additionalJobs = map[string]job.Job{
 	"codeintel-crates-syncer":                     codeintel.NewCratesSyncerJob(),
 	"codeintel-sentinel-cve-scanner":              codeintel.NewSentinelCVEScannerJob(),
 	"codeintel-package-filter-applicator":         codeintel.NewPackagesFilterApplicatorJob(),
+	"codeintel-dead-code-reporter":                codeintel.NewCodenavDeadCodeReporterJob(),
 
 	"auth-sourcegraph-operator-cleaner": auth.NewSourcegraphOperatorCleaner(),
 
@@ -0,0 +1,36 @@
+package gitea
+
+import (
+	atypes "github.com/sourcegraph/sourcegraph/enterprise/internal/authz/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// NewAuthzProviders returns the set of Gitea authz providers derived from the
+// connections.
+//
+// Sourcegraph users are matched to Gitea users by username, so no
+// authentication provider is required.
+func NewAuthzProviders(conns []*types.GiteaConnection) *atypes.ProviderInitResult {
+	initResults := &atypes.ProviderInitResult{}
+	for _, c := range conns {
+		if c.Authorization == nil {
+			// No authorization required
+			continue
+		}
+		if err := licensing.Check(licensing.FeatureACLs); err != nil {
+			initResults.InvalidConnections = append(initResults.InvalidConnections, extsvc.VariantGitea.AsType())
+			initResults.Problems = append(initResults.Problems, err.Error())
+			continue
+		}
+		p, err := NewProvider(c, nil)
+		if err != nil {
+			initResults.InvalidConnections = append(initResults.InvalidConnections, extsvc.VariantGitea.AsType())
+			initResults.Problems = append(initResults.Problems, err.Error())
+			continue
+		}
+		initResults.Providers = append(initResults.Providers, p)
+	}
+	return initResults
+}
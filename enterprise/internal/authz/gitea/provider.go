@@ -0,0 +1,157 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Provider is an implementation of AuthzProvider that provides repository
+// permissions as determined from a Gitea instance. The configured token must
+// belong to a site admin, so that repositories can be listed on behalf of other
+// users.
+type Provider struct {
+	urn      string
+	client   gitea.Client
+	codeHost *extsvc.CodeHost
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Gitea authorization provider for the given
+// connection. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewProvider(conn *types.GiteaConnection, httpClient httpcli.Doer) (*Provider, error) {
+	baseURL, err := url.Parse(conn.Url)
+	if err != nil {
+		return nil, err
+	}
+	client, err := gitea.NewClient(conn.URN, baseURL, &auth.OAuthBearerToken{Token: conn.Token}, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		urn:      conn.URN,
+		client:   client,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.VariantGitea.AsType()),
+	}, nil
+}
+
+// FetchAccount looks up the Gitea user with the same username as the given
+// Sourcegraph user. It returns nil if no such user exists.
+func (p *Provider) FetchAccount(ctx context.Context, user *types.User, _ []*extsvc.Account, _ []string) (*extsvc.Account, error) {
+	if user == nil {
+		return nil, nil
+	}
+
+	giteaUser, err := p.client.GetUser(ctx, user.Username)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	accountData, err := json.Marshal(giteaUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extsvc.Account{
+		UserID: user.ID,
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: p.codeHost.ServiceType,
+			ServiceID:   p.codeHost.ServiceID,
+			AccountID:   strconv.FormatInt(giteaUser.ID, 10),
+		},
+		AccountData: extsvc.AccountData{
+			Data: extsvc.NewUnencryptedData(accountData),
+		},
+	}, nil
+}
+
+// FetchUserPerms returns the IDs of the private repositories the given account
+// can read, by listing repositories while impersonating the user.
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, _ authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case account.Data == nil:
+		return nil, errors.New("no account data provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	}
+
+	user, err := encryption.DecryptJSON[gitea.User](ctx, account.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling account data")
+	}
+	if user.Login == "" {
+		return nil, errors.New("account data has no username")
+	}
+
+	client := p.client.WithSudo(user.Login)
+	extIDs := []extsvc.RepoID{}
+	for page := 1; ; page++ {
+		repos, hasNext, err := client.ListUserRepos(ctx, page)
+		if err != nil {
+			// Return partial results, callers decide whether to discard them.
+			return &authz.ExternalUserPermissions{Exacts: extIDs}, err
+		}
+		for _, r := range repos {
+			if r.Private {
+				extIDs = append(extIDs, extsvc.RepoID(strconv.FormatInt(r.ID, 10)))
+			}
+		}
+		if !hasNext {
+			break
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, nil
+}
+
+// FetchRepoPerms is not implemented for Gitea; permissions are synced
+// user-centrically.
+func (p *Provider) FetchRepoPerms(context.Context, *extsvc.Repository, authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	return nil, &authz.ErrUnimplemented{Feature: "gitea.FetchRepoPerms"}
+}
+
+func (p *Provider) ServiceType() string {
+	return p.codeHost.ServiceType
+}
+
+func (p *Provider) ServiceID() string {
+	return p.codeHost.ServiceID
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ValidateConnection checks that the configured token belongs to a site admin,
+// which is required to list repositories on behalf of other users.
+func (p *Provider) ValidateConnection(ctx context.Context) error {
+	user, err := p.client.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "fetching authenticated Gitea user")
+	}
+	if !user.IsAdmin {
+		return errors.Newf("Gitea token for %q does not belong to a site admin, which is required to sync permissions", user.Login)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// createTestServer returns a Gitea API stub that knows about the users alice
+// (a site admin) and bob. Requests made with the Sudo header are answered as
+// if they were made by that user.
+func createTestServer(t *testing.T) *httptest.Server {
+	users := map[string]gitea.User{
+		"alice": {ID: 1, Login: "alice", IsAdmin: true},
+		"bob":   {ID: 2, Login: "bob"},
+	}
+	reposByUser := map[string][][]gitea.Repository{
+		"bob": {
+			{{ID: 10, FullName: "org/private", Private: true}, {ID: 11, FullName: "org/public"}},
+			{{ID: 12, FullName: "bob/secret", Private: true}},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer admin-token" {
+			t.Errorf("unexpected Authorization header %q", got)
+		}
+
+		current := "alice"
+		if sudo := r.Header.Get("Sudo"); sudo != "" {
+			current = sudo
+		}
+
+		switch {
+		case r.URL.Path == "/api/v1/user":
+			json.NewEncoder(w).Encode(users[current])
+		case r.URL.Path == "/api/v1/user/repos":
+			pages := reposByUser[current]
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page < len(pages) {
+				w.Header().Set("Link", `<next>; rel="next"`)
+			} else {
+				w.Header().Set("Link", `<first>; rel="first"`)
+			}
+			if page >= 1 && page <= len(pages) {
+				json.NewEncoder(w).Encode(pages[page-1])
+				return
+			}
+			w.Write([]byte("[]"))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/users/"):
+			u, ok := users[strings.TrimPrefix(r.URL.Path, "/api/v1/users/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"user does not exist"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(u)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestProvider(t *testing.T, url string) *Provider {
+	t.Helper()
+	p, err := NewProvider(&types.GiteaConnection{
+		URN: "extsvc:gitea:1",
+		GiteaConnection: &schema.GiteaConnection{
+			Url:           url,
+			Token:         "admin-token",
+			Authorization: &schema.GiteaAuthorization{},
+		},
+	}, http.DefaultClient)
+	require.NoError(t, err)
+	return p
+}
+
+func TestProvider_FetchAccount(t *testing.T) {
+	server := createTestServer(t)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+	ctx := context.Background()
+
+	t.Run("matching user", func(t *testing.T) {
+		acct, err := p.FetchAccount(ctx, &types.User{ID: 42, Username: "bob"}, nil, nil)
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+
+		assert.Equal(t, int32(42), acct.UserID)
+		assert.Equal(t, extsvc.AccountSpec{
+			ServiceType: extsvc.VariantGitea.AsType(),
+			ServiceID:   p.ServiceID(),
+			AccountID:   "2",
+		}, acct.AccountSpec)
+	})
+
+	t.Run("no matching user", func(t *testing.T) {
+		acct, err := p.FetchAccount(ctx, &types.User{ID: 43, Username: "mallory"}, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	server := createTestServer(t)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+	ctx := context.Background()
+
+	t.Run("nil account", func(t *testing.T) {
+		_, err := p.FetchUserPerms(ctx, nil, authz.FetchPermsOptions{})
+		assert.EqualError(t, err, "no account provided")
+	})
+
+	t.Run("not the code host of the account", func(t *testing.T) {
+		_, err := p.FetchUserPerms(ctx, &extsvc.Account{
+			AccountSpec: extsvc.AccountSpec{
+				ServiceType: extsvc.TypeGitHub,
+				ServiceID:   "https://github.com/",
+			},
+			AccountData: extsvc.AccountData{Data: extsvc.NewUnencryptedData([]byte(`{}`))},
+		}, authz.FetchPermsOptions{})
+		assert.EqualError(t, err, fmt.Sprintf("not a code host of the account: want %q but have %q", p.ServiceID(), "https://github.com/"))
+	})
+
+	t.Run("lists private repos as the user", func(t *testing.T) {
+		acct, err := p.FetchAccount(ctx, &types.User{ID: 42, Username: "bob"}, nil, nil)
+		require.NoError(t, err)
+
+		perms, err := p.FetchUserPerms(ctx, acct, authz.FetchPermsOptions{})
+		require.NoError(t, err)
+
+		want := []extsvc.RepoID{"10", "12"}
+		if diff := cmp.Diff(want, perms.Exacts); diff != "" {
+			t.Fatalf("Exacts mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestProvider_ValidateConnection(t *testing.T) {
+	server := createTestServer(t)
+	defer server.Close()
+
+	p := newTestProvider(t, server.URL)
+	assert.NoError(t, p.ValidateConnection(context.Background()))
+
+	p.client = p.client.WithSudo("bob")
+	assert.Error(t, p.ValidateConnection(context.Background()))
+}
@@ -3,6 +3,7 @@ package processor
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/sourcegraph/log"
 
@@ -37,6 +38,28 @@ func (e unknownJobTypeErr) NonRetryable() bool {
 
 var changesetIsProcessingErr = errors.New("cannot update a changeset that is currently being processed; will retry")
 
+// ErrMergeRolloutWaveNotReady is returned when a changeset of a merge rollout
+// cannot be merged yet, because changesets of previous waves are still being
+// merged or their checks are still pending. The job should be requeued.
+var ErrMergeRolloutWaveNotReady = errors.New("previous waves of the merge rollout have not finished yet")
+
+// ErrMergeRolloutPaused is returned when a changeset of a merge rollout cannot
+// be merged because the rollout is paused. The job should be requeued, it is
+// merged once the rollout is resumed.
+var ErrMergeRolloutPaused = errors.New("merge rollout is paused")
+
+// mergeRolloutPausedErr is returned by mergeRolloutWaveStatus when too many
+// changesets of previous waves of a merge rollout have failing checks or could
+// not be merged.
+type mergeRolloutPausedErr struct {
+	failed    int
+	maxFailed int
+}
+
+func (e mergeRolloutPausedErr) Error() string {
+	return fmt.Sprintf("merge rollout paused: %d changesets of previous waves have failing checks or could not be merged, but only %d are allowed", e.failed, e.maxFailed)
+}
+
 func New(logger log.Logger, tx *store.Store, sourcer sources.Sourcer) BulkProcessor {
 	return &bulkProcessor{
 		tx:      tx,
@@ -169,6 +192,17 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobMergePayload{}, job.Payload)
 	}
 
+	if rollout := typedPayload.Rollout; rollout != nil {
+		if rollout.Paused {
+			return nil, ErrMergeRolloutPaused
+		}
+		if rollout.Wave > 0 {
+			if err := b.checkMergeRolloutWave(ctx, job, rollout); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	remoteRepo, err := sources.GetRemoteRepo(ctx, b.css, b.repo, b.ch, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "loading remote repo")
@@ -204,6 +238,93 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 	return afterDone, nil
 }
 
+// checkMergeRolloutWave returns nil if the changesets of previous waves of the
+// merge rollout of the given job have been merged and their checks finished.
+func (b *bulkProcessor) checkMergeRolloutWave(ctx context.Context, job *btypes.ChangesetJob, rollout *btypes.ChangesetJobMergeRollout) error {
+	jobs, err := b.tx.ListChangesetJobs(ctx, store.ListChangesetJobsOpts{BulkGroup: job.BulkGroup})
+	if err != nil {
+		return errors.Wrap(err, "loading merge rollout jobs")
+	}
+
+	var ids []int64
+	for _, j := range jobs {
+		if inPreviousWave(j, rollout) {
+			ids = append(ids, j.ChangesetID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	cs, _, err := b.tx.ListChangesets(ctx, store.ListChangesetsOpts{IDs: ids})
+	if err != nil {
+		return errors.Wrap(err, "loading merge rollout changesets")
+	}
+	changesets := make(map[int64]*btypes.Changeset, len(cs))
+	for _, c := range cs {
+		changesets[c.ID] = c
+	}
+
+	err = mergeRolloutWaveStatus(jobs, changesets, rollout)
+	var paused mergeRolloutPausedErr
+	if errors.As(err, &paused) {
+		b.logger.Warn("pausing merge rollout", log.String("bulkGroup", job.BulkGroup), log.Error(err))
+		// The failures so far are accepted when the rollout is resumed.
+		if err := b.tx.PauseMergeRollout(ctx, job.BulkGroup, job.ID, paused.failed); err != nil {
+			return errors.Wrap(err, "pausing merge rollout")
+		}
+		return ErrMergeRolloutPaused
+	}
+	return err
+}
+
+// mergeRolloutWaveStatus determines, based on the jobs of a merge rollout and
+// the changesets they merged, whether the given wave can be merged. It returns
+// a mergeRolloutPausedErr if more changesets of previous waves have failing
+// checks than allowed and accepted when the rollout was last resumed, and
+// ErrMergeRolloutWaveNotReady if previous waves haven't finished yet.
+func mergeRolloutWaveStatus(jobs []*btypes.ChangesetJob, changesets map[int64]*btypes.Changeset, rollout *btypes.ChangesetJobMergeRollout) error {
+	failed := 0
+	pending := false
+	for _, j := range jobs {
+		if !inPreviousWave(j, rollout) {
+			continue
+		}
+
+		switch btypes.ChangesetJobState(strings.ToUpper(string(j.State))) {
+		case btypes.ChangesetJobStateFailed:
+			failed++
+		case btypes.ChangesetJobStateCompleted:
+			c, ok := changesets[j.ChangesetID]
+			if !ok {
+				// The changeset is gone, so there are no checks to wait for.
+				continue
+			}
+			switch c.ExternalCheckState {
+			case btypes.ChangesetCheckStatePending:
+				pending = true
+			case btypes.ChangesetCheckStateFailed:
+				failed++
+			}
+		default:
+			pending = true
+		}
+	}
+
+	if maxFailed := rollout.MaxFailedChecks + rollout.AcceptedFailedChecks; failed > maxFailed {
+		return mergeRolloutPausedErr{failed: failed, maxFailed: maxFailed}
+	}
+	if pending {
+		return ErrMergeRolloutWaveNotReady
+	}
+	return nil
+}
+
+func inPreviousWave(job *btypes.ChangesetJob, rollout *btypes.ChangesetJobMergeRollout) bool {
+	payload, ok := job.Payload.(*btypes.ChangesetJobMergePayload)
+	return ok && payload.Rollout != nil && payload.Rollout.Wave < rollout.Wave
+}
+
 func (b *bulkProcessor) closeChangeset(ctx context.Context) (afterDone func(*store.Store), err error) {
 	remoteRepo, err := sources.GetRemoteRepo(ctx, b.css, b.repo, b.ch, nil)
 	if err != nil {
@@ -271,6 +271,97 @@ func TestBulkProcessor(t *testing.T) {
 		}
 	})
 
+	t.Run("Merge rollout pauses and resumes", func(t *testing.T) {
+		failedChangeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+			Repo:                repo.ID,
+			BatchChanges:        []types.BatchChangeAssoc{{BatchChangeID: batchChange.ID}},
+			Metadata:            &github.PullRequest{},
+			ExternalServiceType: extsvc.TypeGitHub,
+			ExternalCheckState:  btypes.ChangesetCheckStateFailed,
+			CurrentSpec:         changesetSpec.ID,
+		})
+
+		rolloutJob := func(changesetID int64, wave int, state btypes.ChangesetJobState) *types.ChangesetJob {
+			job := &types.ChangesetJob{
+				BulkGroup:     "merge-rollout",
+				JobType:       types.ChangesetJobTypeMerge,
+				BatchChangeID: batchChange.ID,
+				ChangesetID:   changesetID,
+				UserID:        user.ID,
+				State:         state,
+				Payload: &btypes.ChangesetJobMergePayload{
+					Rollout: &btypes.ChangesetJobMergeRollout{Wave: wave},
+				},
+			}
+			if err := bstore.CreateChangesetJob(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+			return job
+		}
+		rolloutJob(failedChangeset.ID, 0, btypes.ChangesetJobStateCompleted)
+		job := rolloutJob(changeset.ID, 1, btypes.ChangesetJobStateQueued)
+
+		process := func() (*stesting.FakeChangesetSource, error) {
+			fake := &stesting.FakeChangesetSource{}
+			bp := &bulkProcessor{
+				tx:      bstore,
+				sourcer: stesting.NewFakeSourcer(nil, fake),
+				logger:  logtest.Scoped(t),
+			}
+			job, err := bstore.GetChangesetJob(ctx, store.GetChangesetJobOpts{ID: job.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = bp.Process(ctx, job)
+			return fake, err
+		}
+		rollout := func() btypes.ChangesetJobMergeRollout {
+			job, err := bstore.GetChangesetJob(ctx, store.GetChangesetJobOpts{ID: job.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return *job.Payload.(*btypes.ChangesetJobMergePayload).Rollout
+		}
+
+		// The failing checks of the first wave pause the rollout.
+		fake, err := process()
+		if err != ErrMergeRolloutPaused {
+			t.Fatalf("unexpected error. want=%s, got=%v", ErrMergeRolloutPaused, err)
+		}
+		if fake.MergeChangesetCalled {
+			t.Fatal("expected MergeChangeset not to be called")
+		}
+		if want, have := (btypes.ChangesetJobMergeRollout{Wave: 1, Paused: true, AcceptedFailedChecks: 1}), rollout(); want != have {
+			t.Fatalf("unexpected rollout. want=%+v, have=%+v", want, have)
+		}
+
+		// The job stays paused until the rollout is resumed.
+		if fake, err = process(); err != ErrMergeRolloutPaused {
+			t.Fatalf("unexpected error. want=%s, got=%v", ErrMergeRolloutPaused, err)
+		}
+		if fake.MergeChangesetCalled {
+			t.Fatal("expected MergeChangeset not to be called")
+		}
+
+		if err := bstore.ResumeMergeRollout(ctx, "merge-rollout"); err != nil {
+			t.Fatal(err)
+		}
+		if err := bstore.ResumeMergeRollout(ctx, "merge-rollout"); err != store.ErrNoResults {
+			t.Fatalf("unexpected error resuming a running rollout. want=%s, got=%v", store.ErrNoResults, err)
+		}
+		if want, have := (btypes.ChangesetJobMergeRollout{Wave: 1, AcceptedFailedChecks: 1}), rollout(); want != have {
+			t.Fatalf("unexpected rollout. want=%+v, have=%+v", want, have)
+		}
+
+		// The failures before the pause are accepted now.
+		if fake, err = process(); err != nil {
+			t.Fatal(err)
+		}
+		if !fake.MergeChangesetCalled {
+			t.Fatal("expected MergeChangeset to be called but wasn't")
+		}
+	})
+
 	t.Run("Close job", func(t *testing.T) {
 		fake := &stesting.FakeChangesetSource{FakeMetadata: &github.PullRequest{}}
 		bp := &bulkProcessor{
@@ -484,3 +575,101 @@ func TestBulkProcessor(t *testing.T) {
 		})
 	})
 }
+
+func TestMergeRolloutWaveStatus(t *testing.T) {
+	job := func(changesetID int64, wave int, state btypes.ChangesetJobState) *btypes.ChangesetJob {
+		return &btypes.ChangesetJob{
+			ChangesetID: changesetID,
+			JobType:     btypes.ChangesetJobTypeMerge,
+			// Job states are stored in lowercase in the database.
+			State: btypes.ChangesetJobState(state.ToDB()),
+			Payload: &btypes.ChangesetJobMergePayload{
+				Rollout: &btypes.ChangesetJobMergeRollout{Wave: wave},
+			},
+		}
+	}
+	changesets := func(checkStates ...btypes.ChangesetCheckState) map[int64]*btypes.Changeset {
+		m := make(map[int64]*btypes.Changeset, len(checkStates))
+		for i, s := range checkStates {
+			m[int64(i+1)] = &btypes.Changeset{ID: int64(i + 1), ExternalCheckState: s}
+		}
+		return m
+	}
+
+	tcs := []struct {
+		name       string
+		jobs       []*btypes.ChangesetJob
+		changesets map[int64]*btypes.Changeset
+		rollout    btypes.ChangesetJobMergeRollout
+		wantErr    error
+	}{
+		{
+			name: "previous wave passed",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 0, btypes.ChangesetJobStateCompleted),
+				job(3, 1, btypes.ChangesetJobStateQueued),
+			},
+			changesets: changesets(btypes.ChangesetCheckStatePassed, btypes.ChangesetCheckStateUnknown),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 1},
+		},
+		{
+			name: "previous wave still merging",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 0, btypes.ChangesetJobStateProcessing),
+			},
+			changesets: changesets(btypes.ChangesetCheckStatePassed, btypes.ChangesetCheckStatePending),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 1},
+			wantErr:    ErrMergeRolloutWaveNotReady,
+		},
+		{
+			name: "checks pending",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 1, btypes.ChangesetJobStateCompleted),
+			},
+			changesets: changesets(btypes.ChangesetCheckStatePassed, btypes.ChangesetCheckStatePending),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 2},
+			wantErr:    ErrMergeRolloutWaveNotReady,
+		},
+		{
+			name: "failures within threshold",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 0, btypes.ChangesetJobStateCompleted),
+			},
+			changesets: changesets(btypes.ChangesetCheckStateFailed, btypes.ChangesetCheckStatePassed),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 1, MaxFailedChecks: 1},
+		},
+		{
+			name: "failures accepted when resumed",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 0, btypes.ChangesetJobStateFailed),
+			},
+			changesets: changesets(btypes.ChangesetCheckStateFailed, btypes.ChangesetCheckStatePending),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 1, MaxFailedChecks: 1, AcceptedFailedChecks: 1},
+		},
+		{
+			name: "failures above threshold",
+			jobs: []*btypes.ChangesetJob{
+				job(1, 0, btypes.ChangesetJobStateCompleted),
+				job(2, 0, btypes.ChangesetJobStateFailed),
+				job(3, 0, btypes.ChangesetJobStateQueued),
+			},
+			changesets: changesets(btypes.ChangesetCheckStateFailed, btypes.ChangesetCheckStatePending, btypes.ChangesetCheckStatePending),
+			rollout:    btypes.ChangesetJobMergeRollout{Wave: 1, MaxFailedChecks: 1},
+			wantErr:    mergeRolloutPausedErr{failed: 2, maxFailed: 1},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mergeRolloutWaveStatus(tc.jobs, tc.changesets, &tc.rollout)
+			if err != tc.wantErr {
+				t.Fatalf("unexpected error, want=%v have=%v", tc.wantErr, err)
+			}
+		})
+	}
+}
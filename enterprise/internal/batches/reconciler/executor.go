@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"text/template"
 	"time"
 
 	"github.com/inconshreveable/log15"
+	"github.com/sourcegraph/go-diff/diff"
 	"github.com/sourcegraph/log"
 
 	bgql "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/graphql"
@@ -18,6 +21,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/webhooks"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -99,6 +104,9 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 		case btypes.ReconcilerOperationPush:
 			afterDone, err = e.pushChangesetPatch(ctx, triggerUpdateWebhook)
 
+		case btypes.ReconcilerOperationRebase:
+			afterDone, err = e.rebaseChangeset(ctx)
+
 		case btypes.ReconcilerOperationPublish:
 			afterDone, err = e.publishChangeset(ctx, false)
 
@@ -202,6 +210,10 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	}
 	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
 	resp, err := e.pushCommit(ctx, opts)
+	if err == nil {
+		// The new commit is based on the base revision of the current spec.
+		e.ch.RebasedBaseRev = ""
+	}
 	var pce pushCommitError
 	if errors.As(err, &pce) {
 		if acss, ok := css.(sources.ArchivableChangesetSource); ok {
@@ -230,6 +242,157 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	return afterDone, err
 }
 
+// rebaseChangeset re-applies the diff of the changeset spec on top of the
+// current head of the base branch and force-pushes the resulting commit to the
+// changeset's branch, if the base branch changed any of the files touched by the
+// diff since the changeset was last rebased. If the diff doesn't apply anymore,
+// an errRebaseConflict listing the conflicting files is returned.
+func (e *executor) rebaseChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
+	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
+
+	base, err := e.client.ResolveRevision(ctx, e.targetRepo.Name, e.spec.BaseRef, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return afterDone, errors.Wrap(err, "resolving base branch")
+	}
+
+	// If the changeset branch already contains the head of the base branch,
+	// e.g. because a previous run rebased it, there is nothing to do.
+	if head := e.ch.SyncState.HeadRefOid; head != "" {
+		mergeBase, err := e.client.MergeBase(ctx, e.targetRepo.Name, base, api.CommitID(head))
+		if err == nil && mergeBase == base {
+			e.ch.RebasedBaseRev = string(base)
+			return nil, nil
+		}
+	}
+
+	lastBase := e.ch.RebasedBaseRev
+	if lastBase == "" {
+		lastBase = e.spec.BaseRev
+	}
+	conflicts, err := e.baseChangesConflict(ctx, lastBase, string(base))
+	if err != nil {
+		return afterDone, errors.Wrap(err, "comparing base branch changes")
+	}
+	if !conflicts {
+		// The code host can still merge the changeset cleanly, so there is no
+		// need to force-push it.
+		e.ch.RebasedBaseRev = string(base)
+		return nil, nil
+	}
+
+	css, err := e.changesetSource(ctx)
+	if err != nil {
+		return afterDone, err
+	}
+	remoteRepo, err := e.remoteRepo(ctx)
+	if err != nil {
+		return afterDone, err
+	}
+	if remoteRepo.Archived {
+		return afterDone, errCannotPushToArchivedRepo
+	}
+
+	pushConf, err := css.GitserverPushConfig(remoteRepo)
+	if err != nil {
+		return afterDone, err
+	}
+	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
+	opts.BaseCommit = base
+
+	resp, err := e.pushCommit(ctx, opts)
+	if err != nil {
+		var pce pushCommitError
+		if errors.As(err, &pce) && strings.Contains(pce.CombinedOutput, "patch does not apply") {
+			return afterDone, errRebaseConflict{
+				baseRef: e.spec.BaseRef,
+				files:   conflictingFiles(pce.CombinedOutput),
+			}
+		}
+		return afterDone, err
+	}
+
+	if err = e.runAfterCommit(ctx, css, resp, remoteRepo, opts); err != nil {
+		return afterDone, errors.Wrap(err, "running after commit routine")
+	}
+
+	e.ch.RebasedBaseRev = string(base)
+	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
+	return afterDone, nil
+}
+
+// baseChangesConflict returns true if any of the files touched by the diff of
+// the changeset spec changed on the base branch between the two revisions.
+func (e *executor) baseChangesConflict(ctx context.Context, from, to string) (bool, error) {
+	paths, err := diffPaths(e.spec.Diff)
+	if err != nil {
+		return false, err
+	}
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	iter, err := e.client.Diff(ctx, authz.DefaultSubRepoPermsChecker, gitserver.DiffOptions{
+		Repo:      e.targetRepo.Name,
+		Base:      from,
+		Head:      to,
+		RangeType: "..",
+		Paths:     paths,
+	})
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	if _, err := iter.Next(); err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// diffPaths returns the paths of the files touched by the given diff.
+func diffPaths(rawDiff []byte) ([]string, error) {
+	fileDiffs, err := diff.ParseMultiFileDiff(rawDiff)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing changeset diff")
+	}
+
+	var paths []string
+	seen := make(map[string]struct{})
+	for _, fd := range fileDiffs {
+		for _, name := range []string{fd.OrigName, fd.NewName} {
+			if name == "" || name == "/dev/null" {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			paths = append(paths, name)
+		}
+	}
+	return paths, nil
+}
+
+var gitApplyConflictPattern = regexp.MustCompile(`(?m)^error: (.+): patch does not apply$`)
+
+// conflictingFiles extracts the paths of the files that `git apply` failed to
+// patch from its output.
+func conflictingFiles(output string) []string {
+	var files []string
+	seen := make(map[string]struct{})
+	for _, match := range gitApplyConflictPattern.FindAllStringSubmatch(output, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		files = append(files, match[1])
+	}
+	return files
+}
+
 // publishChangeset creates the given changeset on its code host.
 func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDone func(store *store.Store), err error) {
 	afterDoneUpdate := func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
@@ -803,6 +966,24 @@ func (e errPublishSameBranch) Error() string {
 
 func (e errPublishSameBranch) NonRetryable() bool { return true }
 
+// errRebaseConflict is returned when the diff of a changeset cannot be
+// re-applied on top of the new head of its base branch.
+// It is a terminal error that won't be fixed by retrying the rebase; the batch
+// spec needs to be re-executed against the new base.
+type errRebaseConflict struct {
+	baseRef string
+	files   []string
+}
+
+func (e errRebaseConflict) Error() string {
+	if len(e.files) == 0 {
+		return fmt.Sprintf("cannot rebase changeset onto %s: the diff does not apply", e.baseRef)
+	}
+	return fmt.Sprintf("cannot rebase changeset onto %s: the diff does not apply to the following files: %s", e.baseRef, strings.Join(e.files, ", "))
+}
+
+func (e errRebaseConflict) NonRetryable() bool { return true }
+
 // errNoSSHCredential is returned, if the  clone URL of the repository uses the
 // ssh:// scheme, but the authenticator doesn't support SSH pushes.
 type errNoSSHCredential struct{}
@@ -26,6 +26,7 @@ import (
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/webhooks"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
@@ -1242,6 +1243,70 @@ func TestHandleArchivedRepo(t *testing.T) {
 	})
 }
 
+func TestConflictingFiles(t *testing.T) {
+	output := `error: patch failed: README.md:1
+error: README.md: patch does not apply
+error: patch failed: cmd/main.go:12
+error: cmd/main.go: patch does not apply
+error: cmd/main.go: patch does not apply
+`
+
+	want := []string{"README.md", "cmd/main.go"}
+	assert.Equal(t, want, conflictingFiles(output))
+	assert.Empty(t, conflictingFiles("fatal: something else went wrong"))
+
+	err := errRebaseConflict{baseRef: "refs/heads/main", files: want}
+	assert.Equal(t, "cannot rebase changeset onto refs/heads/main: the diff does not apply to the following files: README.md, cmd/main.go", err.Error())
+	assert.True(t, errcode.IsNonRetryable(err))
+}
+
+func TestBaseChangesConflict(t *testing.T) {
+	const specDiff = `diff --git README.md README.md
+index 671e50a..851b23a 100644
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-# Hello
++# Hello World
+diff --git old.go new.go
+similarity index 100%
+rename from old.go
+rename to new.go
+`
+
+	client := gitserver.NewMockClient()
+	e := &executor{
+		client:     client,
+		targetRepo: &types.Repo{Name: "github.com/sourcegraph/sourcegraph"},
+		spec:       &btypes.ChangesetSpec{Diff: []byte(specDiff)},
+	}
+
+	t.Run("no overlapping changes", func(t *testing.T) {
+		client.DiffFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, opts gitserver.DiffOptions) (*gitserver.DiffFileIterator, error) {
+			assert.Equal(t, gitserver.DiffOptions{
+				Repo:      "github.com/sourcegraph/sourcegraph",
+				Base:      "d34db33f",
+				Head:      "f00b4r",
+				RangeType: "..",
+				Paths:     []string{"README.md", "old.go", "new.go"},
+			}, opts)
+			return gitserver.NewDiffFileIterator(io.NopCloser(strings.NewReader(""))), nil
+		})
+
+		conflicts, err := e.baseChangesConflict(context.Background(), "d34db33f", "f00b4r")
+		require.NoError(t, err)
+		assert.False(t, conflicts)
+	})
+
+	t.Run("overlapping changes", func(t *testing.T) {
+		client.DiffFunc.SetDefaultReturn(gitserver.NewDiffFileIterator(io.NopCloser(strings.NewReader(specDiff))), nil)
+
+		conflicts, err := e.baseChangesConflict(context.Background(), "d34db33f", "f00b4r")
+		require.NoError(t, err)
+		assert.True(t, conflicts)
+	})
+}
+
 type mockRepoArchivedError struct{}
 
 func (mockRepoArchivedError) Archived() bool     { return true }
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -15,6 +17,7 @@ var operationPrecedence = map[btypes.ReconcilerOperation]int{
 	btypes.ReconcilerOperationDetach:       0,
 	btypes.ReconcilerOperationArchive:      0,
 	btypes.ReconcilerOperationReattach:     0,
+	btypes.ReconcilerOperationRebase:       0,
 	btypes.ReconcilerOperationImport:       1,
 	btypes.ReconcilerOperationPublish:      1,
 	btypes.ReconcilerOperationPublishDraft: 1,
@@ -254,6 +257,16 @@ func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChang
 			}
 		}
 
+		// If we don't push a new commit anyway, but the base branch moved since
+		// the changeset spec was created, we re-apply the diff on top of it.
+		if !pl.Ops.Contains(btypes.ReconcilerOperationPush) && needsRebase(currentSpec, wantedChangeset) {
+			pl.AddOp(btypes.ReconcilerOperationRebase)
+			if !pl.Ops.Contains(btypes.ReconcilerOperationUpdate) && !pl.Ops.Contains(btypes.ReconcilerOperationSync) {
+				pl.AddOp(btypes.ReconcilerOperationSleep)
+				pl.AddOp(btypes.ReconcilerOperationSync)
+			}
+		}
+
 	default:
 		return pl, errors.Errorf("unknown changeset publication state: %s", wantedChangeset.PublicationState)
 	}
@@ -283,6 +296,34 @@ func reopenAfterDetach(ch *btypes.Changeset) bool {
 	return ch.AttachedTo(ch.OwnedByBatchChangeID)
 }
 
+// needsRebase returns true if rebasing changesets is enabled and the last
+// synced base revision of the published changeset differs from the one it was
+// last rebased onto, or, if it was never rebased, the one its changeset spec
+// was created against.
+func needsRebase(spec *btypes.ChangesetSpec, ch *btypes.Changeset) bool {
+	if !conf.Get().BatchChangesRebaseOnBaseChange {
+		return false
+	}
+
+	if spec.Type != btypes.ChangesetSpecTypeBranch || ch.ExternalServiceType == extsvc.TypePerforce {
+		return false
+	}
+
+	if ch.ExternalState != btypes.ChangesetExternalStateOpen && ch.ExternalState != btypes.ChangesetExternalStateDraft {
+		return false
+	}
+
+	base := ch.RebasedBaseRev
+	if base == "" {
+		base = spec.BaseRev
+	}
+	if base == "" || ch.SyncState.BaseRefOid == "" {
+		return false
+	}
+
+	return ch.SyncState.BaseRefOid != base
+}
+
 func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicationState *btypes.ChangesetUiPublicationState) *ChangesetSpecDelta {
 	delta := &ChangesetSpecDelta{}
 
@@ -5,8 +5,10 @@ import (
 
 	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestDetermineReconcilerPlan(t *testing.T) {
@@ -460,3 +462,161 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestDetermineReconcilerPlan_Rebase(t *testing.T) {
+	const (
+		oldBase = "d34db33f"
+		newBase = "f00b4r"
+	)
+
+	tcs := []struct {
+		name           string
+		enabled        bool
+		previousSpec   *bt.TestSpecOpts
+		currentSpec    *bt.TestSpecOpts
+		changeset      bt.TestChangesetOpts
+		syncedBase     string
+		rebasedBase    string
+		wantOperations Operations
+	}{
+		{
+			name:        "base moved",
+			enabled:     true,
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase: newBase,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationRebase,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:        "base moved but rebasing disabled",
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase:     newBase,
+			wantOperations: Operations{},
+		},
+		{
+			name:        "base unchanged",
+			enabled:     true,
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase:     oldBase,
+			wantOperations: Operations{},
+		},
+		{
+			name:        "base moved since last rebase",
+			enabled:     true,
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase:  newBase,
+			rebasedBase: "c0ff33",
+			wantOperations: Operations{
+				btypes.ReconcilerOperationRebase,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:        "already rebased onto base",
+			enabled:     true,
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase:     newBase,
+			rebasedBase:    newBase,
+			wantOperations: Operations{},
+		},
+		{
+			name:        "base moved on closed changeset",
+			enabled:     true,
+			currentSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateClosed,
+			},
+			syncedBase:     newBase,
+			wantOperations: Operations{},
+		},
+		{
+			name:         "base moved with new diff",
+			enabled:      true,
+			previousSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase, CommitDiff: []byte("old diff")},
+			currentSpec:  &bt.TestSpecOpts{Published: true, BaseRev: oldBase, CommitDiff: []byte("new diff")},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase: newBase,
+			// The new commit is pushed anyway, so no rebase is needed.
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "base moved with new title",
+			enabled:      true,
+			previousSpec: &bt.TestSpecOpts{Published: true, BaseRev: oldBase, Title: "old title"},
+			currentSpec:  &bt.TestSpecOpts{Published: true, BaseRev: oldBase, Title: "new title"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			syncedBase: newBase,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationRebase,
+				btypes.ReconcilerOperationUpdate,
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{
+				SiteConfiguration: schema.SiteConfiguration{
+					BatchChangesRebaseOnBaseChange: tc.enabled,
+				},
+			})
+			defer conf.Mock(nil)
+
+			var previousSpec *btypes.ChangesetSpec
+			if tc.previousSpec != nil {
+				tc.previousSpec.Typ = btypes.ChangesetSpecTypeBranch
+				previousSpec = bt.BuildChangesetSpec(t, *tc.previousSpec)
+			}
+
+			tc.currentSpec.Typ = btypes.ChangesetSpecTypeBranch
+			currentSpec := bt.BuildChangesetSpec(t, *tc.currentSpec)
+
+			cs := bt.BuildChangeset(tc.changeset)
+			cs.SyncState.BaseRefOid = tc.syncedBase
+			cs.RebasedBaseRev = tc.rebasedBase
+
+			plan, err := DeterminePlan(previousSpec, currentSpec, nil, cs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have, want := plan.Ops, tc.wantOperations; !have.Equal(want) {
+				t.Fatalf("incorrect plan determined, want=%v have=%v", want, have)
+			}
+		})
+	}
+}
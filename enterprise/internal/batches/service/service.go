@@ -92,6 +92,8 @@ type operations struct {
 	fetchUsernameForBitbucketServerToken *observation.Operation
 	validateAuthenticator                *observation.Operation
 	createChangesetJobs                  *observation.Operation
+	createMergeRolloutJobs               *observation.Operation
+	resumeMergeRollout                   *observation.Operation
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
@@ -142,6 +144,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			fetchUsernameForBitbucketServerToken: op("FetchUsernameForBitbucketServerToken"),
 			validateAuthenticator:                op("ValidateAuthenticator"),
 			createChangesetJobs:                  op("CreateChangesetJobs"),
+			createMergeRolloutJobs:               op("CreateMergeRolloutJobs"),
+			resumeMergeRollout:                   op("ResumeMergeRollout"),
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
@@ -1321,6 +1325,134 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 	ctx, _, endObservation := s.operations.createChangesetJobs.With(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
 
+	return s.createChangesetJobs(ctx, batchChangeID, ids, jobType, func(cs []*btypes.Changeset) ([]any, error) {
+		payloads := make([]any, len(cs))
+		for i := range payloads {
+			payloads[i] = payload
+		}
+		return payloads, nil
+	}, listOpts)
+}
+
+// MergeRolloutOpts configures the waves of a merge rollout.
+type MergeRolloutOpts struct {
+	// Squash is passed on to the code host when merging the changesets.
+	Squash bool
+	// Waves are the cumulative percentages of changesets that are merged after
+	// each wave, in ascending order. The remaining changesets are merged in a
+	// final wave.
+	Waves []int32
+	// MaxFailedChecks is the number of changesets of previous waves that may
+	// have failing checks before the rollout is paused.
+	MaxFailedChecks int32
+}
+
+// CreateMergeRolloutJobs creates one merge job for each given Changeset in the
+// given BatchChange, like CreateChangesetJobs, but assigns the changesets to
+// the waves of a merge rollout. The bulk processor only merges the changesets
+// of a wave once the checks of the changesets merged in previous waves
+// finished.
+func (s *Service) CreateMergeRolloutJobs(ctx context.Context, batchChangeID int64, ids []int64, opts MergeRolloutOpts, listOpts store.ListChangesetsOpts) (bulkGroupID string, err error) {
+	ctx, _, endObservation := s.operations.createMergeRolloutJobs.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	if opts.MaxFailedChecks < 0 {
+		return bulkGroupID, errors.New("maxFailedChecks must not be negative")
+	}
+
+	return s.createChangesetJobs(ctx, batchChangeID, ids, btypes.ChangesetJobTypeMerge, func(cs []*btypes.Changeset) ([]any, error) {
+		waves, err := mergeRolloutWaves(len(cs), opts.Waves)
+		if err != nil {
+			return nil, err
+		}
+
+		payloads := make([]any, len(cs))
+		for i, wave := range waves {
+			payloads[i] = &btypes.ChangesetJobMergePayload{
+				Squash: opts.Squash,
+				Rollout: &btypes.ChangesetJobMergeRollout{
+					Wave:            wave,
+					MaxFailedChecks: int(opts.MaxFailedChecks),
+				},
+			}
+		}
+		return payloads, nil
+	}, listOpts)
+}
+
+// mergeRolloutWaves returns the index of the wave in which each of count
+// changesets is merged, given the cumulative percentages of changesets merged
+// after each wave.
+func mergeRolloutWaves(count int, percentages []int32) ([]int, error) {
+	if len(percentages) == 0 {
+		return nil, errors.New("a merge rollout needs at least one wave")
+	}
+
+	ends := make([]int, 0, len(percentages))
+	for i, p := range percentages {
+		if p <= 0 || p > 100 {
+			return nil, errors.Newf("invalid wave percentage %d: must be between 1 and 100", p)
+		}
+		if i > 0 && p <= percentages[i-1] {
+			return nil, errors.New("wave percentages must be in ascending order")
+		}
+		// Round up, so that every wave with a percentage above zero merges at
+		// least one changeset.
+		ends = append(ends, (count*int(p)+99)/100)
+	}
+
+	waves := make([]int, count)
+	wave := 0
+	for i := range waves {
+		for wave < len(ends) && i >= ends[wave] {
+			wave++
+		}
+		waves[i] = wave
+	}
+	return waves, nil
+}
+
+// ErrMergeRolloutNotPaused is returned by ResumeMergeRollout if the bulk
+// operation is not a paused merge rollout.
+var ErrMergeRolloutNotPaused = errors.New("bulk operation is not a paused merge rollout")
+
+// ResumeMergeRollout resumes the merge rollout of the given bulk operation after
+// it was paused because too many changesets of previous waves have failing
+// checks. Those changesets are accepted, and the rollout pauses again if more
+// than MaxFailedChecks changesets fail after resuming.
+func (s *Service) ResumeMergeRollout(ctx context.Context, bulkGroupID string) (err error) {
+	ctx, _, endObservation := s.operations.resumeMergeRollout.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	jobs, err := s.store.ListChangesetJobs(ctx, store.ListChangesetJobsOpts{BulkGroup: bulkGroupID})
+	if err != nil {
+		return errors.Wrap(err, "loading merge rollout jobs")
+	}
+	if len(jobs) == 0 {
+		return ErrMergeRolloutNotPaused
+	}
+
+	// Load the BatchChange to check for write permissions.
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: jobs[0].BatchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch change")
+	}
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return err
+	}
+
+	if err := s.store.ResumeMergeRollout(ctx, bulkGroupID); err != nil {
+		if err == store.ErrNoResults {
+			return ErrMergeRolloutNotPaused
+		}
+		return err
+	}
+	return nil
+}
+
+// createChangesetJobs creates one changeset job for each given Changeset in the
+// given BatchChange with the payload returned by payloads for it.
+func (s *Service) createChangesetJobs(ctx context.Context, batchChangeID int64, ids []int64, jobType btypes.ChangesetJobType, payloads func([]*btypes.Changeset) ([]any, error), listOpts store.ListChangesetsOpts) (bulkGroupID string, err error) {
 	// Load the BatchChange to check for write permissions.
 	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
 	if err != nil {
@@ -1348,6 +1480,11 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 		return bulkGroupID, ErrChangesetsForJobNotFound
 	}
 
+	jobPayloads, err := payloads(cs)
+	if err != nil {
+		return bulkGroupID, err
+	}
+
 	bulkGroupID, err = store.RandomID()
 	if err != nil {
 		return bulkGroupID, errors.Wrap(err, "creating bulkGroupID failed")
@@ -1361,7 +1498,7 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 
 	userID := sgactor.FromContext(ctx).UID
 	changesetJobs := make([]*btypes.ChangesetJob, 0, len(cs))
-	for _, changeset := range cs {
+	for i, changeset := range cs {
 		changesetJobs = append(changesetJobs, &btypes.ChangesetJob{
 			BulkGroup:     bulkGroupID,
 			ChangesetID:   changeset.ID,
@@ -1369,7 +1506,7 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 			UserID:        userID,
 			State:         btypes.ChangesetJobStateQueued,
 			JobType:       jobType,
-			Payload:       payload,
+			Payload:       jobPayloads[i],
 		})
 	}
 
@@ -1267,6 +1267,70 @@ index e5af166..d44c3fc 100644
 			}
 		})
 
+		t.Run("creates merge rollout jobs", func(t *testing.T) {
+			var ids []int64
+			for i := 0; i < 3; i++ {
+				changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+					Repo:             rs[i].ID,
+					PublicationState: btypes.ChangesetPublicationStatePublished,
+					BatchChange:      batchChange.ID,
+				})
+				ids = append(ids, changeset.ID)
+			}
+
+			bulkGroupID, err := svc.CreateMergeRolloutJobs(
+				adminCtx,
+				batchChange.ID,
+				ids,
+				MergeRolloutOpts{Squash: true, Waves: []int32{50}, MaxFailedChecks: 1},
+				store.ListChangesetsOpts{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			jobs, err := s.ListChangesetJobs(ctx, store.ListChangesetJobsOpts{BulkGroup: bulkGroupID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var have []btypes.ChangesetJobMergePayload
+			for _, job := range jobs {
+				have = append(have, *job.Payload.(*btypes.ChangesetJobMergePayload))
+			}
+			want := []btypes.ChangesetJobMergePayload{
+				{Squash: true, Rollout: &btypes.ChangesetJobMergeRollout{Wave: 0, MaxFailedChecks: 1}},
+				{Squash: true, Rollout: &btypes.ChangesetJobMergeRollout{Wave: 0, MaxFailedChecks: 1}},
+				{Squash: true, Rollout: &btypes.ChangesetJobMergeRollout{Wave: 1, MaxFailedChecks: 1}},
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatalf("unexpected payloads (-want +got):\n%s", diff)
+			}
+
+			if err := svc.ResumeMergeRollout(adminCtx, bulkGroupID); err != ErrMergeRolloutNotPaused {
+				t.Fatalf("wrong error. want=%s, got=%v", ErrMergeRolloutNotPaused, err)
+			}
+
+			if err := s.PauseMergeRollout(ctx, bulkGroupID, jobs[2].ID, 1); err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.ResumeMergeRollout(userCtx, bulkGroupID); !errcode.IsUnauthorized(err) {
+				t.Fatalf("expected unauthorized error, got %+v", err)
+			}
+			if err := svc.ResumeMergeRollout(adminCtx, bulkGroupID); err != nil {
+				t.Fatal(err)
+			}
+
+			jobs, err = s.ListChangesetJobs(ctx, store.ListChangesetJobsOpts{BulkGroup: bulkGroupID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, job := range jobs {
+				if rollout := job.Payload.(*btypes.ChangesetJobMergePayload).Rollout; rollout.Paused || rollout.AcceptedFailedChecks != 1 {
+					t.Fatalf("unexpected rollout after resuming: %+v", rollout)
+				}
+			}
+		})
+
 		t.Run("DetachChangesets", func(t *testing.T) {
 			spec := testBatchSpec(admin.ID)
 			if err := s.CreateBatchSpec(ctx, spec); err != nil {
@@ -3412,3 +3476,40 @@ func assertNoAuthError(t *testing.T, err error) {
 		t.Fatalf("got auth error")
 	}
 }
+
+func TestMergeRolloutWaves(t *testing.T) {
+	tcs := []struct {
+		name        string
+		count       int
+		percentages []int32
+		want        []int
+		wantErr     bool
+	}{
+		{name: "single wave", count: 3, percentages: []int32{100}, want: []int{0, 0, 0}},
+		{name: "rest in final wave", count: 10, percentages: []int32{10, 50}, want: []int{0, 1, 1, 1, 1, 2, 2, 2, 2, 2}},
+		{name: "rounds up", count: 3, percentages: []int32{10, 50}, want: []int{0, 1, 2}},
+		{name: "no changesets", count: 0, percentages: []int32{10}, want: []int{}},
+		{name: "no waves", count: 3, wantErr: true},
+		{name: "zero percent", count: 3, percentages: []int32{0, 50}, wantErr: true},
+		{name: "above 100 percent", count: 3, percentages: []int32{150}, wantErr: true},
+		{name: "not ascending", count: 3, percentages: []int32{50, 10}, wantErr: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := mergeRolloutWaves(tc.count, tc.percentages)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("no error returned")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("unexpected waves (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
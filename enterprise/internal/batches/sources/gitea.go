@@ -0,0 +1,356 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type GiteaSource struct {
+	client gitea.Client
+}
+
+var (
+	_ ForkableChangesetSource = GiteaSource{}
+	_ DraftChangesetSource    = GiteaSource{}
+)
+
+func NewGiteaSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Gitea URL")
+	}
+
+	client, err := gitea.NewClient(svc.URN(), u, &auth.OAuthBearerToken{Token: c.Token}, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Gitea client")
+	}
+
+	return &GiteaSource{client: client}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s GiteaSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s GiteaSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	client, err := s.client.WithAuthenticator(a)
+	if err != nil {
+		return nil, newUnsupportedAuthenticatorError("GiteaSource", a)
+	}
+
+	return &GiteaSource{client: client}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s GiteaSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.GetAuthenticatedUser(ctx)
+	return err
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s GiteaSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repository)
+	index, err := strconv.ParseInt(cs.ExternalID, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "converting external ID %q", cs.ExternalID)
+	}
+
+	pr, err := s.client.GetPullRequest(ctx, repo.Owner.Login, repo.Name, index)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, repo, pr, cs)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+func (s GiteaSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, cs.Title)
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft
+// mode. Gitea has no draft flag on pull requests, but treats pull requests
+// whose title starts with a work in progress prefix as drafts.
+func (s GiteaSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, gitea.DraftTitle(cs.Title))
+}
+
+func (s GiteaSource) createChangeset(ctx context.Context, cs *Changeset, title string) (bool, error) {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repository)
+
+	head := gitdomain.AbbreviateRef(cs.HeadRef)
+	// If we're forking, the head branch has to be qualified with the owner of
+	// the fork.
+	if cs.RemoteRepo != cs.TargetRepo {
+		fork := cs.RemoteRepo.Metadata.(*gitea.Repository)
+		head = fork.Owner.Login + ":" + head
+	}
+	base := gitdomain.AbbreviateRef(cs.BaseRef)
+
+	exists := false
+	pr, err := s.client.CreatePullRequest(ctx, repo.Owner.Login, repo.Name, gitea.CreatePullRequestInput{
+		Head:  head,
+		Base:  base,
+		Title: title,
+		Body:  cs.Body,
+	})
+	if err != nil {
+		if gitea.HTTPErrorCode(err) != http.StatusConflict {
+			return false, errors.Wrap(err, "creating pull request")
+		}
+
+		exists = true
+		pr, err = s.client.GetPullRequestByBranch(ctx, repo.Owner.Login, repo.Name, base, head)
+		if err != nil {
+			return false, errors.Wrap(err, "fetching existing pull request")
+		}
+	}
+
+	if err := s.setChangesetMetadata(ctx, repo, pr, cs); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// UndraftChangeset will update the Changeset on the source to be not in draft mode anymore.
+func (s GiteaSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	title := gitea.UndraftTitle(cs.Title)
+	return s.editPullRequest(ctx, cs, gitea.EditPullRequestInput{Title: &title})
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost.
+func (s GiteaSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	state := gitea.PullRequestStateClosed
+	return s.editPullRequest(ctx, cs, gitea.EditPullRequestInput{State: &state})
+}
+
+// UpdateChangeset can update Changesets.
+func (s GiteaSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	title := cs.Title
+	// Keep the work in progress prefix, otherwise updating a draft would
+	// implicitly mark it as ready for review.
+	if pr, ok := cs.Metadata.(*giteabatches.AnnotatedPullRequest); ok && pr.IsDraft() {
+		title = gitea.DraftTitle(cs.Title)
+	}
+	base := gitdomain.AbbreviateRef(cs.BaseRef)
+
+	return s.editPullRequest(ctx, cs, gitea.EditPullRequestInput{
+		Title: &title,
+		Body:  &cs.Body,
+		Base:  &base,
+	})
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s GiteaSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	state := gitea.PullRequestStateOpen
+	return s.editPullRequest(ctx, cs, gitea.EditPullRequestInput{State: &state})
+}
+
+func (s GiteaSource) editPullRequest(ctx context.Context, cs *Changeset, input gitea.EditPullRequestInput) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repository)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	updated, err := s.client.EditPullRequest(ctx, repo.Owner.Login, repo.Name, pr.Index, input)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "updating pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, cs)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s GiteaSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repository)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	return s.client.CreateIssueComment(ctx, repo.Owner.Login, repo.Name, pr.Index, comment)
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, and the code host supports squash merges, the source
+// must attempt a squash merge. Otherwise, it is expected to perform a regular
+// merge. If the changeset cannot be merged, because it is in an unmergeable
+// state, ChangesetNotMergeableError must be returned.
+func (s GiteaSource) MergeChangeset(ctx context.Context, cs *Changeset, squash bool) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repository)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	style := gitea.MergeStyleMerge
+	if squash {
+		style = gitea.MergeStyleSquash
+	}
+
+	err := s.client.MergePullRequest(ctx, repo.Owner.Login, repo.Name, pr.Index, gitea.MergePullRequestInput{
+		Style:                  style,
+		DeleteBranchAfterMerge: conf.Get().BatchChangesAutoDeleteBranch,
+	})
+	if err != nil {
+		// Gitea responds with 405 if the pull request cannot be merged and with
+		// 409 if its head changed or conflicts with the base. Everything else,
+		// such as authentication or server errors, may succeed when retried.
+		if code := gitea.HTTPErrorCode(err); code == http.StatusMethodNotAllowed || code == http.StatusConflict {
+			return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return errors.Wrap(err, "merging pull request")
+	}
+
+	// The merge endpoint doesn't return the pull request, so we have to load
+	// it again to pick up the merged state.
+	return s.LoadChangeset(ctx, cs)
+}
+
+// GetFork returns a repo pointing to a fork of the target repo, ensuring that the fork
+// exists and creating it if it doesn't. If namespace is not provided, the fork will be in
+// the currently authenticated user's namespace. If name is not provided, the fork will be
+// named with the default Sourcegraph convention: "${original-namespace}-${original-name}"
+func (s GiteaSource) GetFork(ctx context.Context, targetRepo *types.Repo, ns, n *string) (*types.Repo, error) {
+	user, err := s.client.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting the current user")
+	}
+
+	namespace := user.Login
+	if ns != nil {
+		namespace = *ns
+	}
+
+	tr := targetRepo.Metadata.(*gitea.Repository)
+
+	var name string
+	if n != nil {
+		name = *n
+	} else {
+		name = DefaultForkName(tr.Owner.Login, tr.Name)
+	}
+
+	// Figure out if we already have a fork of the repo in the given namespace.
+	if fork, err := s.client.GetRepo(ctx, namespace, name); err == nil {
+		return s.checkAndCopy(targetRepo, fork)
+	} else if !errcode.IsNotFound(err) {
+		return nil, errors.Wrap(err, "checking for fork existence")
+	}
+
+	input := gitea.CreateForkInput{Name: name}
+	// Forks into the namespace of the current user must not name an
+	// organization.
+	if namespace != user.Login {
+		input.Organization = namespace
+	}
+
+	fork, err := s.client.ForkRepo(ctx, tr.Owner.Login, tr.Name, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "forking repository")
+	}
+
+	return s.checkAndCopy(targetRepo, fork)
+}
+
+func (s GiteaSource) BuildCommitOpts(repo *types.Repo, _ *btypes.Changeset, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
+	return BuildCommitOptsCommon(repo, spec, pushOpts)
+}
+
+func (s GiteaSource) checkAndCopy(targetRepo *types.Repo, fork *gitea.Repository) (*types.Repo, error) {
+	tr := targetRepo.Metadata.(*gitea.Repository)
+
+	if !fork.Fork || fork.Parent == nil {
+		return nil, errors.New("repo is not a fork")
+	} else if fork.Parent.ID != tr.ID {
+		return nil, errors.New("repo was not forked from the given parent")
+	}
+
+	// Now we make a copy of targetRepo, but with its sources and metadata updated to
+	// point to the fork
+	forkRepo, err := CopyRepoAsFork(targetRepo, fork, tr.FullName, fork.FullName)
+	if err != nil {
+		return nil, errors.Wrap(err, "updating target repo sources and metadata")
+	}
+
+	return forkRepo, nil
+}
+
+func (s GiteaSource) annotatePullRequest(ctx context.Context, repo *gitea.Repository, pr *gitea.PullRequest) (*giteabatches.AnnotatedPullRequest, error) {
+	reviews, err := s.client.ListPullRequestReviews(ctx, repo.Owner.Login, repo.Name, pr.Index)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting pull request reviews")
+	}
+
+	var statuses []*gitea.CommitStatus
+	if pr.Head != nil && pr.Head.Sha != "" {
+		statuses, err = s.client.ListCommitStatuses(ctx, repo.Owner.Login, repo.Name, pr.Head.Sha)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting commit statuses")
+		}
+	}
+
+	return &giteabatches.AnnotatedPullRequest{
+		PullRequest: pr,
+		Reviews:     reviews,
+		Statuses:    statuses,
+	}, nil
+}
+
+func (s GiteaSource) setChangesetMetadata(ctx context.Context, repo *gitea.Repository, pr *gitea.PullRequest, cs *Changeset) error {
+	apr, err := s.annotatePullRequest(ctx, repo, pr)
+	if err != nil {
+		return errors.Wrap(err, "annotating pull request")
+	}
+
+	if err := cs.SetMetadata(apr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
@@ -0,0 +1,439 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// The cassettes for these tests were recorded against a Gitea instance with
+// the repository sourcegraph/automation-testing and a fork of it at
+// milton/sourcegraph-automation-testing.
+
+func TestGiteaSource_LoadChangeset(t *testing.T) {
+	testCases := []struct {
+		name string
+		cs   *Changeset
+		err  string
+	}{
+		{
+			name: "found",
+			cs: &Changeset{
+				RemoteRepo: giteaTestRepo,
+				TargetRepo: giteaTestRepo,
+				Changeset:  &btypes.Changeset{ExternalID: "1"},
+			},
+			err: "<nil>",
+		},
+		{
+			name: "not-found",
+			cs: &Changeset{
+				RemoteRepo: giteaTestRepo,
+				TargetRepo: giteaTestRepo,
+				Changeset:  &btypes.Changeset{ExternalID: "100000"},
+			},
+			err: "Changeset with external ID 100000 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "GiteaSource_LoadChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			src, save := setupGiteaSource(t, ctx, tc.name)
+			defer save(t)
+
+			err := src.LoadChangeset(ctx, tc.cs)
+			if have, want := fmt.Sprint(err), tc.err; have != want {
+				t.Errorf("error:\nhave: %q\nwant: %q", have, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			meta := tc.cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+			testutil.AssertGolden(t, "testdata/golden/"+tc.name, update(tc.name), meta)
+		})
+	}
+}
+
+func TestGiteaSource_CreateChangeset(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cs     *Changeset
+		draft  bool
+		err    string
+		exists bool
+	}{
+		{
+			name: "success",
+			cs: &Changeset{
+				Title:      "Test PR",
+				Body:       "Test body",
+				HeadRef:    "refs/heads/test-pr",
+				BaseRef:    "refs/heads/main",
+				RemoteRepo: giteaTestRepo,
+				TargetRepo: giteaTestRepo,
+				Changeset:  &btypes.Changeset{},
+			},
+			err: "<nil>",
+		},
+		{
+			name: "already exists",
+			cs: &Changeset{
+				Title:      "Test PR",
+				Body:       "Test body",
+				HeadRef:    "refs/heads/test-pr",
+				BaseRef:    "refs/heads/main",
+				RemoteRepo: giteaTestRepo,
+				TargetRepo: giteaTestRepo,
+				Changeset:  &btypes.Changeset{},
+			},
+			err:    "<nil>",
+			exists: true,
+		},
+		{
+			name: "fork",
+			cs: &Changeset{
+				Title:      "Test PR",
+				Body:       "Test body",
+				HeadRef:    "refs/heads/test-pr",
+				BaseRef:    "refs/heads/main",
+				RemoteRepo: giteaTestForkRepo,
+				TargetRepo: giteaTestRepo,
+				Changeset:  &btypes.Changeset{},
+			},
+			err: "<nil>",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "GiteaSource_CreateChangeset_" + strings.ReplaceAll(tc.name, " ", "_")
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			src, save := setupGiteaSource(t, ctx, tc.name)
+			defer save(t)
+
+			exists, err := src.CreateChangeset(ctx, tc.cs)
+			if have, want := fmt.Sprint(err), tc.err; have != want {
+				t.Errorf("error:\nhave: %q\nwant: %q", have, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if have, want := exists, tc.exists; have != want {
+				t.Errorf("exists:\nhave: %t\nwant: %t", have, want)
+			}
+
+			pr := tc.cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+			testutil.AssertGolden(t, "testdata/golden/"+tc.name, update(tc.name), pr)
+		})
+	}
+}
+
+func TestGiteaSource_CreateDraftChangeset(t *testing.T) {
+	name := "GiteaSource_CreateDraftChangeset_success"
+
+	ctx := context.Background()
+	src, save := setupGiteaSource(t, ctx, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Title:      "Test PR",
+		Body:       "Test body",
+		HeadRef:    "refs/heads/test-pr",
+		BaseRef:    "refs/heads/main",
+		RemoteRepo: giteaTestRepo,
+		TargetRepo: giteaTestRepo,
+		Changeset:  &btypes.Changeset{},
+	}
+
+	exists, err := src.CreateDraftChangeset(ctx, cs)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	pr := cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+	assert.True(t, pr.IsDraft())
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+}
+
+func TestGiteaSource_EditChangeset(t *testing.T) {
+	testCases := []struct {
+		name  string
+		index int64
+		title string
+		body  string
+		edit  func(context.Context, *GiteaSource, *Changeset) error
+	}{
+		{
+			name:  "UndraftChangeset",
+			index: 4,
+			title: "WIP: Test PR",
+			edit: func(ctx context.Context, src *GiteaSource, cs *Changeset) error {
+				return src.UndraftChangeset(ctx, cs)
+			},
+		},
+		{
+			name:  "CloseChangeset",
+			index: 2,
+			title: "Test PR",
+			edit: func(ctx context.Context, src *GiteaSource, cs *Changeset) error {
+				return src.CloseChangeset(ctx, cs)
+			},
+		},
+		{
+			name:  "ReopenChangeset",
+			index: 2,
+			title: "Test PR",
+			edit: func(ctx context.Context, src *GiteaSource, cs *Changeset) error {
+				return src.ReopenChangeset(ctx, cs)
+			},
+		},
+		{
+			name:  "UpdateChangeset",
+			index: 2,
+			title: "Updated title",
+			body:  "Updated body",
+			edit: func(ctx context.Context, src *GiteaSource, cs *Changeset) error {
+				return src.UpdateChangeset(ctx, cs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		name := "GiteaSource_" + tc.name + "_success"
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			src, save := setupGiteaSource(t, ctx, name)
+			defer save(t)
+
+			cs := giteaTestChangeset(tc.index)
+			cs.Title = tc.title
+			cs.Body = tc.body
+			cs.BaseRef = "refs/heads/main"
+
+			require.NoError(t, tc.edit(ctx, src, cs))
+
+			pr := cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+			testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+		})
+	}
+}
+
+func TestGiteaSource_CreateComment(t *testing.T) {
+	name := "GiteaSource_CreateComment_success"
+
+	ctx := context.Background()
+	src, save := setupGiteaSource(t, ctx, name)
+	defer save(t)
+
+	err := src.CreateComment(ctx, giteaTestChangeset(2), "test-comment")
+	assert.NoError(t, err)
+}
+
+func TestGiteaSource_MergeChangeset(t *testing.T) {
+	testCases := []struct {
+		name         string
+		squash       bool
+		notMergeable bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:   "squash",
+			squash: true,
+		},
+		{
+			name:         "not mergeable",
+			notMergeable: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "GiteaSource_MergeChangeset_" + strings.ReplaceAll(tc.name, " ", "_")
+
+		t.Run(tc.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{
+				SiteConfiguration: schema.SiteConfiguration{
+					BatchChangesAutoDeleteBranch: false,
+				},
+			})
+			defer conf.Mock(nil)
+
+			ctx := context.Background()
+			src, save := setupGiteaSource(t, ctx, tc.name)
+			defer save(t)
+
+			cs := giteaTestChangeset(2)
+			err := src.MergeChangeset(ctx, cs, tc.squash)
+			if tc.notMergeable {
+				var target ChangesetNotMergeableError
+				assert.ErrorAs(t, err, &target)
+				return
+			}
+			require.NoError(t, err)
+
+			pr := cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+			assert.True(t, pr.HasMerged)
+			testutil.AssertGolden(t, "testdata/golden/"+tc.name, update(tc.name), pr)
+		})
+	}
+}
+
+func TestGiteaSource_MergeChangeset_Errors(t *testing.T) {
+	conf.Mock(&conf.Unified{})
+	defer conf.Mock(nil)
+
+	for status, notMergeable := range map[int]bool{
+		http.StatusMethodNotAllowed:    true,
+		http.StatusConflict:            true,
+		http.StatusUnauthorized:        false,
+		http.StatusNotFound:            false,
+		http.StatusInternalServerError: false,
+	} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			}))
+			defer srv.Close()
+
+			u, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			client, err := gitea.NewClient(giteaTestURN, u, nil, srv.Client())
+			require.NoError(t, err)
+
+			err = GiteaSource{client: client}.MergeChangeset(context.Background(), giteaTestChangeset(2), false)
+			require.Error(t, err)
+			var target ChangesetNotMergeableError
+			assert.Equal(t, notMergeable, errors.As(err, &target))
+			if !notMergeable {
+				// Other errors are returned unchanged, so they can be retried.
+				assert.Equal(t, status, gitea.HTTPErrorCode(err))
+			}
+		})
+	}
+}
+
+func TestGiteaSource_GetFork(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("existing fork in user namespace", func(t *testing.T) {
+		src, save := setupGiteaSource(t, ctx, "GiteaSource_GetFork_existing")
+		defer save(t)
+
+		fork, err := src.GetFork(ctx, giteaTestRepo, nil, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, giteaTestRepo, fork)
+		assert.Equal(t, "milton/sourcegraph-automation-testing", fork.Metadata.(*gitea.Repository).FullName)
+		assert.Equal(t, "https://gitea.sgdev.org/milton/sourcegraph-automation-testing.git", fork.Sources[giteaTestURN].CloneURL)
+	})
+
+	t.Run("new fork in organization", func(t *testing.T) {
+		src, save := setupGiteaSource(t, ctx, "GiteaSource_GetFork_new_in_org")
+		defer save(t)
+
+		fork, err := src.GetFork(ctx, giteaTestRepo, pointers.Ptr("sourcegraph-forks"), pointers.Ptr("automation-testing"))
+		require.NoError(t, err)
+		assert.Equal(t, "sourcegraph-forks/automation-testing", fork.Metadata.(*gitea.Repository).FullName)
+		assert.Equal(t, "https://gitea.sgdev.org/sourcegraph-forks/automation-testing.git", fork.Sources[giteaTestURN].CloneURL)
+	})
+
+	t.Run("existing repo is not a fork", func(t *testing.T) {
+		src, save := setupGiteaSource(t, ctx, "GiteaSource_GetFork_not_a_fork")
+		defer save(t)
+
+		fork, err := src.GetFork(ctx, giteaTestRepo, nil, nil)
+		assert.Nil(t, fork)
+		assert.ErrorContains(t, err, "repo is not a fork")
+	})
+}
+
+var (
+	giteaTestURN = extsvc.URN(extsvc.VariantGitea.AsKind(), 1)
+
+	giteaTestRepo = &types.Repo{
+		Metadata: &gitea.Repository{
+			ID:       1,
+			Owner:    &gitea.User{ID: 101, Login: "sourcegraph"},
+			Name:     "automation-testing",
+			FullName: "sourcegraph/automation-testing",
+		},
+		Sources: map[string]*types.SourceInfo{
+			giteaTestURN: {
+				ID:       giteaTestURN,
+				CloneURL: "https://gitea.sgdev.org/sourcegraph/automation-testing.git",
+			},
+		},
+	}
+
+	giteaTestForkRepo = &types.Repo{
+		Metadata: &gitea.Repository{
+			ID:       4,
+			Owner:    &gitea.User{ID: 104, Login: "milton"},
+			Name:     "sourcegraph-automation-testing",
+			FullName: "milton/sourcegraph-automation-testing",
+			Fork:     true,
+		},
+	}
+)
+
+func giteaTestChangeset(index int64) *Changeset {
+	return &Changeset{
+		RemoteRepo: giteaTestRepo,
+		TargetRepo: giteaTestRepo,
+		Changeset: &btypes.Changeset{
+			ExternalID: fmt.Sprint(index),
+			Metadata: &giteabatches.AnnotatedPullRequest{
+				PullRequest: &gitea.PullRequest{Index: index},
+			},
+		},
+	}
+}
+
+func setupGiteaSource(t *testing.T, ctx context.Context, tName string) (src *GiteaSource, save func(testing.TB)) {
+	cf, save := newClientFactory(t, tName)
+
+	svc := &types.ExternalService{
+		Kind: extsvc.VariantGitea.AsKind(),
+		Config: extsvc.NewUnencryptedConfig(marshalJSON(t, &schema.GiteaConnection{
+			Url:   "https://gitea.sgdev.org",
+			Token: os.Getenv("GITEA_TOKEN"),
+		})),
+	}
+
+	src, err := NewGiteaSource(ctx, svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src, save
+}
@@ -10774,6 +10774,9 @@ type MockGitserverClient struct {
 	// StreamBlameFileFunc is an instance of a mock function object
 	// controlling the behavior of the method StreamBlameFile.
 	StreamBlameFileFunc *GitserverClientStreamBlameFileFunc
+	// UnappliedCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method UnappliedCommits.
+	UnappliedCommitsFunc *GitserverClientUnappliedCommitsFunc
 }
 
 // NewMockGitserverClient creates a new mock of the Client interface. All
@@ -11045,6 +11048,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		UnappliedCommitsFunc: &GitserverClientUnappliedCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (r0 []api.CommitID, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -11317,6 +11325,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.StreamBlameFile")
 			},
 		},
+		UnappliedCommitsFunc: &GitserverClientUnappliedCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+				panic("unexpected invocation of MockGitserverClient.UnappliedCommits")
+			},
+		},
 	}
 }
 
@@ -11484,6 +11497,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		StreamBlameFileFunc: &GitserverClientStreamBlameFileFunc{
 			defaultHook: i.StreamBlameFile,
 		},
+		UnappliedCommitsFunc: &GitserverClientUnappliedCommitsFunc{
+			defaultHook: i.UnappliedCommits,
+		},
 	}
 }
 
@@ -17547,3 +17563,115 @@ func (c GitserverClientStreamBlameFileFuncCall) Args() []interface{} {
 func (c GitserverClientStreamBlameFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// GitserverClientUnappliedCommitsFunc describes the behavior when the
+// UnappliedCommits method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientUnappliedCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)
+	history     []GitserverClientUnappliedCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// UnappliedCommits delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) UnappliedCommits(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID) ([]api.CommitID, error) {
+	r0, r1 := m.UnappliedCommitsFunc.nextHook()(v0, v1, v2, v3)
+	m.UnappliedCommitsFunc.appendCall(GitserverClientUnappliedCommitsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UnappliedCommits
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientUnappliedCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UnappliedCommits method of the parent MockGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverClientUnappliedCommitsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientUnappliedCommitsFunc) SetDefaultReturn(r0 []api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientUnappliedCommitsFunc) PushReturn(r0 []api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientUnappliedCommitsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientUnappliedCommitsFunc) appendCall(r0 GitserverClientUnappliedCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientUnappliedCommitsFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientUnappliedCommitsFunc) History() []GitserverClientUnappliedCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientUnappliedCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientUnappliedCommitsFuncCall is an object that describes an
+// invocation of method UnappliedCommits on an instance of
+// MockGitserverClient.
+type GitserverClientUnappliedCommitsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientUnappliedCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientUnappliedCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,324 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+
+	bgql "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/graphql"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PatchSource is a ChangesetSource for code hosts that have no pull request
+// API, such as Pagure, Gitolite and Phabricator. Changes are pushed to a
+// branch of the repository like on every other code host, and then published
+// as a patch series that maintainers can download and apply with `git am`.
+//
+// Since the code host doesn't know about the series, its state is tracked by
+// Sourcegraph: closing and reopening only change the tracked state, and the
+// series is considered merged once its head commit is contained in the base
+// branch.
+type PatchSource struct {
+	gitserverClient gitserver.Client
+	au              auth.Authenticator
+}
+
+var _ ChangesetSource = PatchSource{}
+
+func NewPatchSource(_ context.Context, _ *types.ExternalService, _ *httpcli.Factory) (*PatchSource, error) {
+	return &PatchSource{gitserverClient: gitserver.NewClient()}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s PatchSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.au)
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s PatchSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth, *auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("PatchSource", a)
+	}
+
+	s.au = a
+	return s, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+//
+// There is no API we could validate the credentials against, so whether they
+// grant push access only becomes apparent when pushing.
+func (s PatchSource) ValidateAuthenticator(ctx context.Context) error {
+	if s.au == nil {
+		return errors.New("no credentials set for PatchSource")
+	}
+	return nil
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s PatchSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	series, ok := cs.Metadata.(*patch.Series)
+	if !ok {
+		// The changeset is being imported, so the external ID is the name of an
+		// existing branch that we start tracking as a patch series.
+		return s.importSeries(ctx, cs)
+	}
+
+	// Work on a copy, so the changeset isn't modified if loading fails.
+	updated := *series
+	if err := s.refreshSeries(ctx, cs.TargetRepo, &updated); err != nil {
+		return err
+	}
+
+	return s.setChangesetMetadata(cs, &updated)
+}
+
+func (s PatchSource) importSeries(ctx context.Context, cs *Changeset) error {
+	repo := cs.TargetRepo
+
+	head, err := s.gitserverClient.ResolveRevision(ctx, repo.Name, gitdomain.EnsureRefPrefix(cs.ExternalID), gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "resolving branch")
+	}
+
+	commit, err := s.gitserverClient.GetCommit(ctx, authz.DefaultSubRepoPermsChecker, repo.Name, head, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return errors.Wrap(err, "getting head commit")
+	}
+
+	baseRef, _, err := s.gitserverClient.GetDefaultBranch(ctx, repo.Name, false)
+	if err != nil {
+		return errors.Wrap(err, "getting default branch")
+	}
+
+	series := &patch.Series{
+		ServiceType: repo.ExternalRepo.ServiceType,
+		Title:       commit.Message.Subject(),
+		Body:        commit.Message.Body(),
+		Branch:      gitdomain.AbbreviateRef(cs.ExternalID),
+		BaseRef:     baseRef,
+		State:       patch.SeriesStateOpen,
+		URL:         patchURL(cs.Changeset.ID),
+		CreatedAt:   commit.Author.Date,
+		UpdatedAt:   commit.Author.Date,
+	}
+	if err := s.refreshSeries(ctx, repo, series); err != nil {
+		return err
+	}
+
+	return s.setChangesetMetadata(cs, series)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+//
+// The branch has already been pushed at this point, so publishing the series
+// only means to start tracking it.
+func (s PatchSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	branch := gitdomain.AbbreviateRef(cs.HeadRef)
+	now := timeutil.Now()
+
+	exists := false
+	series, ok := cs.Metadata.(*patch.Series)
+	if ok && series.Branch == branch {
+		exists = true
+		updated := *series
+		series = &updated
+	} else {
+		series = &patch.Series{
+			ServiceType: cs.TargetRepo.ExternalRepo.ServiceType,
+			Branch:      branch,
+			CreatedAt:   now,
+		}
+	}
+
+	series.Title = cs.Title
+	series.Body = cs.Body
+	series.BaseRef = cs.BaseRef
+	series.State = patch.SeriesStateOpen
+	series.URL = patchURL(cs.Changeset.ID)
+	series.UpdatedAt = now
+
+	if err := s.refreshSeries(ctx, cs.TargetRepo, series); err != nil {
+		return false, err
+	}
+
+	return exists, s.setChangesetMetadata(cs, series)
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost.
+//
+// The pushed branch is left in place, so maintainers can still refer to it.
+func (s PatchSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	return s.updateSeries(cs, func(series *patch.Series) {
+		series.State = patch.SeriesStateClosed
+	})
+}
+
+// UpdateChangeset can update Changesets.
+func (s PatchSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	return s.updateSeries(cs, func(series *patch.Series) {
+		series.Title = cs.Title
+		series.Body = cs.Body
+		series.BaseRef = cs.BaseRef
+	})
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s PatchSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	return s.updateSeries(cs, func(series *patch.Series) {
+		if series.State == patch.SeriesStateClosed {
+			series.State = patch.SeriesStateOpen
+		}
+	})
+}
+
+func (s PatchSource) updateSeries(cs *Changeset, update func(*patch.Series)) error {
+	series, ok := cs.Metadata.(*patch.Series)
+	if !ok {
+		return ChangesetNotFoundError{Changeset: cs}
+	}
+
+	updated := *series
+	update(&updated)
+	updated.UpdatedAt = timeutil.Now()
+
+	return s.setChangesetMetadata(cs, &updated)
+}
+
+// CreateComment posts a comment on the Changeset.
+//
+// Patch series have no place to keep comments in, since they are sent to and
+// discussed by maintainers outside of the code host.
+func (s PatchSource) CreateComment(_ context.Context, _ *Changeset, _ string) error {
+	return errors.New("commenting is not supported on patch series")
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, and the code host supports squash merges, the source
+// must attempt a squash merge. Otherwise, it is expected to perform a regular
+// merge. If the changeset cannot be merged, because it is in an unmergeable
+// state, ChangesetNotMergeableError must be returned.
+//
+// Patch series are applied by the maintainers of the repository, so they can
+// never be merged from Sourcegraph.
+func (s PatchSource) MergeChangeset(_ context.Context, _ *Changeset, _ bool) error {
+	return ChangesetNotMergeableError{ErrorMsg: "patch series have to be applied by a maintainer of the repository"}
+}
+
+func (s PatchSource) BuildCommitOpts(repo *types.Repo, _ *btypes.Changeset, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
+	return BuildCommitOptsCommon(repo, spec, pushOpts)
+}
+
+// refreshSeries updates the state of the series from the mirror of the
+// repository on gitserver.
+func (s PatchSource) refreshSeries(ctx context.Context, repo *types.Repo, series *patch.Series) error {
+	base, err := s.gitserverClient.ResolveRevision(ctx, repo.Name, series.BaseRef, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return errors.Wrap(err, "resolving base ref")
+	}
+	series.BaseRefOid = string(base)
+
+	head, err := s.gitserverClient.ResolveRevision(ctx, repo.Name, gitdomain.EnsureRefPrefix(series.Branch), gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		if !errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return errors.Wrap(err, "resolving branch")
+		}
+
+		// Right after pushing, the mirror might not have fetched the branch
+		// yet. Only a branch that we've seen before and that is gone now means
+		// that the series has been merged or withdrawn.
+		if series.HeadRefOid == "" || series.State != patch.SeriesStateOpen {
+			return nil
+		}
+		merged, err := s.isMerged(ctx, repo.Name, base, api.CommitID(series.HeadRefOid))
+		if err != nil {
+			// The commit is gone along with the branch, so it can't have been
+			// merged.
+			merged = false
+		}
+		if merged {
+			series.State = patch.SeriesStateMerged
+		} else {
+			series.State = patch.SeriesStateClosed
+		}
+		return nil
+	}
+	series.HeadRefOid = string(head)
+
+	if series.State != patch.SeriesStateMerged {
+		merged, err := s.isMerged(ctx, repo.Name, base, head)
+		if err != nil {
+			return err
+		}
+		if merged {
+			series.State = patch.SeriesStateMerged
+		}
+	}
+
+	return nil
+}
+
+// isMerged returns whether head has been merged into base. Maintainers usually
+// apply patch series with git am, which creates new commits, so head counts as
+// merged when every commit of the series has an equivalent change in base,
+// compared by patch ID, even if head itself is not contained in base.
+func (s PatchSource) isMerged(ctx context.Context, repo api.RepoName, base, head api.CommitID) (bool, error) {
+	if base == head {
+		return false, nil
+	}
+
+	mergeBase, err := s.gitserverClient.MergeBase(ctx, repo, base, head)
+	if err != nil {
+		return false, errors.Wrap(err, "computing merge base")
+	}
+	if mergeBase == head {
+		return true, nil
+	}
+
+	unapplied, err := s.gitserverClient.UnappliedCommits(ctx, repo, base, head)
+	if err != nil {
+		return false, errors.Wrap(err, "comparing patch IDs")
+	}
+	return len(unapplied) == 0, nil
+}
+
+func (s PatchSource) setChangesetMetadata(cs *Changeset, series *patch.Series) error {
+	if err := cs.SetMetadata(series); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+// patchURL returns the URL the mailbox of the changeset with the given ID can
+// be downloaded from.
+func patchURL(changesetID int64) string {
+	return fmt.Sprintf("%s/.api/batches/changesets/%s/patch", conf.ExternalURL(), bgql.MarshalChangesetID(changesetID))
+}
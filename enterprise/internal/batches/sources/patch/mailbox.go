@@ -0,0 +1,70 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// Commit is a single commit of a patch series.
+type Commit struct {
+	// Oid is the ID of the commit, if it's known. Patches of commits that
+	// haven't been created yet use the all-zero ID, like `git format-patch`
+	// does for its magic timestamp line.
+	Oid         string
+	AuthorName  string
+	AuthorEmail string
+	Date        time.Time
+	Message     string
+	Diff        []byte
+}
+
+const zeroOid = "0000000000000000000000000000000000000000"
+
+// FormatMailbox renders the given commits as a mailbox in the format produced
+// by `git format-patch --stdout`, which can be applied with `git am`.
+func FormatMailbox(commits []Commit) []byte {
+	var buf bytes.Buffer
+	for i, c := range commits {
+		oid := c.Oid
+		if oid == "" {
+			oid = zeroOid
+		}
+
+		subject, body, _ := strings.Cut(strings.TrimSpace(c.Message), "\n")
+		prefix := "[PATCH]"
+		if len(commits) > 1 {
+			prefix = fmt.Sprintf("[PATCH %d/%d]", i+1, len(commits))
+		}
+
+		fmt.Fprintf(&buf, "From %s Mon Sep 17 00:00:00 2001\n", oid)
+		fmt.Fprintf(&buf, "From: %s <%s>\n", encodeHeader(c.AuthorName), c.AuthorEmail)
+		fmt.Fprintf(&buf, "Date: %s\n", c.Date.Format(time.RFC1123Z))
+		fmt.Fprintf(&buf, "Subject: %s %s\n", prefix, encodeHeader(strings.TrimSpace(subject)))
+		buf.WriteString("\n")
+		if body = strings.TrimSpace(body); body != "" {
+			buf.WriteString(body)
+			buf.WriteString("\n")
+		}
+		buf.WriteString("---\n")
+		buf.Write(c.Diff)
+		if len(c.Diff) > 0 && c.Diff[len(c.Diff)-1] != '\n' {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("-- \nSourcegraph\n\n")
+	}
+	return buf.Bytes()
+}
+
+// encodeHeader encodes s as an RFC 2047 encoded-word if it contains non-ASCII
+// characters, as git does for author names and subjects.
+func encodeHeader(s string) string {
+	for _, r := range s {
+		if r > 127 {
+			return mime.QEncoding.Encode("utf-8", s)
+		}
+	}
+	return s
+}
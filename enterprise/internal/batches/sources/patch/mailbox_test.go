@@ -0,0 +1,76 @@
+package patch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormatMailbox(t *testing.T) {
+	date := time.Date(2023, 6, 6, 10, 0, 0, 0, time.UTC)
+	diff := []byte(`diff --git a/README.md b/README.md
+index 1914491..6e4ac7e 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1,2 @@
+ # Hello
++World
+`)
+
+	t.Run("single commit", func(t *testing.T) {
+		have := string(FormatMailbox([]Commit{{
+			AuthorName:  "Batch Changes",
+			AuthorEmail: "batch-changes@sourcegraph.com",
+			Date:        date,
+			Message:     "Add world to README\n\nThe README was missing the world.\n",
+			Diff:        diff,
+		}}))
+
+		want := `From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001
+From: Batch Changes <batch-changes@sourcegraph.com>
+Date: Tue, 06 Jun 2023 10:00:00 +0000
+Subject: [PATCH] Add world to README
+
+The README was missing the world.
+---
+` + string(diff) + `-- 
+Sourcegraph
+
+`
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected mailbox (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("series", func(t *testing.T) {
+		have := string(FormatMailbox([]Commit{
+			{Oid: "3b9a8e1f0c2d4e6f8a0b2c4d6e8f0a1b3c5d7e9f", AuthorName: "Jürgen", AuthorEmail: "j@example.com", Date: date, Message: "First", Diff: []byte("diff")},
+			{AuthorName: "Ada", AuthorEmail: "ada@example.com", Date: date, Message: "Second"},
+		}))
+
+		want := `From 3b9a8e1f0c2d4e6f8a0b2c4d6e8f0a1b3c5d7e9f Mon Sep 17 00:00:00 2001
+From: =?utf-8?q?J=C3=BCrgen?= <j@example.com>
+Date: Tue, 06 Jun 2023 10:00:00 +0000
+Subject: [PATCH 1/2] First
+
+---
+diff
+-- 
+Sourcegraph
+
+From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001
+From: Ada <ada@example.com>
+Date: Tue, 06 Jun 2023 10:00:00 +0000
+Subject: [PATCH 2/2] Second
+
+---
+-- 
+Sourcegraph
+
+`
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected mailbox (-want +have):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package patch
+
+import "time"
+
+// SeriesState is the state of a patch series.
+type SeriesState string
+
+const (
+	// SeriesStateOpen is the state of a series whose branch has been pushed
+	// and that is waiting to be applied by a maintainer.
+	SeriesStateOpen SeriesState = "OPEN"
+	// SeriesStateClosed is the state of a series that has been closed in
+	// Sourcegraph, or whose branch has been deleted from the code host.
+	SeriesStateClosed SeriesState = "CLOSED"
+	// SeriesStateMerged is the state of a series whose head commit is
+	// contained in the base branch.
+	SeriesStateMerged SeriesState = "MERGED"
+)
+
+// Series is the metadata of a changeset on a code host without a pull request
+// API, such as Pagure, Gitolite or Phabricator. The changes are pushed to a
+// branch of the repository and published as a patch series that maintainers
+// can download and apply with `git am`. Since the code host doesn't track the
+// series, its state is tracked by Sourcegraph.
+type Series struct {
+	// ServiceType is the external service type of the repository the series
+	// has been published to.
+	ServiceType string `json:"serviceType"`
+
+	Title string `json:"title"`
+	Body  string `json:"body"`
+
+	// Branch is the name of the branch that has been pushed, without the
+	// refs/heads/ prefix. It doubles as the external ID of the series.
+	Branch string `json:"branch"`
+	// BaseRef is the full ref the series should be applied to.
+	BaseRef string `json:"baseRef"`
+
+	// HeadRefOid is the commit the branch pointed to when it was last seen on
+	// the mirror of the repository. It's empty until the branch has been
+	// fetched for the first time.
+	HeadRefOid string `json:"headRefOid,omitempty"`
+	// BaseRefOid is the commit the base ref pointed to at the same time.
+	BaseRefOid string `json:"baseRefOid,omitempty"`
+
+	State SeriesState `json:"state"`
+
+	// URL is the URL the series can be downloaded from in mailbox format.
+	URL string `json:"url"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,270 @@
+package sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPatchSource_CreateChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("new series", func(t *testing.T) {
+		s := newPatchTestSource(map[string]api.CommitID{
+			"refs/heads/main":    "base",
+			"refs/heads/my-spec": "head",
+		}, nil, nil)
+		cs := newPatchTestChangeset()
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		series := cs.Metadata.(*patch.Series)
+		assert.Equal(t, "my-spec", series.Branch)
+		assert.Equal(t, "Fix everything", series.Title)
+		assert.Equal(t, "refs/heads/main", series.BaseRef)
+		assert.Equal(t, "base", series.BaseRefOid)
+		assert.Equal(t, "head", series.HeadRefOid)
+		assert.Equal(t, patch.SeriesStateOpen, series.State)
+		assert.Contains(t, series.URL, "/.api/batches/changesets/")
+		assert.Equal(t, "my-spec", cs.ExternalID)
+	})
+
+	t.Run("existing series", func(t *testing.T) {
+		s := newPatchTestSource(map[string]api.CommitID{
+			"refs/heads/main":    "base",
+			"refs/heads/my-spec": "head",
+		}, nil, nil)
+		cs := newPatchTestChangeset()
+		cs.Metadata = &patch.Series{Branch: "my-spec", Title: "Old title", State: patch.SeriesStateClosed}
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		series := cs.Metadata.(*patch.Series)
+		assert.Equal(t, "Fix everything", series.Title)
+		assert.Equal(t, patch.SeriesStateOpen, series.State)
+	})
+}
+
+func TestPatchSource_LoadChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		refs    map[string]api.CommitID
+		merged  map[api.CommitID]bool
+		applied map[api.CommitID]bool
+		state   patch.SeriesState
+		want    patch.SeriesState
+	}{
+		"branch unchanged": {
+			refs: map[string]api.CommitID{
+				"refs/heads/main":    "base",
+				"refs/heads/my-spec": "head",
+			},
+			state: patch.SeriesStateOpen,
+			want:  patch.SeriesStateOpen,
+		},
+		"head contained in base": {
+			refs: map[string]api.CommitID{
+				"refs/heads/main":    "base",
+				"refs/heads/my-spec": "head",
+			},
+			merged: map[api.CommitID]bool{"head": true},
+			state:  patch.SeriesStateOpen,
+			want:   patch.SeriesStateMerged,
+		},
+		"series applied to base": {
+			refs: map[string]api.CommitID{
+				"refs/heads/main":    "base",
+				"refs/heads/my-spec": "head",
+			},
+			applied: map[api.CommitID]bool{"head": true},
+			state:   patch.SeriesStateOpen,
+			want:    patch.SeriesStateMerged,
+		},
+		"branch deleted after merge": {
+			refs:   map[string]api.CommitID{"refs/heads/main": "base"},
+			merged: map[api.CommitID]bool{"head": true},
+			state:  patch.SeriesStateOpen,
+			want:   patch.SeriesStateMerged,
+		},
+		"branch deleted after series applied": {
+			refs:    map[string]api.CommitID{"refs/heads/main": "base"},
+			applied: map[api.CommitID]bool{"head": true},
+			state:   patch.SeriesStateOpen,
+			want:    patch.SeriesStateMerged,
+		},
+		"branch deleted without merge": {
+			refs:  map[string]api.CommitID{"refs/heads/main": "base"},
+			state: patch.SeriesStateOpen,
+			want:  patch.SeriesStateClosed,
+		},
+		"closed series stays closed": {
+			refs: map[string]api.CommitID{
+				"refs/heads/main":    "base",
+				"refs/heads/my-spec": "head",
+			},
+			state: patch.SeriesStateClosed,
+			want:  patch.SeriesStateClosed,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := newPatchTestSource(tc.refs, tc.merged, tc.applied)
+			cs := newPatchTestChangeset()
+			cs.Metadata = &patch.Series{
+				Branch:     "my-spec",
+				BaseRef:    "refs/heads/main",
+				HeadRefOid: "head",
+				State:      tc.state,
+			}
+
+			require.NoError(t, s.LoadChangeset(ctx, cs))
+			assert.Equal(t, tc.want, cs.Metadata.(*patch.Series).State)
+		})
+	}
+
+	t.Run("import existing branch", func(t *testing.T) {
+		s := newPatchTestSource(map[string]api.CommitID{
+			"refs/heads/main":    "base",
+			"refs/heads/my-spec": "head",
+		}, nil, nil)
+		cs := newPatchTestChangeset()
+		cs.ExternalID = "my-spec"
+
+		require.NoError(t, s.LoadChangeset(ctx, cs))
+
+		series := cs.Metadata.(*patch.Series)
+		assert.Equal(t, "Imported change", series.Title)
+		assert.Equal(t, "With a body.", series.Body)
+		assert.Equal(t, "refs/heads/main", series.BaseRef)
+		assert.Equal(t, patch.SeriesStateOpen, series.State)
+	})
+
+	t.Run("import missing branch", func(t *testing.T) {
+		s := newPatchTestSource(map[string]api.CommitID{"refs/heads/main": "base"}, nil, nil)
+		cs := newPatchTestChangeset()
+		cs.ExternalID = "my-spec"
+
+		err := s.LoadChangeset(ctx, cs)
+		assert.ErrorAs(t, err, &ChangesetNotFoundError{})
+	})
+}
+
+func TestPatchSource_CloseAndReopenChangeset(t *testing.T) {
+	ctx := context.Background()
+	s := newPatchTestSource(nil, nil, nil)
+	cs := newPatchTestChangeset()
+	cs.Metadata = &patch.Series{Branch: "my-spec", State: patch.SeriesStateOpen}
+
+	require.NoError(t, s.CloseChangeset(ctx, cs))
+	assert.Equal(t, patch.SeriesStateClosed, cs.Metadata.(*patch.Series).State)
+
+	require.NoError(t, s.ReopenChangeset(ctx, cs))
+	assert.Equal(t, patch.SeriesStateOpen, cs.Metadata.(*patch.Series).State)
+
+	cs.Metadata = &patch.Series{Branch: "my-spec", State: patch.SeriesStateMerged}
+	require.NoError(t, s.ReopenChangeset(ctx, cs))
+	assert.Equal(t, patch.SeriesStateMerged, cs.Metadata.(*patch.Series).State)
+}
+
+func TestPatchSource_MergeChangeset(t *testing.T) {
+	s := newPatchTestSource(nil, nil, nil)
+	cs := newPatchTestChangeset()
+	cs.Metadata = &patch.Series{Branch: "my-spec", State: patch.SeriesStateOpen}
+
+	err := s.MergeChangeset(context.Background(), cs, false)
+	assert.ErrorAs(t, err, &ChangesetNotMergeableError{})
+}
+
+func TestPatchSource_WithAuthenticator(t *testing.T) {
+	s := newPatchTestSource(nil, nil, nil)
+
+	for name, tc := range map[string]struct {
+		au      auth.Authenticator
+		wantErr bool
+	}{
+		"BasicAuth":        {au: &auth.BasicAuth{}},
+		"BasicAuthWithSSH": {au: &auth.BasicAuthWithSSH{}},
+		"OAuthBearerToken": {au: &auth.OAuthBearerToken{}, wantErr: true},
+		"nil":              {au: nil, wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			src, err := s.WithAuthenticator(tc.au)
+			if tc.wantErr {
+				assert.ErrorAs(t, err, &UnsupportedAuthenticatorError{})
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, src.ValidateAuthenticator(context.Background()))
+		})
+	}
+}
+
+// newPatchTestSource returns a PatchSource backed by a mock gitserver client
+// that knows about the given refs. Commits in merged are reachable from every
+// other commit, commits in applied have all their changes applied to every
+// other commit.
+func newPatchTestSource(refs map[string]api.CommitID, merged, applied map[api.CommitID]bool) PatchSource {
+	client := gitserver.NewMockClient()
+	client.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if oid, ok := refs[spec]; ok {
+			return oid, nil
+		}
+		return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: spec}
+	})
+	client.MergeBaseFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, a, b api.CommitID) (api.CommitID, error) {
+		if merged[b] {
+			return b, nil
+		}
+		return "merge-base", nil
+	})
+	client.UnappliedCommitsFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, _, head api.CommitID) ([]api.CommitID, error) {
+		if applied[head] {
+			return nil, nil
+		}
+		return []api.CommitID{head}, nil
+	})
+	client.GetDefaultBranchFunc.SetDefaultReturn("refs/heads/main", "base", nil)
+	client.GetCommitFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, id api.CommitID, _ gitserver.ResolveRevisionOptions) (*gitdomain.Commit, error) {
+		return &gitdomain.Commit{
+			ID:      id,
+			Author:  gitdomain.Signature{Name: "Mary Mango", Email: "mary@example.com", Date: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)},
+			Message: "Imported change\n\nWith a body.",
+		}, nil
+	})
+
+	return PatchSource{gitserverClient: client}
+}
+
+func newPatchTestChangeset() *Changeset {
+	return &Changeset{
+		Title:   "Fix everything",
+		Body:    "This fixes everything.",
+		HeadRef: "refs/heads/my-spec",
+		BaseRef: "refs/heads/main",
+		TargetRepo: &types.Repo{
+			Name: "pagure.example.com/sourcegraph/automation-testing",
+			ExternalRepo: api.ExternalRepoSpec{
+				ServiceType: extsvc.TypePagure,
+				ServiceID:   "https://pagure.example.com/",
+			},
+		},
+		Changeset: &btypes.Changeset{ID: 42},
+	}
+}
@@ -421,7 +421,11 @@ func loadExternalService(ctx context.Context, s database.ExternalServiceStore, o
 			*schema.BitbucketCloudConnection,
 			*schema.AzureDevOpsConnection,
 			*schema.GerritConnection,
-			*schema.PerforceConnection:
+			*schema.GiteaConnection,
+			*schema.PerforceConnection,
+			*schema.PagureConnection,
+			*schema.GitoliteConnection,
+			*schema.PhabricatorConnection:
 			return e, nil
 		}
 	}
@@ -445,8 +449,12 @@ func buildChangesetSource(ctx context.Context, cf *httpcli.Factory, externalServ
 		return NewAzureDevOpsSource(ctx, externalService, cf)
 	case extsvc.KindGerrit:
 		return NewGerritSource(ctx, externalService, cf)
+	case extsvc.VariantGitea.AsKind():
+		return NewGiteaSource(ctx, externalService, cf)
 	case extsvc.KindPerforce:
 		return NewPerforceSource(ctx, externalService, cf)
+	case extsvc.KindPagure, extsvc.KindGitolite, extsvc.KindPhabricator:
+		return NewPatchSource(ctx, externalService, cf)
 	default:
 		return nil, errors.Errorf("unsupported external service type %q", extsvc.KindToType(externalService.Kind))
 	}
@@ -496,6 +504,9 @@ func setOAuthTokenAuth(u *vcs.URL, extSvcType, token string) error {
 	case extsvc.TypeBitbucketServer:
 		return errors.New("require username/token to push commits to BitbucketServer")
 
+	case extsvc.VariantGitea.AsType():
+		u.User = url.UserPassword("oauth2", token)
+
 	default:
 		panic(fmt.Sprintf("setOAuthTokenAuth: invalid external service type %q", extSvcType))
 	}
@@ -508,7 +519,8 @@ func setBasicAuth(u *vcs.URL, extSvcType, username, password string) error {
 	switch extSvcType {
 	case extsvc.TypeGitHub, extsvc.TypeGitLab:
 		return errors.New("need token to push commits to " + extSvcType)
-	case extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit:
+	case extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit, extsvc.VariantGitea.AsType(),
+		extsvc.TypePagure, extsvc.TypeGitolite, extsvc.TypePhabricator:
 		u.User = url.UserPassword(username, password)
 
 	default:
@@ -7,6 +7,7 @@ import (
 	"github.com/inconshreveable/log15"
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
@@ -86,6 +87,7 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitLabApproved,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestApproved,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+	btypes.ChangesetEventKindGiteaPullRequestApproved,
 
 	// Reviewed, not approved.
 	btypes.ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved,
@@ -95,6 +97,7 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitLabUnapproved,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestRejected,
+	btypes.ChangesetEventKindGiteaPullRequestChangesRequested,
 }
 
 type changesetStatesAtTime struct {
@@ -212,7 +215,9 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 			btypes.ChangesetEventKindGitLabApproved,
 			btypes.ChangesetEventKindBitbucketCloudApproved,
 			btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
-			btypes.ChangesetEventKindAzureDevOpsPullRequestApproved:
+			btypes.ChangesetEventKindAzureDevOpsPullRequestApproved,
+			btypes.ChangesetEventKindGiteaPullRequestApproved,
+			btypes.ChangesetEventKindGiteaPullRequestChangesRequested:
 			s, err := e.ReviewState()
 			if err != nil {
 				return nil, err
@@ -346,6 +351,10 @@ func initialExternalState(ch *btypes.Changeset, ce ChangesetEvents) btypes.Chang
 		if m.Change.WorkInProgress {
 			open = false
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		if m.IsDraft() {
+			open = false
+		}
 	default:
 		return btypes.ChangesetExternalStateOpen
 	}
@@ -10,9 +10,12 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
+	patchbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	adobatches "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 
 	"github.com/sourcegraph/go-diff/diff"
@@ -124,6 +127,11 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		return computeAzureDevOpsBuildState(m)
 	case *gerritbatches.AnnotatedChange:
 		return computeGerritBuildState(m)
+	case *giteabatches.AnnotatedPullRequest:
+		return computeGiteaBuildState(m)
+	case *patchbatches.Series:
+		// Patch series aren't built by the code host.
+		return btypes.ChangesetCheckStateUnknown
 	case *protocol.PerforceChangelistState:
 		// Perforce doesn't have builds built-in, its better to be explicit by still
 		// including this case for clarity.
@@ -332,6 +340,38 @@ func computeGerritBuildState(ac *gerritbatches.AnnotatedChange) btypes.Changeset
 	return combineCheckStates(states)
 }
 
+func computeGiteaBuildState(apr *giteabatches.AnnotatedPullRequest) btypes.ChangesetCheckState {
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+
+	// States from last sync. Gitea returns the most recent status first, so
+	// only the first status of each context is considered.
+	for _, status := range apr.Statuses {
+		if _, ok := stateMap[status.Context]; ok {
+			continue
+		}
+		stateMap[status.Context] = parseGiteaBuildState(status.State)
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+	return combineCheckStates(states)
+}
+
+func parseGiteaBuildState(s gitea.CommitStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case gitea.CommitStatusError, gitea.CommitStatusFailure:
+		return btypes.ChangesetCheckStateFailed
+	case gitea.CommitStatusPending:
+		return btypes.ChangesetCheckStatePending
+	case gitea.CommitStatusSuccess, gitea.CommitStatusWarning:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
 func parseGerritBuildState(s string) btypes.ChangesetCheckState {
 	switch s {
 	case "-2", "-1":
@@ -639,6 +679,32 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Gerrit Change state: %s", m.Change.Status)
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		switch {
+		case m.HasMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case m.State == gitea.PullRequestStateClosed:
+			s = btypes.ChangesetExternalStateClosed
+		case m.State == gitea.PullRequestStateOpen:
+			if m.IsDraft() {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gitea pull request state: %s", m.State)
+		}
+	case *patchbatches.Series:
+		switch m.State {
+		case patchbatches.SeriesStateClosed:
+			s = btypes.ChangesetExternalStateClosed
+		case patchbatches.SeriesStateMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case patchbatches.SeriesStateOpen:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown patch series state: %s", m.State)
+		}
 	case *protocol.PerforceChangelist:
 		switch m.State {
 		case protocol.PerforceChangelistStateClosed:
@@ -758,6 +824,29 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		// Only the most recent review of each reviewer counts, and Gitea
+		// returns reviews in chronological order.
+		latest := make(map[int64]gitea.ReviewState)
+		for _, review := range m.Reviews {
+			if review.Reviewer == nil || review.Dismissed {
+				continue
+			}
+			latest[review.Reviewer.ID] = review.State
+		}
+		for _, state := range latest {
+			switch state {
+			case gitea.ReviewStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case gitea.ReviewStateRequestChanges:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
+	case *patchbatches.Series:
+		// Patch series are reviewed outside of the code host.
+		states[btypes.ChangesetReviewStatePending] = true
 	case *protocol.PerforceChangelist:
 		states[btypes.ChangesetReviewStatePending] = true
 	default:
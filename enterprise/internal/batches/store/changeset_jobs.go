@@ -167,6 +167,134 @@ func getChangesetJobQuery(opts *GetChangesetJobOpts) *sqlf.Query {
 	)
 }
 
+// ListChangesetJobsOpts captures the query options needed for listing
+// ChangesetJobs.
+type ListChangesetJobsOpts struct {
+	BulkGroup string
+}
+
+// ListChangesetJobs lists the ChangesetJobs matching the given options.
+func (s *Store) ListChangesetJobs(ctx context.Context, opts ListChangesetJobsOpts) (jobs []*btypes.ChangesetJob, err error) {
+	ctx, _, endObservation := s.operations.listChangesetJobs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("bulkGroup", opts.BulkGroup),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := listChangesetJobsQuery(&opts)
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var j btypes.ChangesetJob
+		if err := scanChangesetJob(&j, sc); err != nil {
+			return err
+		}
+		jobs = append(jobs, &j)
+		return nil
+	})
+	return jobs, err
+}
+
+var listChangesetJobsQueryFmtstr = `
+SELECT %s FROM changeset_jobs
+INNER JOIN changesets ON changesets.id = changeset_jobs.changeset_id
+INNER JOIN repo ON repo.id = changesets.repo_id
+WHERE %s
+ORDER BY changeset_jobs.id ASC
+`
+
+func listChangesetJobsQuery(opts *ListChangesetJobsOpts) *sqlf.Query {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("repo.deleted_at IS NULL"),
+	}
+
+	if opts.BulkGroup != "" {
+		preds = append(preds, sqlf.Sprintf("changeset_jobs.bulk_group = %s", opts.BulkGroup))
+	}
+
+	return sqlf.Sprintf(
+		listChangesetJobsQueryFmtstr,
+		sqlf.Join(changesetJobColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// PauseMergeRollout marks the queued merge jobs of the merge rollout with the
+// given bulk group, and the job with the given ID that paused it, as paused.
+// The failing changesets of previous waves are accepted once the rollout is
+// resumed.
+func (s *Store) PauseMergeRollout(ctx context.Context, bulkGroup string, jobID int64, failedChecks int) (err error) {
+	ctx, _, endObservation := s.operations.pauseMergeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("bulkGroup", bulkGroup),
+		attribute.Int("jobID", int(jobID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		pauseMergeRolloutQueryFmtstr,
+		failedChecks,
+		s.now(),
+		bulkGroup,
+		btypes.ChangesetJobTypeMerge,
+		btypes.ChangesetJobStateQueued.ToDB(),
+		jobID,
+	))
+}
+
+var pauseMergeRolloutQueryFmtstr = `
+UPDATE changeset_jobs
+SET
+	payload = jsonb_set(
+		jsonb_set(payload, '{rollout,paused}', 'true'),
+		'{rollout,acceptedFailedChecks}',
+		to_jsonb(%s::integer)
+	),
+	updated_at = %s
+WHERE
+	bulk_group = %s
+	AND job_type = %s
+	AND payload ? 'rollout'
+	AND (state = %s OR id = %s)
+`
+
+// ResumeMergeRollout resumes the paused merge rollout with the given bulk group.
+// ErrNoResults is returned if the rollout has no paused jobs.
+func (s *Store) ResumeMergeRollout(ctx context.Context, bulkGroup string) (err error) {
+	ctx, _, endObservation := s.operations.resumeMergeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("bulkGroup", bulkGroup),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(
+		resumeMergeRolloutQueryFmtstr,
+		s.now(),
+		bulkGroup,
+		btypes.ChangesetJobTypeMerge,
+		btypes.ChangesetJobStateQueued.ToDB(),
+	))
+	if err != nil {
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+var resumeMergeRolloutQueryFmtstr = `
+UPDATE changeset_jobs
+SET
+	payload = payload #- '{rollout,paused}',
+	-- Paused jobs are requeued with a delay, they can run right away now.
+	process_after = NULL,
+	updated_at = %s
+WHERE
+	bulk_group = %s
+	AND job_type = %s
+	AND state = %s
+	AND payload->'rollout'->>'paused' = 'true'
+`
+
 func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 	var raw json.RawMessage
 	if err := s.Scan(
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -37,6 +38,7 @@ func testStoreChangesetJobs(t *testing.T, ctx context.Context, s *Store, clock b
 	jobs := make([]*btypes.ChangesetJob, 0, 3)
 	for i := 0; i < cap(jobs); i++ {
 		c := &btypes.ChangesetJob{
+			BulkGroup:     fmt.Sprintf("group-%d", i%2),
 			UserID:        int32(i + 1234),
 			BatchChangeID: int64(i + 910),
 			ChangesetID:   changeset.ID,
@@ -110,4 +112,20 @@ func testStoreChangesetJobs(t *testing.T, ctx context.Context, s *Store, clock b
 			}
 		})
 	})
+	t.Run("List", func(t *testing.T) {
+		for bulkGroup, want := range map[string][]*btypes.ChangesetJob{
+			// The job of the changeset with the deleted repo is not returned.
+			"group-0": {jobs[0]},
+			"group-1": {jobs[1]},
+			"unknown": nil,
+		} {
+			have, err := s.ListChangesetJobs(ctx, ListChangesetJobsOpts{BulkGroup: bulkGroup})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatalf("unexpected jobs for bulk group %q (-want +got):\n%s", bulkGroup, diff)
+			}
+		}
+	})
 }
@@ -14,8 +14,11 @@ import (
 
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
+	patchbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 
 	"github.com/keegancsmith/sqlf"
@@ -78,6 +81,7 @@ var changesetStringColumns = SQLColumns{
 	"syncer_error",
 	"detached_at",
 	"previous_failure_message",
+	"rebased_base_rev",
 }
 
 // ChangesetColumns are used by the changeset related Store methods and by
@@ -123,6 +127,7 @@ var ChangesetColumns = []*sqlf.Query{
 	sqlf.Sprintf("changesets.syncer_error"),
 	sqlf.Sprintf("changesets.detached_at"),
 	sqlf.Sprintf("changesets.previous_failure_message"),
+	sqlf.Sprintf("changesets.rebased_base_rev"),
 }
 
 // changesetInsertColumns is the list of changeset columns that are modified in
@@ -167,6 +172,7 @@ var changesetInsertColumns = []*sqlf.Query{
 	// indexable for searching.
 	sqlf.Sprintf("external_title"),
 	sqlf.Sprintf("previous_failure_message"),
+	sqlf.Sprintf("rebased_base_rev"),
 }
 
 // changesetCodeHostStateInsertColumns are the columns that Store.UpdateChangesetCodeHostState uses to update a changeset
@@ -230,6 +236,7 @@ var changesetInsertStringColumns = []string{
 	"syncer_error",
 	"external_title",
 	"previous_failure_message",
+	"rebased_base_rev",
 }
 
 // temporaryChangesetInsertColumns is the list of column names used by Store.UpdateChangesetsForApply to insert into
@@ -337,6 +344,7 @@ func (s *Store) CreateChangeset(ctx context.Context, cs ...*btypes.Changeset) (e
 				c.SyncErrorMessage,
 				dbutil.NullStringColumn(title),
 				c.PreviousFailureMessage,
+				dbutil.NullStringColumn(c.RebasedBaseRev),
 			); err != nil {
 				return err
 			}
@@ -930,6 +938,7 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 		c.SyncErrorMessage,
 		dbutil.NullStringColumn(title),
 		c.PreviousFailureMessage,
+		dbutil.NullStringColumn(c.RebasedBaseRev),
 	}
 
 	if includeID {
@@ -943,7 +952,7 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 
 var updateChangesetQueryFmtstr = `
 UPDATE changesets
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING
   %s
@@ -1486,6 +1495,7 @@ func ScanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &syncErrorMessage},
 		&dbutil.NullTime{Time: &t.DetachedAt},
 		&dbutil.NullString{S: &previousFailureMessage},
+		&dbutil.NullString{S: &t.RebasedBaseRev},
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset")
@@ -1526,8 +1536,15 @@ func ScanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		m := new(gerritbatches.AnnotatedChange)
 		m.Change = &gerrit.Change{}
 		t.Metadata = m
+	case extsvc.VariantGitea.AsType():
+		m := new(giteabatches.AnnotatedPullRequest)
+		// Ensure the inner PR is initialized, it should never be nil.
+		m.PullRequest = &gitea.PullRequest{}
+		t.Metadata = m
 	case extsvc.TypePerforce:
 		t.Metadata = new(protocol.PerforceChangelist)
+	case extsvc.TypePagure, extsvc.TypeGitolite, extsvc.TypePhabricator:
+		t.Metadata = new(patchbatches.Series)
 	case extsvc.TypeGerrit:
 		t.Metadata = new(gerrit.Change)
 	default:
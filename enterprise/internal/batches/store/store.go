@@ -220,6 +220,9 @@ type operations struct {
 
 	createChangesetJob *observation.Operation
 	getChangesetJob    *observation.Operation
+	listChangesetJobs  *observation.Operation
+	pauseMergeRollout  *observation.Operation
+	resumeMergeRollout *observation.Operation
 
 	createChangesetSpec                      *observation.Operation
 	updateChangesetSpecBatchSpecID           *observation.Operation
@@ -365,6 +368,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 			createChangesetJob: op("CreateChangesetJob"),
 			getChangesetJob:    op("GetChangesetJob"),
+			listChangesetJobs:  op("ListChangesetJobs"),
+			pauseMergeRollout:  op("PauseMergeRollout"),
+			resumeMergeRollout: op("ResumeMergeRollout"),
 
 			createChangesetSpec:                      op("CreateChangesetSpec"),
 			updateChangesetSpecBatchSpecID:           op("UpdateChangesetSpecBatchSpecID"),
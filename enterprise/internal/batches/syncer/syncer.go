@@ -523,6 +523,7 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 	if err != nil {
 		return err
 	}
+	previousBaseRefOid := c.SyncState.BaseRefOid
 	state.SetDerivedState(ctx, syncStore.Repos(), client, c, events)
 
 	tx, err := syncStore.Transact(ctx)
@@ -539,5 +540,31 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		return err
 	}
 
+	if baseRefMoved(previousBaseRefOid, c) {
+		// Let the reconciler rebase the changeset onto the new base.
+		if err := tx.EnqueueChangeset(ctx, c, btypes.ReconcilerStateQueued, btypes.ReconcilerStateCompleted); err != nil {
+			return errors.Wrap(err, "enqueueing changeset for rebase")
+		}
+	}
+
 	return tx.UpsertChangesetEvents(ctx, events...)
 }
+
+// baseRefMoved returns true if rebasing changesets is enabled and the base
+// branch of the given open changeset, owned by a batch change, moved since the
+// last sync.
+func baseRefMoved(previousBaseRefOid string, c *btypes.Changeset) bool {
+	if !conf.Get().BatchChangesRebaseOnBaseChange {
+		return false
+	}
+
+	if c.OwnedByBatchChangeID == 0 || c.CurrentSpecID == 0 || c.ReconcilerState != btypes.ReconcilerStateCompleted {
+		return false
+	}
+
+	if c.ExternalState != btypes.ChangesetExternalStateOpen && c.ExternalState != btypes.ChangesetExternalStateDraft {
+		return false
+	}
+
+	return previousBaseRefOid != "" && c.SyncState.BaseRefOid != "" && previousBaseRefOid != c.SyncState.BaseRefOid
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestMain(m *testing.M) {
@@ -389,3 +391,36 @@ func TestSyncRegistry_EnqueueChangesetSyncsForRepos(t *testing.T) {
 		assert.ElementsMatch(t, []int64{1, 2}, <-s.priorityNotify)
 	})
 }
+
+func TestBaseRefMoved(t *testing.T) {
+	newChangeset := func(base string, state btypes.ChangesetExternalState) *btypes.Changeset {
+		return &btypes.Changeset{
+			OwnedByBatchChangeID: 1,
+			CurrentSpecID:        2,
+			ReconcilerState:      btypes.ReconcilerStateCompleted,
+			ExternalState:        state,
+			SyncState:            btypes.ChangesetSyncState{BaseRefOid: base},
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.False(t, baseRefMoved("old", newChangeset("new", btypes.ChangesetExternalStateOpen)))
+	})
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{BatchChangesRebaseOnBaseChange: true}})
+	defer conf.Mock(nil)
+
+	assert.True(t, baseRefMoved("old", newChangeset("new", btypes.ChangesetExternalStateOpen)))
+	assert.True(t, baseRefMoved("old", newChangeset("new", btypes.ChangesetExternalStateDraft)))
+	assert.False(t, baseRefMoved("old", newChangeset("old", btypes.ChangesetExternalStateOpen)))
+	assert.False(t, baseRefMoved("", newChangeset("new", btypes.ChangesetExternalStateOpen)))
+	assert.False(t, baseRefMoved("old", newChangeset("new", btypes.ChangesetExternalStateMerged)))
+
+	imported := newChangeset("new", btypes.ChangesetExternalStateOpen)
+	imported.OwnedByBatchChangeID = 0
+	assert.False(t, baseRefMoved("old", imported))
+
+	processing := newChangeset("new", btypes.ChangesetExternalStateOpen)
+	processing.ReconcilerState = btypes.ReconcilerStateProcessing
+	assert.False(t, baseRefMoved("old", processing))
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gitea"
+	patchbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/patch"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
@@ -23,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -283,6 +286,12 @@ type Changeset struct {
 	DiffStatDeleted *int32
 	SyncState       ChangesetSyncState
 
+	// RebasedBaseRev is the head of the base branch the changeset was last
+	// rebased onto, or checked against without finding changes that conflict
+	// with its diff. It is empty until the base branch moves for the first time
+	// after the current changeset spec was pushed.
+	RebasedBaseRev string
+
 	// The batch change that "owns" this changeset: it can create/close
 	// it on code host. If this is 0, it is imported/tracked by a batch change.
 	OwnedByBatchChangeID int64
@@ -470,6 +479,26 @@ func (c *Changeset) SetMetadata(meta any) error {
 		c.ExternalServiceType = extsvc.TypeGerrit
 		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Change.Branch)
 		c.ExternalUpdatedAt = pr.Change.Updated
+	case *giteabatches.AnnotatedPullRequest:
+		c.Metadata = pr
+		c.ExternalID = strconv.FormatInt(pr.Index, 10)
+		c.ExternalServiceType = extsvc.VariantGitea.AsType()
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Head.Ref)
+		c.ExternalUpdatedAt = pr.Updated
+
+		if pr.Head.Repo != nil && pr.Base.Repo != nil && pr.Head.Repo.ID != pr.Base.Repo.ID {
+			c.ExternalForkNamespace = pr.Head.Repo.Owner.Login
+			c.ExternalForkName = pr.Head.Repo.Name
+		} else {
+			c.ExternalForkNamespace = ""
+			c.ExternalForkName = ""
+		}
+	case *patchbatches.Series:
+		c.Metadata = pr
+		c.ExternalID = pr.Branch
+		c.ExternalServiceType = pr.ServiceType
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Branch)
+		c.ExternalUpdatedAt = pr.UpdatedAt
 	case *protocol.PerforceChangelist:
 		c.Metadata = pr
 		c.ExternalID = pr.ID
@@ -510,6 +539,10 @@ func (c *Changeset) Title() (string, error) {
 		// Remove extra quotes added by the commit message
 		title = strings.TrimPrefix(strings.TrimSuffix(title, "\""), "\"")
 		return title, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return gitea.UndraftTitle(m.Title), nil
+	case *patchbatches.Series:
+		return m.Title, nil
 	case *protocol.PerforceChangelist:
 		return m.Title, nil
 	default:
@@ -537,6 +570,11 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.CreatedBy.UniqueName, nil
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Owner.Name, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.User.Login, nil
+	case *patchbatches.Series:
+		// Patch series don't record who published them.
+		return "", nil
 	case *protocol.PerforceChangelist:
 		return m.Author, nil
 	default:
@@ -572,6 +610,10 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		return m.CreatedBy.UniqueName, nil
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Owner.Email, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.User.Email, nil
+	case *patchbatches.Series:
+		return "", nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -596,6 +638,10 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreationDate
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Created
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Created
+	case *patchbatches.Series:
+		return m.CreatedAt
 	case *protocol.PerforceChangelist:
 		return m.CreationDate
 	default:
@@ -619,6 +665,10 @@ func (c *Changeset) Body() (string, error) {
 	case *gerritbatches.AnnotatedChange:
 		// Gerrit doesn't really differentiate between title/description.
 		return m.Change.Subject, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Body, nil
+	case *patchbatches.Series:
+		return m.Body, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -687,6 +737,10 @@ func (c *Changeset) URL() (s string, err error) {
 		return returnURL.String(), nil
 	case *gerritbatches.AnnotatedChange:
 		return m.CodeHostURL.JoinPath("c", url.PathEscape(m.Change.Project), "+", url.PathEscape(strconv.Itoa(m.Change.ChangeNumber))).String(), nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.HTMLURL, nil
+	case *patchbatches.Series:
+		return m.URL, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -907,6 +961,41 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 				Metadata:    reviewer,
 			})
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		// There are two types of event that we create from an annotated pull
+		// request: review events, based on the reviews of the pull request,
+		// and check events, based on the commit statuses of the head commit.
+		var kind ChangesetEventKind
+
+		for _, review := range m.Reviews {
+			if kind, err = ChangesetEventKindFor(review); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         strconv.FormatInt(review.ID, 10),
+				Kind:        kind,
+				Metadata:    review,
+			})
+		}
+
+		for _, status := range m.Statuses {
+			if kind, err = ChangesetEventKindFor(status); err != nil {
+				return
+			}
+			// Gitea returns the most recent status first, so deduplicating
+			// on the context keeps the current status of each check.
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         status.Context,
+				Kind:        kind,
+				Metadata:    status,
+			})
+		}
+	case *patchbatches.Series:
+		// Patch series are discussed outside of the code host, so there are
+		// no events.
+		break
 	case *protocol.PerforceChangelist:
 		// We don't have any events we care about right now
 		break
@@ -932,6 +1021,10 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return "", nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Head.Sha, nil
+	case *patchbatches.Series:
+		return m.HeadRefOid, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -955,6 +1048,10 @@ func (c *Changeset) HeadRef() (string, error) {
 		return m.SourceRefName, nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return "refs/heads/" + m.Head.Ref, nil
+	case *patchbatches.Series:
+		return "refs/heads/" + m.Branch, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -979,6 +1076,10 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return "", nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Base.Sha, nil
+	case *patchbatches.Series:
+		return m.BaseRefOid, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
 	default:
@@ -1002,6 +1103,10 @@ func (c *Changeset) BaseRef() (string, error) {
 		return m.TargetRefName, nil
 	case *gerritbatches.AnnotatedChange:
 		return "refs/heads/" + m.Change.Branch, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return "refs/heads/" + m.Base.Ref, nil
+	case *patchbatches.Series:
+		return m.BaseRef, nil
 	case *protocol.PerforceChangelist:
 		// TODO: @peterguy we may need to change this to something.
 		return "", nil
@@ -1121,6 +1226,16 @@ func (c *Changeset) Labels() []ChangesetLabel {
 			labels[i] = ChangesetLabel{Name: l, Color: "000000"}
 		}
 		return labels
+	case *giteabatches.AnnotatedPullRequest:
+		labels := make([]ChangesetLabel, len(m.Labels))
+		for i, l := range m.Labels {
+			labels[i] = ChangesetLabel{
+				Name:        l.Name,
+				Color:       l.Color,
+				Description: l.Description,
+			}
+		}
+		return labels
 	default:
 		return []ChangesetLabel{}
 	}
@@ -1399,6 +1514,17 @@ func ChangesetEventKindFor(e any) (ChangesetEventKind, error) {
 				}
 			}
 		}
+	case *gitea.PullReview:
+		switch e.State {
+		case gitea.ReviewStateApproved:
+			return ChangesetEventKindGiteaPullRequestApproved, nil
+		case gitea.ReviewStateRequestChanges:
+			return ChangesetEventKindGiteaPullRequestChangesRequested, nil
+		default:
+			return ChangesetEventKindGiteaPullRequestReviewed, nil
+		}
+	case *gitea.CommitStatus:
+		return ChangesetEventKindGiteaCommitStatus, nil
 	}
 
 	return ChangesetEventKindInvalid, errors.Errorf("changeset eventkindfor unknown changeset event kind for %T", e)
@@ -1548,6 +1674,15 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 			ChangesetEventKindGerritChangeBuildSucceeded:
 			return new(gerrit.Reviewer), nil
 		}
+	case strings.HasPrefix(string(k), "gitea"):
+		switch k {
+		case ChangesetEventKindGiteaPullRequestApproved,
+			ChangesetEventKindGiteaPullRequestChangesRequested,
+			ChangesetEventKindGiteaPullRequestReviewed:
+			return new(gitea.PullReview), nil
+		case ChangesetEventKindGiteaCommitStatus:
+			return new(gitea.CommitStatus), nil
+		}
 	}
 	return nil, errors.Errorf("changeset event metadata unknown changeset event kind %q", k)
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
@@ -125,6 +126,11 @@ const (
 	ChangesetEventKindGerritChangeBuildFailed             ChangesetEventKind = "gerrit:change:build_failed"
 	ChangesetEventKindGerritChangeBuildPending            ChangesetEventKind = "gerrit:change:build_pending"
 
+	ChangesetEventKindGiteaPullRequestApproved         ChangesetEventKind = "gitea:pullrequest:approved"
+	ChangesetEventKindGiteaPullRequestChangesRequested ChangesetEventKind = "gitea:pullrequest:changes_requested"
+	ChangesetEventKindGiteaPullRequestReviewed         ChangesetEventKind = "gitea:pullrequest:reviewed"
+	ChangesetEventKindGiteaCommitStatus                ChangesetEventKind = "gitea:commit_status"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -202,6 +208,12 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 		return meta.PullRequest.Reviewers[len(meta.PullRequest.Reviewers)-1].UniqueName
 	case *azuredevops.PullRequestUpdatedEvent:
 		return meta.PullRequest.CreatedBy.UniqueName
+
+	case *gitea.PullReview:
+		if meta.Reviewer == nil {
+			return ""
+		}
+		return meta.Reviewer.Login
 	default:
 		return ""
 	}
@@ -214,7 +226,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindGitLabApproved,
 		ChangesetEventKindBitbucketCloudApproved,
 		ChangesetEventKindBitbucketCloudPullRequestApproved,
-		ChangesetEventKindAzureDevOpsPullRequestApproved:
+		ChangesetEventKindAzureDevOpsPullRequestApproved,
+		ChangesetEventKindGiteaPullRequestApproved:
 		return ChangesetReviewStateApproved, nil
 
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
@@ -223,7 +236,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindBitbucketCloudChangesRequested,
 		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated,
 		ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor,
-		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions:
+		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+		ChangesetEventKindGiteaPullRequestChangesRequested:
 		return ChangesetReviewStateChangesRequested, nil
 
 	case ChangesetEventKindGitHubReviewed:
@@ -370,6 +384,10 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CreatedDate
 	case *azuredevops.PullRequestMergedEvent:
 		t = ev.CreatedDate
+	case *gitea.PullReview:
+		t = ev.Submitted
+	case *gitea.CommitStatus:
+		t = ev.Updated
 	}
 
 	return t
@@ -913,6 +931,13 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 	case *azuredevops.PullRequestRejectedEvent:
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
+
+	case *gitea.PullReview:
+		o := o.Metadata.(*gitea.PullReview)
+		*e = *o
+	case *gitea.CommitStatus:
+		o := o.Metadata.(*gitea.CommitStatus)
+		*e = *o
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
@@ -60,6 +60,26 @@ type ChangesetJobReenqueuePayload struct{}
 
 type ChangesetJobMergePayload struct {
 	Squash bool `json:"squash,omitempty"`
+	// Rollout is set if the changeset is merged as part of a merge rollout.
+	Rollout *ChangesetJobMergeRollout `json:"rollout,omitempty"`
+}
+
+// ChangesetJobMergeRollout describes the place of a merge job in a merge
+// rollout, in which changesets are merged in consecutive waves.
+type ChangesetJobMergeRollout struct {
+	// Wave is the zero-based index of the wave the changeset is merged in.
+	Wave int `json:"wave"`
+	// MaxFailedChecks is the number of changesets of previous waves that may
+	// have failing checks before the rollout is paused.
+	MaxFailedChecks int `json:"maxFailedChecks"`
+	// Paused is set if the rollout was paused because too many changesets of
+	// previous waves have failing checks. Paused jobs are requeued until the
+	// rollout is resumed.
+	Paused bool `json:"paused,omitempty"`
+	// AcceptedFailedChecks is the number of failing changesets of previous waves
+	// when the rollout was paused. They don't count towards MaxFailedChecks
+	// once the rollout is resumed.
+	AcceptedFailedChecks int `json:"acceptedFailedChecks,omitempty"`
 }
 
 type ChangesetJobClosePayload struct{}
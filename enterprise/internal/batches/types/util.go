@@ -29,6 +29,14 @@ func GetSupportedExternalServices() map[string]CodehostCapabilities {
 		extsvc.TypeBitbucketCloud:  {},
 		extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true},
 		extsvc.TypeGerrit:          {CodehostCapabilityDraftChangesets: true},
+
+		extsvc.VariantGitea.AsType(): {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
+
+		// Code hosts without a pull request API, on which changesets are
+		// published as patch series.
+		extsvc.TypePagure:      {},
+		extsvc.TypeGitolite:    {},
+		extsvc.TypePhabricator: {},
 	}
 	if c := conf.Get(); c.ExperimentalFeatures != nil && c.ExperimentalFeatures.BatchChangesEnablePerforce {
 		supportedExternalServices[extsvc.TypePerforce] = CodehostCapabilities{}
@@ -58,6 +58,7 @@ var indexColumnsWithNullRank = []*sqlf.Query{
 	sqlf.Sprintf(`(SELECT MAX(id) FROM lsif_uploads WHERE associated_index_id = u.id) AS associated_upload_id`),
 	sqlf.Sprintf(`u.should_reindex`),
 	sqlf.Sprintf(`u.requested_envvars`),
+	sqlf.Sprintf(`u.indexer_resources`),
 }
 
 func scanIndex(s dbutil.Scanner) (index uploadsshared.Index, err error) {
@@ -86,6 +87,7 @@ func scanIndex(s dbutil.Scanner) (index uploadsshared.Index, err error) {
 		&index.AssociatedUploadID,
 		&index.ShouldReindex,
 		pq.Array(&index.RequestedEnvVars),
+		dbutil.JSONMessage(&index.IndexerResources),
 	); err != nil {
 		return index, err
 	}
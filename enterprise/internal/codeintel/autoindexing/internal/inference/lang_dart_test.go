@@ -0,0 +1,46 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestDartGenerator(t *testing.T) {
+	expectedIndexerImage, _ := libs.DefaultIndexerForLang("dart")
+
+	job := func(root string) config.IndexJob {
+		return config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    expectedIndexerImage,
+					Commands: []string{"dart pub get"},
+				},
+			},
+			LocalSteps:       nil,
+			Root:             root,
+			Indexer:          expectedIndexerImage,
+			IndexerArgs:      []string{"dart", "pub", "global", "run", "scip_dart", "./"},
+			Outfile:          "index.scip",
+			RequestedEnvVars: []string{"PUB_HOSTED_URL"},
+		}
+	}
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "pub packages",
+			repositoryContents: map[string]string{
+				"pubspec.yaml":                             "",
+				"packages/widgets/pubspec.yaml":            "",
+				"example/pubspec.yaml":                     "",
+				"packages/widgets/.dart_tool/pubspec.yaml": "",
+			},
+			expected: []config.IndexJob{
+				job(""),
+				job("packages/widgets"),
+			},
+		},
+	)
+}
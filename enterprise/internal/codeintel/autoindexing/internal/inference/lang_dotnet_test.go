@@ -0,0 +1,75 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestDotNetGenerator(t *testing.T) {
+	expectedIndexerImage, _ := libs.DefaultIndexerForLang("dotnet")
+
+	job := func(root string) config.IndexJob {
+		return config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    expectedIndexerImage,
+					Commands: []string{"dotnet restore"},
+				},
+			},
+			LocalSteps:       nil,
+			Root:             root,
+			Indexer:          expectedIndexerImage,
+			IndexerArgs:      []string{"scip-dotnet", "index"},
+			Outfile:          "index.scip",
+			RequestedEnvVars: []string{"NUGET_AUTH_TOKEN"},
+		}
+	}
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "solution with projects",
+			repositoryContents: map[string]string{
+				"App.sln":                 "",
+				"src/App/App.csproj":      "",
+				"src/Lib/Lib.csproj":      "",
+				"test/App.Tests.csproj":   "",
+				"src/App/bin/Gen.csproj":  "",
+				"src/App/obj/Temp.csproj": "",
+			},
+			expected: []config.IndexJob{
+				job(""),
+			},
+		},
+		generatorTestCase{
+			description: "projects without solution",
+			repositoryContents: map[string]string{
+				"a/A.csproj":   "",
+				"b/B.csproj":   "",
+				"b/c/C.csproj": "",
+			},
+			expected: []config.IndexJob{
+				job("a"),
+				job("b"),
+				job("b/c"),
+			},
+		},
+		generatorTestCase{
+			description: "nested solutions",
+			repositoryContents: map[string]string{
+				"backend/Backend.sln":         "",
+				"backend/Api/Api.csproj":      "",
+				"tools/Tool.csproj":           "",
+				"tools/plugins/Plugin.csproj": "",
+				"tools/plugins/Plugins.sln":   "",
+			},
+			expected: []config.IndexJob{
+				job("backend"),
+				job("tools"),
+				job("tools/plugins"),
+			},
+		},
+	)
+}
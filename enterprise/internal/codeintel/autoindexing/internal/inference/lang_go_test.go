@@ -58,6 +58,31 @@ func TestGoGenerator(t *testing.T) {
 				},
 			},
 		},
+		generatorTestCase{
+			description: "go modules in excluded directories",
+			repositoryContents: map[string]string{
+				"go.mod":                        "",
+				"vendor/example.com/x/go.mod":   "",
+				"internal/testdata/demo/go.mod": "",
+			},
+			expected: []config.IndexJob{
+				{
+					Steps: []config.DockerStep{
+						{
+							Root:     "",
+							Image:    expectedIndexerImage,
+							Commands: []string{netrcString, "go mod download"},
+						},
+					},
+					LocalSteps:       []string{netrcString},
+					Root:             "",
+					Indexer:          expectedIndexerImage,
+					IndexerArgs:      []string{"scip-go", "--no-animation"},
+					Outfile:          "index.scip",
+					RequestedEnvVars: []string{"GOPRIVATE", "GOPROXY", "GONOPROXY", "GOSUMDB", "GONOSUMDB", "NETRC_DATA"},
+				},
+			},
+		},
 		generatorTestCase{
 			description: "go files in root",
 			repositoryContents: map[string]string{
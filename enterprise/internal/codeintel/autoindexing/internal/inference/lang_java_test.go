@@ -51,6 +51,15 @@ func TestJavaGenerator(t *testing.T) {
 			},
 			expected: singleTopLevelJob,
 		},
+		generatorTestCase{
+			description: "Kotlin project with Gradle Kotlin DSL",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts":  "",
+				"app/build.gradle.kts": "",
+				"app/src/main/kotlin/com/sourcegraph/codeintel/fun.kt": "",
+			},
+			expected: singleTopLevelJob,
+		},
 		generatorTestCase{
 			description: "JVM project with Maven",
 			repositoryContents: map[string]string{
@@ -0,0 +1,46 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPHPGenerator(t *testing.T) {
+	expectedIndexerImage, _ := libs.DefaultIndexerForLang("php")
+
+	job := func(root string) config.IndexJob {
+		return config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    expectedIndexerImage,
+					Commands: []string{"composer install --no-interaction --no-scripts --ignore-platform-reqs"},
+				},
+			},
+			LocalSteps:       nil,
+			Root:             root,
+			Indexer:          expectedIndexerImage,
+			IndexerArgs:      []string{"scip-php"},
+			Outfile:          "index.scip",
+			RequestedEnvVars: []string{"COMPOSER_AUTH"},
+		}
+	}
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "composer packages",
+			repositoryContents: map[string]string{
+				"composer.json":                    "",
+				"packages/http/composer.json":      "",
+				"vendor/psr/log/composer.json":     "",
+				"tests/fixtures/app/composer.json": "",
+			},
+			expected: []config.IndexJob{
+				job(""),
+				job("packages/http"),
+			},
+		},
+	)
+}
@@ -23,6 +23,9 @@ var defaultIndexers = map[string]string{
 	"rust":       "sourcegraph/scip-rust",
 	"typescript": "sourcegraph/scip-typescript",
 	"ruby":       "sourcegraph/scip-ruby",
+	"dotnet":     "sourcegraph/scip-dotnet",
+	"php":        "davidrjenni/scip-php",
+	"dart":       "workiva/scip-dart",
 }
 
 // To update, run `DOCKER_USER=... DOCKER_PASS=... ./update-shas.sh`
@@ -37,6 +40,14 @@ var defaultIndexerSHAs = map[string]string{
 	"sourcegraph/scip-ruby":       "sha256:f18eb10da9cc1998a7d5b123deefae0f69016614cbf323ec5edcd09a529d466e",
 }
 
+// Indexers without a pinned SHA are referenced by tag. Running update-shas.sh pins
+// each of these images in defaultIndexerSHAs and removes it from this map.
+var defaultIndexerTags = map[string]string{
+	"sourcegraph/scip-dotnet": "latest",
+	"davidrjenni/scip-php":    "latest",
+	"workiva/scip-dart":       "latest",
+}
+
 func DefaultIndexerForLang(language string) (string, bool) {
 	indexer, ok := defaultIndexers[language]
 	if !ok {
@@ -45,6 +56,10 @@ func DefaultIndexerForLang(language string) (string, bool) {
 
 	sha, ok := defaultIndexerSHAs[indexer]
 	if !ok {
+		if tag, ok := defaultIndexerTags[indexer]; ok {
+			return fmt.Sprintf("%s:%s", indexer, tag), true
+		}
+
 		panic(fmt.Sprintf("no SHA set for indexer %q", indexer))
 	}
 
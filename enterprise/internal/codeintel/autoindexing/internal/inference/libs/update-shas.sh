@@ -5,17 +5,33 @@ set -u
 DOCKER_USER=${DOCKER_USER:?"No DOCKER_USER is set."}
 DOCKER_PASS=${DOCKER_PASS:?"No DOCKER_PASS is set."}
 
-for indexer in lsif-clang scip-go lsif-rust scip-rust scip-java scip-python scip-typescript scip-ruby; do
-  tag="latest"
-  if [[ "${indexer}" = "scip-python" ]] || [[ "${indexer}" = "scip-typescript" || "${indexer}" = "scip-ruby" ]]; then
-    tag="autoindex"
-  fi
+for image in \
+  sourcegraph/lsif-clang:latest \
+  sourcegraph/scip-go:latest \
+  sourcegraph/lsif-rust:latest \
+  sourcegraph/scip-rust:latest \
+  sourcegraph/scip-java:latest \
+  sourcegraph/scip-python:autoindex \
+  sourcegraph/scip-typescript:autoindex \
+  sourcegraph/scip-ruby:autoindex \
+  sourcegraph/scip-dotnet:latest \
+  davidrjenni/scip-php:latest \
+  workiva/scip-dart:latest; do
+  indexer="${image%:*}"
 
-  sha=$(docker buildx imagetools inspect sourcegraph/${indexer}:${tag} --raw | sha256sum | awk '{print "\"" "sha256:" $1 "\""}')
+  sha=$(docker buildx imagetools inspect "${image}" --raw | sha256sum | awk '{print "\"" "sha256:" $1 "\""}')
 
-  sed -i.bak \
-    "s|\("'"'"sourcegraph/${indexer}"'"'":\).*|\1${sha},|g" \
-    indexes.go
+  if grep -q "^	\"${indexer}\": *\"sha256:" indexes.go; then
+    sed -i.bak \
+      "s|^\(	\"${indexer}\":\).*|\1 ${sha},|g" \
+      indexes.go
+  else
+    # Pin an indexer that was previously referenced by tag
+    sed -i.bak \
+      -e "\|^	\"${indexer}\": *\"[a-z0-9.-]*\",$|d" \
+      -e "/^var defaultIndexerSHAs = /a\\	\"${indexer}\": ${sha}," \
+      indexes.go
+  fi
 
   echo "Updated tag for ${indexer}"
   rm indexes.go.bak
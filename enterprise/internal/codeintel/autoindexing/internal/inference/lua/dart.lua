@@ -0,0 +1,44 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+local indexer = require("sg.autoindex.indexes").get "dart"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment ".dart_tool",
+})
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "pubspec.yaml",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when pubspec.yaml files exist
+  generate = function(_, paths)
+    local jobs = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            commands = { "dart pub get" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "dart", "pub", "global", "run", "scip_dart", "./" },
+        outfile = outfile,
+        requested_envvars = { "PUB_HOSTED_URL" },
+      })
+    end
+
+    return jobs
+  end,
+}
@@ -0,0 +1,83 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+local indexer = require("sg.autoindex.indexes").get "dotnet"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment "bin",
+  pattern.new_path_segment "obj",
+})
+
+local is_solution_file = function(base)
+  return string.sub(base, -4) == ".sln"
+end
+
+-- Returns true if the given directory is the given root or one of its descendants.
+local is_within = function(dir, root)
+  return root == "" or dir == root or string.sub(dir, 1, string.len(root) + 1) == root .. "/"
+end
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "sln",
+    pattern.new_path_extension "csproj",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when solution or project files exist. A solution builds all of the
+  -- projects beneath it, so project files are only indexed on their own when no
+  -- solution is found in the same directory or any of its ancestors.
+  generate = function(_, paths)
+    local solution_roots = {}
+    for i = 1, #paths do
+      if is_solution_file(path.basename(paths[i])) then
+        solution_roots[path.dirname(paths[i])] = true
+      end
+    end
+
+    local roots = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+
+      if solution_roots[root] then
+        roots[root] = true
+      else
+        local covered = false
+        for solution_root in pairs(solution_roots) do
+          if is_within(root, solution_root) then
+            covered = true
+            break
+          end
+        end
+
+        if not covered then
+          roots[root] = true
+        end
+      end
+    end
+
+    local jobs = {}
+    for root in pairs(roots) do
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            commands = { "dotnet restore" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "scip-dotnet", "index" },
+        outfile = outfile,
+        requested_envvars = { "NUGET_AUTH_TOKEN" },
+      })
+    end
+
+    return jobs
+  end,
+}
@@ -38,6 +38,7 @@ return recognizer.new_path_recognizer {
     pattern.new_path_basename("build.gradle.kts"),
     pattern.new_path_basename("gradlew"),
     pattern.new_path_basename("settings.gradle"),
+    pattern.new_path_basename("settings.gradle.kts"),
     -- Maven
     pattern.new_path_basename("pom.xml"),
     -- SBT
@@ -2,8 +2,11 @@ local config = require("sg.autoindex.config").new {}
 
 for _, name in ipairs {
   "clang",
+  "dart",
+  "dotnet",
   "go",
   "java",
+  "php",
   "python",
   "ruby",
   "rust",
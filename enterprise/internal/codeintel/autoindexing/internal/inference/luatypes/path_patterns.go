@@ -54,15 +54,21 @@ func FlattenPatterns(pathPatterns []*PathPattern, inverted bool) (patterns []Glo
 }
 
 // FlattenPattern returns the set of patterns matching the given inverted flag on this
-// path pattern or any of its descendants.
+// path pattern or any of its descendants. The descendants of an exclude pattern are
+// themselves non-inverted, so they are all returned as inverted patterns.
 func FlattenPattern(pathPattern *PathPattern, inverted bool) (patterns []GlobAndPathspecPattern) {
 	if pathPattern.invert == inverted {
 		if pathPattern.pattern.Glob != "" {
 			patterns = append(patterns, pathPattern.pattern)
 		}
 
+		childInverted := inverted
+		if pathPattern.invert {
+			childInverted = false
+		}
+
 		for _, child := range pathPattern.children {
-			patterns = append(patterns, FlattenPattern(child, inverted)...)
+			patterns = append(patterns, FlattenPattern(child, childInverted)...)
 		}
 	}
 
@@ -16,6 +16,7 @@ type operations struct {
 	invokeRecognizers          *observation.Operation
 	resolveFileContents        *observation.Operation
 	resolvePaths               *observation.Operation
+	resolveRepositoryScript    *observation.Operation
 	setupRecognizers           *observation.Operation
 }
 
@@ -53,6 +54,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		invokeRecognizers:          op("invokeRecognizers"),
 		resolveFileContents:        op("resolveFileContents"),
 		resolvePaths:               op("resolvePaths"),
+		resolveRepositoryScript:    op("resolveRepositoryScript"),
 		setupRecognizers:           op("setupRecognizers"),
 	}
 }
@@ -25,6 +25,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// RepositoryScriptPath is the path of the inference script that may be committed to a
+// repository. It is run after the site-level override script with the same API.
+const RepositoryScriptPath = ".sourcegraph/index.lua"
+
 type Service struct {
 	sandboxService                  SandboxService
 	gitService                      GitService
@@ -110,7 +114,7 @@ func (s *Service) InferIndexJobs(ctx context.Context, repo api.RepoName, commit,
 		},
 	}
 
-	jobOrHints, logs, err := s.inferIndexJobOrHints(ctx, repo, commit, overrideScript, functionTable)
+	jobOrHints, logs, repositoryScriptErr, err := s.inferIndexJobOrHints(ctx, repo, commit, overrideScript, functionTable)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +129,9 @@ func (s *Service) InferIndexJobs(ctx context.Context, repo api.RepoName, commit,
 	}
 
 	return &shared.InferenceResult{
-		IndexJobs:       jobs,
-		InferenceOutput: logs,
+		IndexJobs:             jobs,
+		InferenceOutput:       logs,
+		RepositoryScriptError: repositoryScriptErr,
 	}, nil
 }
 
@@ -160,7 +165,7 @@ func (s *Service) InferIndexJobHints(ctx context.Context, repo api.RepoName, com
 		},
 	}
 
-	jobOrHints, _, err := s.inferIndexJobOrHints(ctx, repo, commit, overrideScript, functionTable)
+	jobOrHints, _, _, err := s.inferIndexJobOrHints(ctx, repo, commit, overrideScript, functionTable)
 	if err != nil {
 		return nil, err
 	}
@@ -182,24 +187,21 @@ func (s *Service) InferIndexJobHints(ctx context.Context, repo api.RepoName, com
 // overwrite them (to disable or change default behavior). Each recognizer's callback function is invoked
 // and the resulting values are combined into a flattened list. See InferIndexJobs and InferIndexJobHints
 // for concrete implementations of the given function table.
+//
+// If the repository contains an inference script at RepositoryScriptPath, it is run after the given
+// script in the same way. If the repository script or one of its recognizers fails, the values are
+// computed again without it in a fresh sandbox, and the error is returned alongside them.
 func (s *Service) inferIndexJobOrHints(
 	ctx context.Context,
 	repo api.RepoName,
 	commit string,
 	overrideScript string,
 	invocationContextMethods invocationFunctionTable,
-) (_ []indexJobOrHint, logs string, _ error) {
-	sandbox, err := s.createSandbox(ctx)
-	if err != nil {
-		return nil, "", err
-	}
-	defer sandbox.Close()
-
+) (_ []indexJobOrHint, logs string, repositoryScriptErr error, _ error) {
 	var buf bytes.Buffer
 	defer func() { logs = buf.String() }()
 
 	invocationContext := invocationContext{
-		sandbox:                 sandbox,
 		printSink:               &buf,
 		gitService:              s.gitService,
 		repo:                    repo,
@@ -207,13 +209,71 @@ func (s *Service) inferIndexJobOrHints(
 		invocationFunctionTable: invocationContextMethods,
 	}
 
-	recognizers, err := s.setupRecognizers(ctx, invocationContext, overrideScript)
+	repositoryScript, err := s.resolveRepositoryScript(ctx, invocationContext)
+	if err != nil {
+		if !errors.As(err, &LimitError{}) {
+			return nil, logs, nil, err
+		}
+
+		repositoryScriptErr = err
+	}
+
+	if repositoryScript != "" {
+		jobsOrHints, err := s.invokeScripts(ctx, invocationContext, overrideScript, repositoryScript)
+		if err == nil {
+			return jobsOrHints, logs, nil, nil
+		}
+
+		repositoryScriptErr = err
+	}
+	if repositoryScriptErr != nil {
+		fmt.Fprintf(&buf, "Ignoring %s: %s\n", RepositoryScriptPath, repositoryScriptErr)
+	}
+
+	jobsOrHints, err := s.invokeScripts(ctx, invocationContext, overrideScript)
+	return jobsOrHints, logs, repositoryScriptErr, err
+}
+
+// invokeScripts runs the default recognizers followed by the given override scripts in a fresh Lua
+// sandbox and invokes the resulting recognizers.
+func (s *Service) invokeScripts(ctx context.Context, invocationContext invocationContext, overrideScripts ...string) ([]indexJobOrHint, error) {
+	sandbox, err := s.createSandbox(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer sandbox.Close()
+
+	invocationContext.sandbox = sandbox
+
+	recognizers, err := s.setupRecognizers(ctx, invocationContext, overrideScripts...)
 	if err != nil || len(recognizers) == 0 {
-		return nil, logs, err
+		return nil, err
 	}
 
-	jobsOrHints, err := s.invokeRecognizers(ctx, invocationContext, recognizers)
-	return jobsOrHints, logs, err
+	return s.invokeRecognizers(ctx, invocationContext, recognizers)
+}
+
+// resolveRepositoryScript returns the content of the inference script committed to the repository
+// at RepositoryScriptPath, or an empty string if the repository does not contain one.
+func (s *Service) resolveRepositoryScript(ctx context.Context, invocationContext invocationContext) (_ string, err error) {
+	ctx, _, endObservation := s.operations.resolveRepositoryScript.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	patterns := []*luatypes.PathPattern{
+		luatypes.NewPattern("/"+RepositoryScriptPath, []string{RepositoryScriptPath}),
+	}
+
+	paths, err := s.resolvePaths(ctx, invocationContext, patterns)
+	if err != nil || len(paths) == 0 {
+		return "", err
+	}
+
+	contentsByPath, err := s.resolveFileContents(ctx, invocationContext, paths, patterns)
+	if err != nil {
+		return "", err
+	}
+
+	return contentsByPath[RepositoryScriptPath], nil
 }
 
 // createSandbox creates a Lua sandbox wih the modules loaded for use with auto indexing inference.
@@ -241,9 +301,9 @@ func (s *Service) createSandbox(ctx context.Context) (_ *luasandbox.Sandbox, err
 	return sandbox, nil
 }
 
-// setupRecognizers runs the given default and override scripts in the given sandbox and converts the
-// script return values to a list of recognizer instances.
-func (s *Service) setupRecognizers(ctx context.Context, invocationContext invocationContext, overrideScript string) (_ []*luatypes.Recognizer, err error) {
+// setupRecognizers runs the default script followed by the given override scripts in the given sandbox
+// and converts the script return values to a list of recognizer instances.
+func (s *Service) setupRecognizers(ctx context.Context, invocationContext invocationContext, overrideScripts ...string) (_ []*luatypes.Recognizer, err error) {
 	ctx, _, endObservation := s.operations.setupRecognizers.With(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
 
@@ -260,7 +320,11 @@ func (s *Service) setupRecognizers(ctx context.Context, invocationContext invoca
 		return nil, err
 	}
 
-	if overrideScript != "" {
+	for _, overrideScript := range overrideScripts {
+		if overrideScript == "" {
+			continue
+		}
+
 		rawRecognizers, err := invocationContext.sandbox.RunScript(ctx, opts, overrideScript)
 		if err != nil {
 			return nil, err
@@ -3,6 +3,7 @@ package inference
 import (
 	"context"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -126,6 +127,131 @@ func TestOverrideGenerators(t *testing.T) {
 	)
 }
 
+const repositoryScript = `
+	local path = require("path")
+	local pattern = require("sg.autoindex.patterns")
+	local recognizer = require("sg.autoindex.recognizer")
+
+	local custom_recognizer = recognizer.new_path_recognizer {
+		patterns = { pattern.new_path_basename("acme-custom.yaml") },
+
+		-- Invoked with paths matching acme-custom.yaml anywhere in repo
+		generate = function(_, paths)
+			local jobs = {}
+			for i = 1, #paths do
+				table.insert(jobs, {
+					steps = {},
+					root = path.dirname(paths[i]),
+					indexer = "acme/repo-indexer",
+					indexer_args = {},
+					outfile = "",
+				})
+			end
+
+			return jobs
+		end,
+	}
+
+	return require("sg.autoindex.config").new({
+		["sg.test"] = false,
+		["acme.repo"] = custom_recognizer,
+	})
+`
+
+func TestRepositoryScriptGenerators(t *testing.T) {
+	testGenerators(t,
+		generatorTestCase{
+			description: "repository script",
+			repositoryContents: map[string]string{
+				".sourcegraph/index.lua": repositoryScript,
+				"acme-custom.yaml":       "",
+				"foo/acme-custom.yaml":   "",
+				"foo/sg-test":            "",
+			},
+			expected: []config.IndexJob{
+				// sg.test is disabled by the repository script
+				{Indexer: "acme/repo-indexer", Root: ""},
+				{Indexer: "acme/repo-indexer", Root: "foo"},
+			},
+		},
+		generatorTestCase{
+			description: "repository script after override",
+			overrideScript: `
+				return require("sg.autoindex.config").new({
+					["acme.repo"] = false,
+				})
+			`,
+			repositoryContents: map[string]string{
+				".sourcegraph/index.lua": repositoryScript,
+				"acme-custom.yaml":       "",
+			},
+			expected: []config.IndexJob{
+				{Indexer: "acme/repo-indexer", Root: ""},
+			},
+		},
+		generatorTestCase{
+			description: "nested script is ignored",
+			repositoryContents: map[string]string{
+				"foo/.sourcegraph/index.lua": repositoryScript,
+				"acme-custom.yaml":           "",
+				"sg-test":                    "",
+			},
+			expected: []config.IndexJob{
+				{Indexer: "test", Root: ""},
+			},
+		},
+	)
+}
+
+func TestRepositoryScriptErrors(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		script      string
+	}{
+		{description: "syntax error", script: "return {"},
+		{description: "runtime error", script: `error("oops")`},
+		{
+			description: "recognizer error",
+			script: `
+				local pattern = require("sg.autoindex.patterns")
+				local recognizer = require("sg.autoindex.recognizer")
+
+				return require("sg.autoindex.config").new({
+					["acme.broken"] = recognizer.new_path_recognizer {
+						patterns = { pattern.new_path_basename("sg-test") },
+						generate = function(_, paths)
+							error("oops")
+						end,
+					},
+				})
+			`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			service := testService(t, map[string]string{
+				".sourcegraph/index.lua": testCase.script,
+				"sg-test":                "",
+			})
+
+			result, err := service.InferIndexJobs(context.Background(), "github.com/test/test", "HEAD", "")
+			if err != nil {
+				t.Fatalf("unexpected error inferring jobs: %s", err)
+			}
+			if result.RepositoryScriptError == nil {
+				t.Errorf("expected repository script error")
+			}
+
+			// Jobs are inferred without the repository script
+			if diff := cmp.Diff([]config.IndexJob{{Indexer: "test", Root: ""}}, result.IndexJobs); diff != "" {
+				t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+			}
+			if !strings.Contains(result.InferenceOutput, RepositoryScriptPath) {
+				t.Errorf("expected inference output to mention %s, have %q", RepositoryScriptPath, result.InferenceOutput)
+			}
+		})
+	}
+}
+
 type generatorTestCase struct {
 	description        string
 	overrideScript     string
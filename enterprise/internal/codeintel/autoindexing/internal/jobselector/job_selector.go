@@ -232,9 +232,10 @@ func convertIndexConfiguration(repositoryID int, commit string, indexConfigurati
 		var dockerSteps []uploadsshared.DockerStep
 		for _, dockerStep := range indexJob.Steps {
 			dockerSteps = append(dockerSteps, uploadsshared.DockerStep{
-				Root:     dockerStep.Root,
-				Image:    dockerStep.Image,
-				Commands: dockerStep.Commands,
+				Root:      dockerStep.Root,
+				Image:     dockerStep.Image,
+				Commands:  dockerStep.Commands,
+				Resources: dockerStep.Resources,
 			})
 		}
 
@@ -249,6 +250,7 @@ func convertIndexConfiguration(repositoryID int, commit string, indexConfigurati
 			IndexerArgs:      indexJob.IndexerArgs,
 			Outfile:          indexJob.Outfile,
 			RequestedEnvVars: indexJob.RequestedEnvVars,
+			IndexerResources: indexJob.IndexerResources,
 		})
 	}
 
@@ -262,9 +264,10 @@ func convertInferredConfiguration(repositoryID int, commit string, indexJobs []c
 		var dockerSteps []uploadsshared.DockerStep
 		for _, dockerStep := range indexJob.Steps {
 			dockerSteps = append(dockerSteps, uploadsshared.DockerStep{
-				Root:     dockerStep.Root,
-				Image:    dockerStep.Image,
-				Commands: dockerStep.Commands,
+				Root:      dockerStep.Root,
+				Image:     dockerStep.Image,
+				Commands:  dockerStep.Commands,
+				Resources: dockerStep.Resources,
 			})
 		}
 
@@ -279,6 +282,7 @@ func convertInferredConfiguration(repositoryID int, commit string, indexJobs []c
 			IndexerArgs:      indexJob.IndexerArgs,
 			Outfile:          indexJob.Outfile,
 			RequestedEnvVars: indexJob.RequestedEnvVars,
+			IndexerResources: indexJob.IndexerResources,
 		})
 	}
 
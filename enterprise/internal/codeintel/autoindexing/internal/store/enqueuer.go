@@ -132,7 +132,7 @@ func (s *store) InsertIndexes(ctx context.Context, indexes []shared.Index) (_ []
 		}
 
 		values = append(values, sqlf.Sprintf(
-			"(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
+			"(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
 			index.State,
 			index.Commit,
 			index.RepositoryID,
@@ -144,6 +144,7 @@ func (s *store) InsertIndexes(ctx context.Context, indexes []shared.Index) (_ []
 			index.Outfile,
 			pq.Array(index.ExecutionLogs),
 			pq.Array(index.RequestedEnvVars),
+			dbutil.JSONMessage(&index.IndexerResources),
 		))
 	}
 
@@ -185,7 +186,8 @@ INSERT INTO lsif_indexes (
 	indexer_args,
 	outfile,
 	execution_logs,
-	requested_envvars
+	requested_envvars,
+	indexer_resources
 )
 VALUES %s
 RETURNING id
@@ -215,7 +217,8 @@ SELECT
 	u.local_steps,
 	(SELECT MAX(id) FROM lsif_uploads WHERE associated_index_id = u.id) AS associated_upload_id,
 	u.should_reindex,
-	u.requested_envvars
+	u.requested_envvars,
+	u.indexer_resources
 FROM lsif_indexes u
 LEFT JOIN (
 	SELECT
@@ -259,6 +262,7 @@ func scanIndex(s dbutil.Scanner) (index shared.Index, err error) {
 		&index.AssociatedUploadID,
 		&index.ShouldReindex,
 		pq.Array(&index.RequestedEnvVars),
+		dbutil.JSONMessage(&index.IndexerResources),
 	); err != nil {
 		return index, err
 	}
@@ -12,4 +12,8 @@ type IndexConfiguration struct {
 type InferenceResult struct {
 	IndexJobs       []config.IndexJob
 	InferenceOutput string
+
+	// RepositoryScriptError is the error raised by the inference script committed to the
+	// repository, if any. Jobs are inferred without that script when it fails.
+	RepositoryScriptError error
 }
@@ -1,10 +1,14 @@
 package codenav
 
 import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/background"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/background/deadcode"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
+	codenavstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store"
 	codeintelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
@@ -16,16 +20,29 @@ func NewService(
 	gitserver gitserver.Client,
 ) *Service {
 	lsifStore := lsifstore.New(scopedContext("lsifstore", observationCtx), codeIntelDB)
+	store := codenavstore.New(scopedContext("store", observationCtx), db)
 
 	return newService(
 		observationCtx,
 		db.Repos(),
 		lsifStore,
+		store,
 		uploadSvc,
 		gitserver,
 	)
 }
 
+var DeadCodeReporterConfigInst = &deadcode.Config{}
+
+func NewDeadCodeReporter(observationCtx *observation.Context, service *Service) []goroutine.BackgroundRoutine {
+	return background.DeadCodeReporterJob(
+		scopedContext("deadcode", observationCtx),
+		service.store,
+		service.lsifstore,
+		DeadCodeReporterConfigInst,
+	)
+}
+
 func scopedContext(component string, parent *observation.Context) *observation.Context {
 	return observation.ScopedContext("codeintel", "codenav", component, parent)
 }
@@ -0,0 +1,21 @@
+package deadcode
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type Config struct {
+	env.BaseConfig
+
+	Interval          time.Duration
+	BatchSize         int
+	RecomputeInterval time.Duration
+}
+
+func (c *Config) Load() {
+	c.Interval = c.GetInterval("CODEINTEL_DEAD_CODE_REPORTER_INTERVAL", "1m", "How frequently to compute dead code reports.")
+	c.BatchSize = c.GetInt("CODEINTEL_DEAD_CODE_REPORTER_BATCH_SIZE", "10", "How many precise indexes to compute dead code reports for at once.")
+	c.RecomputeInterval = c.GetInterval("CODEINTEL_DEAD_CODE_REPORTER_RECOMPUTE_INTERVAL", "24h", "How long a dead code report stays current before it is recomputed to account for new references from other repositories.")
+}
@@ -0,0 +1,158 @@
+package deadcode
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func NewReporter(store store.Store, lsifStore lsifstore.LsifStore, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+	r := &reporter{
+		store:     store,
+		lsifStore: lsifStore,
+		metrics:   newMetrics(observationCtx),
+		config:    config,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(r.handle),
+		goroutine.WithName("codeintel.dead-code-reporter"),
+		goroutine.WithDescription("Computes the definitions of precise indexes that are not referenced by any precise index visible at the tip of a default branch."),
+		goroutine.WithInterval(config.Interval),
+	)
+}
+
+type reporter struct {
+	store     store.Store
+	lsifStore lsifstore.LsifStore
+	metrics   *metrics
+	config    *Config
+}
+
+func (r *reporter) handle(ctx context.Context) error {
+	numDeleted, err := r.store.DeleteStaleDeadCodeReports(ctx)
+	if err != nil {
+		return err
+	}
+	r.metrics.numStaleReportsDeleted.Add(float64(numDeleted))
+
+	uploadIDs, err := r.store.GetUploadsForDeadCodeReport(ctx, r.config.BatchSize, time.Now().Add(-r.config.RecomputeInterval))
+	if err != nil {
+		return err
+	}
+
+	for _, uploadID := range uploadIDs {
+		if err := r.report(ctx, uploadID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// report computes and stores the dead code report of the given upload. Definitions that are not
+// referenced within the upload are candidates. Candidates belonging to a package provided by the
+// upload are exported, and are discarded when referenced by another upload depending on that package.
+func (r *reporter) report(ctx context.Context, uploadID int) error {
+	candidates, numDefinitions, err := r.lsifStore.GetUnreferencedDefinitions(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+
+	packages, err := r.store.GetUploadPackages(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+
+	exportedSymbolNames := markExportedDefinitions(candidates, packages)
+
+	if len(exportedSymbolNames) > 0 {
+		referencingUploadIDs, err := r.store.GetReferencingUploadIDs(ctx, uploadID)
+		if err != nil {
+			return err
+		}
+
+		referencedSymbolNames, err := r.lsifStore.GetReferencedSymbolNames(ctx, referencingUploadIDs, exportedSymbolNames)
+		if err != nil {
+			return err
+		}
+
+		candidates = removeReferencedDefinitions(candidates, referencedSymbolNames)
+	}
+
+	if err := r.store.InsertDeadCodeReport(ctx, uploadID, numDefinitions, candidates); err != nil {
+		return err
+	}
+
+	r.metrics.numUploadsReported.Inc()
+	r.metrics.numDefinitionsScanned.Add(float64(numDefinitions))
+	r.metrics.numDeadCodeDefinitions.Add(float64(len(candidates)))
+	return nil
+}
+
+// markExportedDefinitions sets the exported flag of the given definitions whose symbol belongs to one
+// of the given packages, and returns the distinct names of the exported symbols.
+func markExportedDefinitions(definitions []shared.DeadCodeDefinition, packages []precise.Package) []string {
+	providedPackages := make(map[precise.Package]struct{}, len(packages))
+	for _, pkg := range packages {
+		providedPackages[pkg] = struct{}{}
+	}
+
+	seen := map[string]struct{}{}
+	symbolNames := make([]string, 0, len(definitions))
+	for i, definition := range definitions {
+		symbol, err := scip.ParseSymbol(definition.SymbolName)
+		if err != nil || symbol.Package == nil {
+			continue
+		}
+
+		pkg := precise.Package{
+			Scheme:  symbol.Scheme,
+			Manager: symbol.Package.Manager,
+			Name:    symbol.Package.Name,
+			Version: symbol.Package.Version,
+		}
+		if _, ok := providedPackages[pkg]; !ok {
+			continue
+		}
+
+		definitions[i].Exported = true
+		if _, ok := seen[definition.SymbolName]; !ok {
+			seen[definition.SymbolName] = struct{}{}
+			symbolNames = append(symbolNames, definition.SymbolName)
+		}
+	}
+
+	return symbolNames
+}
+
+// removeReferencedDefinitions returns the given definitions whose symbol is not in the given list.
+func removeReferencedDefinitions(definitions []shared.DeadCodeDefinition, referencedSymbolNames []string) []shared.DeadCodeDefinition {
+	if len(referencedSymbolNames) == 0 {
+		return definitions
+	}
+
+	referenced := make(map[string]struct{}, len(referencedSymbolNames))
+	for _, symbolName := range referencedSymbolNames {
+		referenced[symbolName] = struct{}{}
+	}
+
+	filtered := definitions[:0]
+	for _, definition := range definitions {
+		if _, ok := referenced[definition.SymbolName]; !ok {
+			filtered = append(filtered, definition)
+		}
+	}
+
+	return filtered
+}
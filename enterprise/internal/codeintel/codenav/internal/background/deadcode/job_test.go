@@ -0,0 +1,50 @@
+package deadcode
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestMarkExportedDefinitions(t *testing.T) {
+	const (
+		exported   = "scip-go gomod github.com/sourcegraph/foo v1.0.0 `github.com/sourcegraph/foo/lib`/Exported()."
+		unexported = "scip-go gomod github.com/sourcegraph/foo/internal v1.0.0 `github.com/sourcegraph/foo/internal`/helper()."
+	)
+
+	definitions := []shared.DeadCodeDefinition{
+		{SymbolName: exported, DocumentPath: "lib/a.go"},
+		{SymbolName: unexported, DocumentPath: "internal/b.go"},
+		{SymbolName: exported, DocumentPath: "lib/a_windows.go"},
+		{SymbolName: "not a symbol", DocumentPath: "c.go"},
+	}
+	packages := []precise.Package{
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/foo", Version: "v1.0.0"},
+	}
+
+	symbolNames := markExportedDefinitions(definitions, packages)
+	if diff := cmp.Diff([]string{exported}, symbolNames); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+
+	expectedDefinitions := []shared.DeadCodeDefinition{
+		{SymbolName: exported, DocumentPath: "lib/a.go", Exported: true},
+		{SymbolName: unexported, DocumentPath: "internal/b.go"},
+		{SymbolName: exported, DocumentPath: "lib/a_windows.go", Exported: true},
+		{SymbolName: "not a symbol", DocumentPath: "c.go"},
+	}
+	if diff := cmp.Diff(expectedDefinitions, definitions); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+
+	expectedDefinitions = []shared.DeadCodeDefinition{
+		{SymbolName: unexported, DocumentPath: "internal/b.go"},
+		{SymbolName: "not a symbol", DocumentPath: "c.go"},
+	}
+	if diff := cmp.Diff(expectedDefinitions, removeReferencedDefinitions(definitions, symbolNames)); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,50 @@
+package deadcode
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type metrics struct {
+	numUploadsReported     prometheus.Counter
+	numDefinitionsScanned  prometheus.Counter
+	numDeadCodeDefinitions prometheus.Counter
+	numStaleReportsDeleted prometheus.Counter
+}
+
+func newMetrics(observationCtx *observation.Context) *metrics {
+	counter := func(name, help string) prometheus.Counter {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: name,
+			Help: help,
+		})
+
+		observationCtx.Registerer.MustRegister(counter)
+		return counter
+	}
+
+	numUploadsReported := counter(
+		"src_codeintel_dead_code_num_uploads_reported_total",
+		"The total number of precise indexes for which a dead code report was computed.",
+	)
+	numDefinitionsScanned := counter(
+		"src_codeintel_dead_code_num_definitions_scanned_total",
+		"The total number of definitions scanned for references.",
+	)
+	numDeadCodeDefinitions := counter(
+		"src_codeintel_dead_code_num_dead_code_definitions_total",
+		"The total number of unreferenced definitions found.",
+	)
+	numStaleReportsDeleted := counter(
+		"src_codeintel_dead_code_num_stale_reports_deleted_total",
+		"The total number of dead code reports deleted for precise indexes no longer visible at the tip of the default branch.",
+	)
+
+	return &metrics{
+		numUploadsReported:     numUploadsReported,
+		numDefinitionsScanned:  numDefinitionsScanned,
+		numDeadCodeDefinitions: numDeadCodeDefinitions,
+		numStaleReportsDeleted: numStaleReportsDeleted,
+	}
+}
@@ -0,0 +1,20 @@
+package background
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/background/deadcode"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func DeadCodeReporterJob(
+	observationCtx *observation.Context,
+	store store.Store,
+	lsifStore lsifstore.LsifStore,
+	config *deadcode.Config,
+) []goroutine.BackgroundRoutine {
+	return []goroutine.BackgroundRoutine{
+		deadcode.NewReporter(store, lsifStore, observationCtx, config),
+	}
+}
@@ -0,0 +1,136 @@
+package lsifstore
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetUnreferencedDefinitions returns the definitions of global symbols within the given upload that
+// are not referenced from any document of the same upload. This method also returns the total number
+// of definitions of global symbols within the upload.
+func (s *store) GetUnreferencedDefinitions(ctx context.Context, uploadID int) (_ []shared.DeadCodeDefinition, _ int, err error) {
+	ctx, trace, endObservation := s.operations.getUnreferencedDefinitions.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	collector := newDefinitionCollector(uploadID)
+	if err := s.scanDocuments(ctx, uploadID, func(path string, document *scip.Document) error {
+		collector.add(path, document)
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+
+	definitions := collector.unreferenced()
+	trace.AddEvent("TODO Domain Owner",
+		attribute.Int("numDefinitions", len(collector.definitions)),
+		attribute.Int("numUnreferencedDefinitions", len(definitions)))
+
+	return definitions, len(collector.definitions), nil
+}
+
+// GetReferencedSymbolNames returns the subset of the given symbol names that are referenced from
+// any document of the given uploads.
+func (s *store) GetReferencedSymbolNames(ctx context.Context, uploadIDs []int, symbolNames []string) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getReferencedSymbolNames.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+		attribute.IntSlice("uploadIDs", uploadIDs),
+		attribute.Int("numSymbolNames", len(symbolNames)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 || len(symbolNames) == 0 {
+		return nil, nil
+	}
+
+	return basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(
+		getReferencedSymbolNamesQuery,
+		pq.Array(uploadIDs),
+		pq.Array(symbolNames),
+	)))
+}
+
+const getReferencedSymbolNamesQuery = `
+WITH RECURSIVE
+` + layersCTE + `,
+` + symbolIDsCTEs + `
+SELECT DISTINCT msn.symbol_name
+FROM layers l
+JOIN matching_symbol_names msn ON msn.upload_id = l.layer_id
+JOIN codeintel_scip_symbols ss ON ss.upload_id = msn.upload_id AND ss.symbol_id = msn.id
+JOIN codeintel_scip_document_lookup sid ON sid.id = ss.document_lookup_id
+WHERE
+	ss.reference_ranges IS NOT NULL AND
+	` + visibleDocumentCondition + `
+ORDER BY msn.symbol_name
+`
+
+// definitionCollector accumulates the definitions and the references of global symbols over the
+// documents of an upload.
+type definitionCollector struct {
+	uploadID    int
+	definitions []shared.DeadCodeDefinition
+	referenced  map[string]struct{}
+}
+
+func newDefinitionCollector(uploadID int) *definitionCollector {
+	return &definitionCollector{
+		uploadID:   uploadID,
+		referenced: map[string]struct{}{},
+	}
+}
+
+func (c *definitionCollector) add(path string, document *scip.Document) {
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == "" || scip.IsLocalSymbol(occurrence.Symbol) {
+			continue
+		}
+
+		if !scip.SymbolRole_Definition.Matches(occurrence) {
+			c.referenced[occurrence.Symbol] = struct{}{}
+			continue
+		}
+
+		r := scip.NewRange(occurrence.Range)
+		c.definitions = append(c.definitions, shared.DeadCodeDefinition{
+			UploadID:     c.uploadID,
+			SymbolName:   occurrence.Symbol,
+			DocumentPath: path,
+			Range:        newRange(int(r.Start.Line), int(r.Start.Character), int(r.End.Line), int(r.End.Character)),
+		})
+	}
+
+	// Symbols taking part in a relationship may be used without being referenced by name, e.g. a
+	// method implementing an interface method called through the interface. Treat both sides of
+	// the relationship as referenced so they are never reported as dead code.
+	for _, symbol := range document.Symbols {
+		for _, relationship := range symbol.Relationships {
+			if relationship.IsImplementation || relationship.IsReference || relationship.IsDefinition {
+				c.referenced[symbol.Symbol] = struct{}{}
+				c.referenced[relationship.Symbol] = struct{}{}
+			}
+		}
+	}
+}
+
+// unreferenced returns the collected definitions of symbols that are not referenced by any of the
+// collected documents.
+func (c *definitionCollector) unreferenced() []shared.DeadCodeDefinition {
+	definitions := make([]shared.DeadCodeDefinition, 0, len(c.definitions))
+	for _, definition := range c.definitions {
+		if _, ok := c.referenced[definition.SymbolName]; !ok {
+			definitions = append(definitions, definition)
+		}
+	}
+
+	return definitions
+}
@@ -0,0 +1,66 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+)
+
+func TestDefinitionCollector(t *testing.T) {
+	const (
+		used      = "scip-go gomod example v1 `example`/Used()."
+		unused    = "scip-go gomod example v1 `example`/Unused()."
+		iface     = "scip-go gomod example v1 `example`/Handler#Handle()."
+		impl      = "scip-go gomod example v1 `example`/server#Handle()."
+		localOnly = "local 1"
+	)
+
+	collector := newDefinitionCollector(42)
+	collector.add("a.go", &scip.Document{
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{0, 5, 9}, Symbol: used, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{3, 5, 11}, Symbol: unused, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{6, 1, 8}, Symbol: localOnly, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{8, 16, 22}, Symbol: impl, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+		Symbols: []*scip.SymbolInformation{
+			{Symbol: impl, Relationships: []*scip.Relationship{{Symbol: iface, IsImplementation: true}}},
+		},
+	})
+	collector.add("b.go", &scip.Document{
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{1, 1, 5}, Symbol: used},
+			{Range: []int32{2, 1, 8, 3}, Symbol: iface, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+	})
+
+	if len(collector.definitions) != 4 {
+		t.Fatalf("unexpected number of definitions. want=%d have=%d", 4, len(collector.definitions))
+	}
+
+	expected := []shared.DeadCodeDefinition{
+		{UploadID: 42, SymbolName: unused, DocumentPath: "a.go", Range: newRange(3, 5, 3, 11)},
+	}
+	if diff := cmp.Diff(expected, collector.unreferenced()); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+}
+
+func TestDatabaseGetReferencedSymbolNames(t *testing.T) {
+	store := populateTestStore(t)
+
+	referenced := "scip-typescript npm template 0.0.0-DEVELOPMENT src/util/`helpers.ts`/asArray()."
+	unknown := "scip-typescript npm template 0.0.0-DEVELOPMENT src/util/`helpers.ts`/doesNotExist()."
+
+	symbolNames, err := store.GetReferencedSymbolNames(context.Background(), []int{testSCIPUploadID}, []string{referenced, unknown})
+	if err != nil {
+		t.Fatalf("unexpected error querying referenced symbol names: %s", err)
+	}
+	if diff := cmp.Diff([]string{referenced}, symbolNames); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,105 @@
+package lsifstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetDefinedSymbols returns the global symbols defined within the given upload, along with their
+// signature and documentation. Symbols defined more than once are returned with their first
+// definition, in document path order.
+func (s *store) GetDefinedSymbols(ctx context.Context, uploadID int) (_ []shared.DefinedSymbol, err error) {
+	ctx, trace, endObservation := s.operations.getDefinedSymbols.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	collector := newDefinedSymbolCollector()
+	if err := s.scanDocuments(ctx, uploadID, func(path string, document *scip.Document) error {
+		collector.add(path, document)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numSymbols", len(collector.symbols)))
+
+	return collector.symbols, nil
+}
+
+// definedSymbolCollector accumulates the first definition of each global symbol over the
+// documents of an upload.
+type definedSymbolCollector struct {
+	symbols []shared.DefinedSymbol
+	seen    map[string]struct{}
+}
+
+func newDefinedSymbolCollector() *definedSymbolCollector {
+	return &definedSymbolCollector{
+		seen: map[string]struct{}{},
+	}
+}
+
+func (c *definedSymbolCollector) add(path string, document *scip.Document) {
+	symbolInformation := make(map[string]*scip.SymbolInformation, len(document.Symbols))
+	for _, symbol := range document.Symbols {
+		symbolInformation[symbol.Symbol] = symbol
+	}
+
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == "" || scip.IsLocalSymbol(occurrence.Symbol) || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+		if _, ok := c.seen[occurrence.Symbol]; ok {
+			continue
+		}
+		c.seen[occurrence.Symbol] = struct{}{}
+
+		signature, documentation := splitSignature(symbolInformation[occurrence.Symbol])
+		r := scip.NewRange(occurrence.Range)
+		c.symbols = append(c.symbols, shared.DefinedSymbol{
+			SymbolName:    occurrence.Symbol,
+			DocumentPath:  path,
+			Range:         newRange(int(r.Start.Line), int(r.Start.Character), int(r.End.Line), int(r.End.Character)),
+			Signature:     signature,
+			Documentation: documentation,
+		})
+	}
+}
+
+// splitSignature returns the signature and the documentation of the given symbol. Indexers that do
+// not emit a signature document conventionally emit the signature as a fenced code block in the
+// first documentation section.
+func splitSignature(symbol *scip.SymbolInformation) (signature, documentation string) {
+	if symbol == nil {
+		return "", ""
+	}
+
+	sections := symbol.Documentation
+	if symbol.SignatureDocumentation != nil && symbol.SignatureDocumentation.Text != "" {
+		signature = symbol.SignatureDocumentation.Text
+	} else if len(sections) > 0 && strings.HasPrefix(sections[0], "```") {
+		signature = trimCodeFence(sections[0])
+		sections = sections[1:]
+	}
+
+	return signature, strings.TrimSpace(strings.Join(sections, "\n\n"))
+}
+
+// trimCodeFence returns the content of the given fenced code block.
+func trimCodeFence(block string) string {
+	block = strings.TrimSpace(block)
+	if i := strings.IndexByte(block, '\n'); i >= 0 {
+		block = block[i+1:]
+	} else {
+		block = strings.TrimPrefix(block, "```")
+	}
+
+	return strings.TrimSpace(strings.TrimSuffix(block, "```"))
+}
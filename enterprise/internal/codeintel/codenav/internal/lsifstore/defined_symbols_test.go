@@ -0,0 +1,107 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+)
+
+func TestDefinedSymbolCollector(t *testing.T) {
+	const (
+		withSignatureDocument = "scip-go gomod example v1 `example`/New()."
+		withCodeFence         = "scip-go gomod example v1 `example`/Server#"
+		withoutSignature      = "scip-go gomod example v1 `example`/Version."
+		referenceOnly         = "scip-go gomod fmt v1 `fmt`/Println()."
+		localOnly             = "local 1"
+	)
+
+	collector := newDefinedSymbolCollector()
+	collector.add("a.go", &scip.Document{
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{0, 5, 8}, Symbol: withSignatureDocument, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{1, 1, 8}, Symbol: referenceOnly},
+			{Range: []int32{2, 1, 2}, Symbol: localOnly, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{4, 5, 11}, Symbol: withCodeFence, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+		Symbols: []*scip.SymbolInformation{
+			{
+				Symbol:                 withSignatureDocument,
+				SignatureDocumentation: &scip.Document{Text: "func New() *Server"},
+				Documentation:          []string{"New creates a server."},
+			},
+			{
+				Symbol:        withCodeFence,
+				Documentation: []string{"```go\ntype Server struct\n```", "Server serves requests.", "It is safe for concurrent use."},
+			},
+		},
+	})
+	collector.add("b.go", &scip.Document{
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{0, 5, 11}, Symbol: withCodeFence, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{2, 6, 13}, Symbol: withoutSignature, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+		Symbols: []*scip.SymbolInformation{
+			{Symbol: withoutSignature, Documentation: []string{"Version is the current version."}},
+		},
+	})
+
+	expected := []shared.DefinedSymbol{
+		{
+			SymbolName:    withSignatureDocument,
+			DocumentPath:  "a.go",
+			Range:         newRange(0, 5, 0, 8),
+			Signature:     "func New() *Server",
+			Documentation: "New creates a server.",
+		},
+		{
+			SymbolName:    withCodeFence,
+			DocumentPath:  "a.go",
+			Range:         newRange(4, 5, 4, 11),
+			Signature:     "type Server struct",
+			Documentation: "Server serves requests.\n\nIt is safe for concurrent use.",
+		},
+		{
+			SymbolName:    withoutSignature,
+			DocumentPath:  "b.go",
+			Range:         newRange(2, 6, 2, 13),
+			Documentation: "Version is the current version.",
+		},
+	}
+	if diff := cmp.Diff(expected, collector.symbols); diff != "" {
+		t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+	}
+}
+
+func TestDatabaseGetDefinedSymbols(t *testing.T) {
+	store := populateTestStore(t)
+
+	symbols, err := store.GetDefinedSymbols(context.Background(), testSCIPUploadID)
+	if err != nil {
+		t.Fatalf("unexpected error querying defined symbols: %s", err)
+	}
+
+	const asArray = "scip-typescript npm template 0.0.0-DEVELOPMENT src/util/`helpers.ts`/asArray()."
+
+	found := false
+	seen := map[string]struct{}{}
+	for _, symbol := range symbols {
+		if _, ok := seen[symbol.SymbolName]; ok {
+			t.Errorf("unexpected duplicate symbol %q", symbol.SymbolName)
+		}
+		seen[symbol.SymbolName] = struct{}{}
+
+		if scip.IsLocalSymbol(symbol.SymbolName) {
+			t.Errorf("unexpected local symbol %q", symbol.SymbolName)
+		}
+		if symbol.SymbolName == asArray {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected symbol %q to be defined", asArray)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -23,19 +24,37 @@ func (s *store) GetPathExists(ctx context.Context, bundleID int, path string) (_
 
 	exists, _, err := basestore.ScanFirstBool(s.db.Query(ctx, sqlf.Sprintf(
 		existsQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		path,
 	)))
 	return exists, err
 }
 
 const existsQuery = `
+SELECT ` + documentLookupIDFragment + ` IS NOT NULL
+`
+
+// HasSymbolData determines if any layer of the given upload defines or references a symbol.
+func (s *store) HasSymbolData(ctx context.Context, uploadID int) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.hasSymbolData.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	exists, _, err := basestore.ScanFirstBool(s.db.Query(ctx, sqlf.Sprintf(
+		hasSymbolDataQuery,
+		pq.Array([]int{uploadID}),
+	)))
+	return exists, err
+}
+
+const hasSymbolDataQuery = `
+WITH RECURSIVE
+` + layersCTE + `
 SELECT EXISTS (
 	SELECT 1
-	FROM codeintel_scip_document_lookup sid
-	WHERE
-		sid.upload_id = %s AND
-		sid.document_path = %s
+	FROM layers l
+	JOIN codeintel_scip_symbols ss ON ss.upload_id = l.layer_id
 )
 `
 
@@ -49,7 +68,7 @@ func (s *store) GetStencil(ctx context.Context, bundleID int, path string) (_ []
 
 	documentData, exists, err := s.scanFirstDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		stencilQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		path,
 	)))
 	if err != nil || !exists {
@@ -73,10 +92,7 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
 
 // GetRanges returns definition, reference, implementation, and hover data for each range within the given span of lines.
@@ -91,7 +107,7 @@ func (s *store) GetRanges(ctx context.Context, bundleID int, path string, startL
 
 	documentData, exists, err := s.scanFirstDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		rangesDocumentQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		path,
 	)))
 	if err != nil || !exists {
@@ -125,10 +141,7 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
 
 func convertSCIPRangesToLocations(ranges []*scip.Range, dumpID int, path string) []shared.Location {
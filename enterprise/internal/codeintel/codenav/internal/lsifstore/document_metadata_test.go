@@ -7,8 +7,12 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	codeintelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
 func TestDatabaseExists(t *testing.T) {
@@ -34,6 +38,61 @@ func TestDatabaseExists(t *testing.T) {
 	}
 }
 
+func TestHasSymbolData(t *testing.T) {
+	store := populateTestStore(t)
+
+	for uploadID, expected := range map[int]bool{testSCIPUploadID: true, testSCIPUploadID + 1: false} {
+		if exists, err := store.HasSymbolData(context.Background(), uploadID); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		} else if exists != expected {
+			t.Errorf("unexpected symbol data result for upload %d. want=%v have=%v", uploadID, expected, exists)
+		}
+	}
+}
+
+func TestDatabaseExistsLayered(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, codeIntelDB)
+	loadTestFile(t, codeIntelDB, "./testdata/code-intel-extensions@7802976b.sql")
+
+	// Layer a partial upload on top of the test upload that adds a document
+	// and deletes util.ts.
+	layeredUploadID := testSCIPUploadID + 1
+	for _, query := range []string{
+		fmt.Sprintf(`INSERT INTO codeintel_scip_layers (upload_id, base_upload_id, depth) VALUES (%d, %d, 1)`, layeredUploadID, testSCIPUploadID),
+		fmt.Sprintf(`INSERT INTO codeintel_scip_document_tombstones (upload_id, document_path) VALUES (%d, 'template/src/lsif/util.ts')`, layeredUploadID),
+		fmt.Sprintf(`
+			INSERT INTO codeintel_scip_document_lookup (upload_id, document_path, document_id)
+			SELECT %d, 'template/src/lsif/added.ts', document_id
+			FROM codeintel_scip_document_lookup
+			WHERE upload_id = %d AND document_path = 'template/src/lsif/api.ts'
+		`, layeredUploadID, testSCIPUploadID),
+	} {
+		if _, err := codeIntelDB.ExecContext(context.Background(), query); err != nil {
+			t.Fatalf("unexpected error inserting layer: %s", err)
+		}
+	}
+
+	testCases := []struct {
+		path     string
+		expected bool
+	}{
+		{"template/src/lsif/api.ts", true},
+		{"template/src/lsif/added.ts", true},
+		{"template/src/lsif/util.ts", false},
+		{"missing.ts", false},
+	}
+
+	for _, testCase := range testCases {
+		if exists, err := store.GetPathExists(context.Background(), layeredUploadID, testCase.path); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		} else if exists != testCase.expected {
+			t.Errorf("unexpected exists result for %s. want=%v have=%v", testCase.path, testCase.expected, exists)
+		}
+	}
+}
+
 func TestStencil(t *testing.T) {
 	testCases := []struct {
 		name           string
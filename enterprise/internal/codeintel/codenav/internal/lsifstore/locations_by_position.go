@@ -61,8 +61,8 @@ func (s *store) GetBulkMonikerLocations(ctx context.Context, tableName string, u
 
 	query := sqlf.Sprintf(
 		bulkMonikerResultsQuery,
-		pq.Array(symbolNames),
 		pq.Array(uploadIDs),
+		pq.Array(symbolNames),
 		sqlf.Sprintf(fmt.Sprintf("%s_ranges", strings.TrimSuffix(tableName, "s"))),
 	)
 
@@ -111,17 +111,20 @@ outer:
 
 const bulkMonikerResultsQuery = `
 WITH RECURSIVE
+` + layersCTE + `,
 ` + symbolIDsCTEs + `
 SELECT
-	ss.upload_id,
+	l.upload_id,
 	'scip',
 	msn.symbol_name,
 	%s,
-	document_path
-FROM matching_symbol_names msn
+	sid.document_path
+FROM layers l
+JOIN matching_symbol_names msn ON msn.upload_id = l.layer_id
 JOIN codeintel_scip_symbols ss ON ss.upload_id = msn.upload_id AND ss.symbol_id = msn.id
-JOIN codeintel_scip_document_lookup dl ON dl.id = ss.document_lookup_id
-ORDER BY ss.upload_id, msn.symbol_name
+JOIN codeintel_scip_document_lookup sid ON sid.id = ss.document_lookup_id
+WHERE ` + visibleDocumentCondition + `
+ORDER BY l.upload_id, msn.symbol_name
 `
 
 func (s *store) getLocations(
@@ -143,7 +146,7 @@ func (s *store) getLocations(
 
 	documentData, exists, err := s.scanFirstDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		locationsDocumentQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		path,
 	)))
 	if err != nil || !exists {
@@ -163,10 +166,9 @@ func (s *store) getLocations(
 		if occurrence.Symbol != "" && !scip.IsLocalSymbol(occurrence.Symbol) {
 			monikerLocations, err := s.scanQualifiedMonikerLocations(s.db.Query(ctx, sqlf.Sprintf(
 				locationsSymbolSearchQuery,
-				pq.Array([]string{occurrence.Symbol}),
 				pq.Array([]int{bundleID}),
+				pq.Array([]string{occurrence.Symbol}),
 				sqlf.Sprintf(scipFieldName),
-				bundleID,
 				path,
 				sqlf.Sprintf(scipFieldName),
 			)))
@@ -211,28 +213,27 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
 
 const locationsSymbolSearchQuery = `
 WITH RECURSIVE
+` + layersCTE + `,
 ` + symbolIDsCTEs + `
 SELECT
-	ss.upload_id,
+	l.upload_id,
 	'' AS scheme,
 	'' AS identifier,
 	ss.%s,
 	sid.document_path
-FROM codeintel_scip_symbols ss
+FROM layers l
+JOIN matching_symbol_names msn ON msn.upload_id = l.layer_id
+JOIN codeintel_scip_symbols ss ON ss.upload_id = msn.upload_id AND ss.symbol_id = msn.id
 JOIN codeintel_scip_document_lookup sid ON sid.id = ss.document_lookup_id
-JOIN matching_symbol_names msn ON msn.id = ss.symbol_id
 WHERE
-	ss.upload_id = %s AND
 	sid.document_path != %s AND
-	ss.%s IS NOT NULL
+	ss.%s IS NOT NULL AND
+	` + visibleDocumentCondition + `
 `
 
 type extractedOccurrenceData struct {
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
@@ -39,15 +40,73 @@ func (s *store) SCIPDocument(ctx context.Context, id int, path string) (_ *scip.
 		}
 		return &document, nil
 	})
-	doc, _, err := scanner(s.db.Query(ctx, sqlf.Sprintf(fetchSCIPDocumentQuery, id, path)))
+	doc, _, err := scanner(s.db.Query(ctx, sqlf.Sprintf(fetchSCIPDocumentQuery, pq.Array([]int{id}), path)))
 	return doc, err
 }
 
+// layersCTE and visibleDocumentCondition resolve the documents of partial uploads through the
+// uploads they are layered on top of. See the definitions in the uploads shared package.
+const (
+	layersCTE                = shared.SCIPLayersCTE
+	visibleDocumentCondition = shared.SCIPVisibleDocumentCondition
+)
+
+// documentLookupIDFragment selects the identifier of the document lookup row providing the document
+// with the given path to the given uploads, or NULL if the uploads have no such document.
+const documentLookupIDFragment = `(
+	WITH RECURSIVE
+	` + layersCTE + `
+	SELECT sid.id
+	FROM layers l
+	JOIN codeintel_scip_document_lookup sid ON sid.upload_id = l.layer_id
+	WHERE
+		sid.document_path = %s AND
+		` + visibleDocumentCondition + `
+	ORDER BY l.depth
+	LIMIT 1
+)`
+
 const fetchSCIPDocumentQuery = `
 SELECT sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
+WHERE sid.id = ` + documentLookupIDFragment + `
+`
+
+// scanDocuments invokes the given function with the path and payload of each document of the given
+// upload, in path order. Documents are decoded one at a time, so the complete index of the upload is
+// never held in memory.
+func (s *store) scanDocuments(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) (err error) {
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(scanDocumentsQuery, pq.Array([]int{uploadID})))
+	if err != nil {
+		return err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		record, err := s.scanSingleDocumentDataObject(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := f(record.Path, record.SCIPData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const scanDocumentsQuery = `
+WITH RECURSIVE
+` + layersCTE + `
+SELECT
+	l.upload_id,
+	sid.document_path,
+	sd.raw_scip_payload
+FROM layers l
+JOIN codeintel_scip_document_lookup sid ON sid.upload_id = l.layer_id
+JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
+WHERE ` + visibleDocumentCondition + `
+ORDER BY sid.document_path
 `
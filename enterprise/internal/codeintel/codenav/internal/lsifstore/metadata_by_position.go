@@ -26,7 +26,7 @@ func (s *store) GetHover(ctx context.Context, bundleID int, path string, line, c
 
 	documentData, exists, err := s.scanFirstDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		hoverDocumentQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		path,
 	)))
 	if err != nil || !exists {
@@ -72,9 +72,8 @@ func (s *store) GetHover(ctx context.Context, bundleID int, path string, line, c
 
 	documents, err := s.scanDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		hoverSymbolsQuery,
-		pq.Array(symbolNames),
 		pq.Array([]int{bundleID}),
-		bundleID,
+		pq.Array(symbolNames),
 	)))
 	if err != nil {
 		return "", shared.Range{}, false, err
@@ -109,16 +108,15 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
 
+// symbolIDsCTEs must follow layersCTE, as the tries of every layer of the given uploads are searched.
 const symbolIDsCTEs = `
 -- Search for the set of trie paths that match one of the given search terms. We
--- do a recursive walk starting at the roots of the trie for a given set of uploads,
--- and only traverse down trie paths that continue to match our search text.
+-- do a recursive walk starting at the roots of the trie for each layer of a given
+-- set of uploads, and only traverse down trie paths that continue to match our
+-- search text.
 matching_prefixes(upload_id, id, prefix, search) AS (
 	(
 		-- Base case: Select roots of the tries for this upload that are also a
@@ -134,7 +132,7 @@ matching_prefixes(upload_id, id, prefix, search) AS (
 		FROM codeintel_scip_symbol_names ssn
 		JOIN unnest(%s::text[]) AS t(name) ON t.name LIKE ssn.name_segment || '%%'
 		WHERE
-			ssn.upload_id = ANY(%s) AND
+			ssn.upload_id IN (SELECT layer_id FROM layers) AND
 			ssn.prefix_id IS NULL AND
 			t.name LIKE ssn.name_segment || '%%'
 	) UNION (
@@ -172,22 +170,26 @@ matching_symbol_names AS (
 
 const hoverSymbolsQuery = `
 WITH RECURSIVE
+` + layersCTE + `,
 ` + symbolIDsCTEs + `
 SELECT
 	sd.id,
 	sid.document_path,
 	sd.raw_scip_payload
-FROM codeintel_scip_document_lookup sid
+FROM layers l
+JOIN codeintel_scip_document_lookup sid ON sid.upload_id = l.layer_id
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE EXISTS (
-	SELECT 1
-	FROM codeintel_scip_symbols ss
-	WHERE
-		ss.upload_id = %s AND
-		ss.symbol_id IN (SELECT id FROM matching_symbol_names) AND
-		ss.document_lookup_id = sid.id AND
-		ss.definition_ranges IS NOT NULL
-)
+WHERE
+	EXISTS (
+		SELECT 1
+		FROM codeintel_scip_symbols ss
+		JOIN matching_symbol_names msn ON msn.upload_id = ss.upload_id AND msn.id = ss.symbol_id
+		WHERE
+			ss.upload_id = l.layer_id AND
+			ss.document_lookup_id = sid.id AND
+			ss.definition_ranges IS NOT NULL
+	) AND
+	` + visibleDocumentCondition + `
 `
 
 // GetDiagnostics returns the diagnostics for the documents that have the given path prefix. This method
@@ -203,7 +205,7 @@ func (s *store) GetDiagnostics(ctx context.Context, bundleID int, prefix string,
 
 	documentData, err := s.scanDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		diagnosticsQuery,
-		bundleID,
+		pq.Array([]int{bundleID}),
 		prefix+"%",
 	)))
 	if err != nil {
@@ -264,8 +266,5 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
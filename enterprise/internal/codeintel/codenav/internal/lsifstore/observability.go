@@ -21,6 +21,10 @@ type operations struct {
 	getHover                   *observation.Operation
 	getDiagnostics             *observation.Operation
 	scipDocument               *observation.Operation
+	getUnreferencedDefinitions *observation.Operation
+	getReferencedSymbolNames   *observation.Operation
+	getDefinedSymbols          *observation.Operation
+	hasSymbolData              *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -57,5 +61,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getHover:                   op("GetHover"),
 		getDiagnostics:             op("GetDiagnostics"),
 		scipDocument:               op("SCIPDocument"),
+		getUnreferencedDefinitions: op("GetUnreferencedDefinitions"),
+		getReferencedSymbolNames:   op("GetReferencedSymbolNames"),
+		getDefinedSymbols:          op("GetDefinedSymbols"),
+		hasSymbolData:              op("HasSymbolData"),
 	}
 }
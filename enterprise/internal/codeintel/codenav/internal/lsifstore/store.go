@@ -33,6 +33,16 @@ type LsifStore interface {
 	GetHover(ctx context.Context, bundleID int, path string, line, character int) (string, shared.Range, bool, error)
 	GetDiagnostics(ctx context.Context, bundleID int, prefix string, limit, offset int) ([]shared.Diagnostic, int, error)
 	SCIPDocument(ctx context.Context, id int, path string) (_ *scip.Document, err error)
+
+	// Dead code
+	GetUnreferencedDefinitions(ctx context.Context, uploadID int) (_ []shared.DeadCodeDefinition, numDefinitions int, err error)
+	GetReferencedSymbolNames(ctx context.Context, uploadIDs []int, symbolNames []string) ([]string, error)
+
+	// API diffs
+	GetDefinedSymbols(ctx context.Context, uploadID int) ([]shared.DefinedSymbol, error)
+
+	// Symbol data
+	HasSymbolData(ctx context.Context, uploadID int) (bool, error)
 }
 
 type store struct {
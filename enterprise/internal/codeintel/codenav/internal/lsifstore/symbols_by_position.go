@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -29,7 +30,7 @@ func (s *store) GetMonikersByPosition(ctx context.Context, uploadID int, path st
 
 	documentData, exists, err := s.scanFirstDocumentData(s.db.Query(ctx, sqlf.Sprintf(
 		monikersDocumentQuery,
-		uploadID,
+		pq.Array([]int{uploadID}),
 		path,
 	)))
 	if err != nil || !exists {
@@ -100,10 +101,7 @@ SELECT
 	sd.raw_scip_payload
 FROM codeintel_scip_document_lookup sid
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE
-	sid.upload_id = %s AND
-	sid.document_path = %s
-LIMIT 1
+WHERE sid.id = ` + documentLookupIDFragment + `
 `
 
 // GetPackageInformation returns package information data by identifier.
@@ -0,0 +1,293 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// GetUploadsForDeadCodeReport returns the identifiers of completed uploads visible at the tip of the
+// default branch of their repository that have no dead code report, or whose report was computed
+// before the given time. Uploads without a report are returned first.
+func (s *store) GetUploadsForDeadCodeReport(ctx context.Context, batchSize int, recomputeBefore time.Time) (_ []int, err error) {
+	ctx, _, endObservation := s.operations.getUploadsForDeadCodeReport.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchSize", batchSize),
+		attribute.String("recomputeBefore", recomputeBefore.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanInts(s.db.Query(ctx, sqlf.Sprintf(getUploadsForDeadCodeReportQuery, recomputeBefore, batchSize)))
+}
+
+const getUploadsForDeadCodeReportQuery = `
+SELECT u.id
+FROM lsif_uploads u
+LEFT JOIN codeintel_dead_code_reports r ON r.upload_id = u.id
+WHERE
+	u.state = 'completed' AND
+	EXISTS (
+		SELECT 1
+		FROM lsif_uploads_visible_at_tip vt
+		WHERE
+			vt.upload_id = u.id AND
+			vt.is_default_branch
+	) AND
+	(r.upload_id IS NULL OR r.computed_at < %s)
+ORDER BY r.computed_at NULLS FIRST, u.id
+LIMIT %s
+`
+
+// GetUploadPackages returns the packages provided by the given upload.
+func (s *store) GetUploadPackages(ctx context.Context, uploadID int) (_ []precise.Package, err error) {
+	ctx, _, endObservation := s.operations.getUploadPackages.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanPackages(s.db.Query(ctx, sqlf.Sprintf(getUploadPackagesQuery, uploadID)))
+}
+
+const getUploadPackagesQuery = `
+SELECT p.scheme, p.manager, p.name, p.version
+FROM lsif_packages p
+WHERE p.dump_id = %s
+ORDER BY p.scheme, p.manager, p.name, p.version
+`
+
+var scanPackages = basestore.NewSliceScanner(func(s dbutil.Scanner) (pkg precise.Package, err error) {
+	err = s.Scan(&pkg.Scheme, &pkg.Manager, &pkg.Name, &dbutil.NullString{S: &pkg.Version})
+	return pkg, err
+})
+
+// GetReferencingUploadIDs returns the identifiers of the uploads visible at the tip of the default
+// branch of their repository that reference a package provided by the given upload.
+func (s *store) GetReferencingUploadIDs(ctx context.Context, uploadID int) (_ []int, err error) {
+	ctx, _, endObservation := s.operations.getReferencingUploadIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanInts(s.db.Query(ctx, sqlf.Sprintf(getReferencingUploadIDsQuery, uploadID)))
+}
+
+const getReferencingUploadIDsQuery = `
+SELECT DISTINCT r.dump_id
+FROM lsif_packages p
+JOIN lsif_references r ON
+	r.scheme = p.scheme AND
+	r.manager = p.manager AND
+	r.name = p.name AND
+	r.version = p.version AND
+	r.dump_id != p.dump_id
+WHERE
+	p.dump_id = %s AND
+	EXISTS (
+		SELECT 1
+		FROM lsif_uploads_visible_at_tip vt
+		WHERE
+			vt.upload_id = r.dump_id AND
+			vt.is_default_branch
+	)
+ORDER BY r.dump_id
+`
+
+// InsertDeadCodeReport replaces the dead code report of the given upload.
+func (s *store) InsertDeadCodeReport(ctx context.Context, uploadID, numDefinitions int, definitions []shared.DeadCodeDefinition) (err error) {
+	ctx, _, endObservation := s.operations.insertDeadCodeReport.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.Int("numDefinitions", numDefinitions),
+		attribute.Int("numDeadCodeDefinitions", len(definitions)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		if err := tx.Exec(ctx, sqlf.Sprintf(insertDeadCodeReportQuery, uploadID, numDefinitions)); err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(insertDeadCodeReportDeleteDefinitionsQuery, uploadID)); err != nil {
+			return err
+		}
+
+		return batch.WithInserter(
+			ctx,
+			tx.Handle(),
+			"codeintel_dead_code_definitions",
+			batch.MaxNumPostgresParameters,
+			[]string{
+				"upload_id",
+				"symbol_name",
+				"document_path",
+				"start_line",
+				"start_character",
+				"end_line",
+				"end_character",
+				"exported",
+			},
+			func(inserter *batch.Inserter) error {
+				for _, definition := range definitions {
+					if err := inserter.Insert(
+						ctx,
+						uploadID,
+						definition.SymbolName,
+						definition.DocumentPath,
+						definition.Range.Start.Line,
+						definition.Range.Start.Character,
+						definition.Range.End.Line,
+						definition.Range.End.Character,
+						definition.Exported,
+					); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		)
+	})
+}
+
+const insertDeadCodeReportQuery = `
+INSERT INTO codeintel_dead_code_reports (upload_id, computed_at, num_definitions)
+VALUES (%s, NOW(), %s)
+ON CONFLICT (upload_id) DO UPDATE SET
+	computed_at = EXCLUDED.computed_at,
+	num_definitions = EXCLUDED.num_definitions
+`
+
+const insertDeadCodeReportDeleteDefinitionsQuery = `
+DELETE FROM codeintel_dead_code_definitions WHERE upload_id = %s
+`
+
+// DeleteStaleDeadCodeReports deletes the dead code reports of uploads that are no longer visible at
+// the tip of the default branch of their repository. This method returns the number of deleted reports.
+func (s *store) DeleteStaleDeadCodeReports(ctx context.Context) (_ int, err error) {
+	ctx, _, endObservation := s.operations.deleteStaleDeadCodeReports.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(deleteStaleDeadCodeReportsQuery)))
+	return count, err
+}
+
+const deleteStaleDeadCodeReportsQuery = `
+WITH deleted AS (
+	DELETE FROM codeintel_dead_code_reports r
+	WHERE NOT EXISTS (
+		SELECT 1
+		FROM lsif_uploads_visible_at_tip vt
+		WHERE
+			vt.upload_id = r.upload_id AND
+			vt.is_default_branch
+	)
+	RETURNING 1
+)
+SELECT COUNT(*) FROM deleted
+`
+
+// GetDeadCodeReport returns the dead code report of the given upload.
+func (s *store) GetDeadCodeReport(ctx context.Context, uploadID int) (_ shared.DeadCodeReport, _ bool, err error) {
+	ctx, _, endObservation := s.operations.getDeadCodeReport.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstDeadCodeReport(s.db.Query(ctx, sqlf.Sprintf(getDeadCodeReportQuery, uploadID)))
+}
+
+const getDeadCodeReportQuery = `
+SELECT r.upload_id, r.computed_at, r.num_definitions
+FROM codeintel_dead_code_reports r
+WHERE r.upload_id = %s
+`
+
+var scanFirstDeadCodeReport = basestore.NewFirstScanner(func(s dbutil.Scanner) (report shared.DeadCodeReport, err error) {
+	err = s.Scan(&report.UploadID, &report.ComputedAt, &report.NumDefinitions)
+	return report, err
+})
+
+// GetDeadCodeDefinitions returns the unreferenced definitions of the given upload within documents
+// having the given path prefix. This method also returns the size of the complete result set to aid
+// in pagination.
+func (s *store) GetDeadCodeDefinitions(ctx context.Context, args shared.GetDeadCodeDefinitionsArgs) (_ []shared.DeadCodeDefinition, _ int, err error) {
+	ctx, _, endObservation := s.operations.getDeadCodeDefinitions.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", args.UploadID),
+		attribute.String("pathPrefix", args.PathPrefix),
+		attribute.Int("limit", args.Limit),
+		attribute.Int("offset", args.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := []*sqlf.Query{
+		sqlf.Sprintf("d.upload_id = %s", args.UploadID),
+	}
+	if args.PathPrefix != "" {
+		// starts_with compares the prefix literally, unlike LIKE which would treat any % or _
+		// in the path as a wildcard
+		conds = append(conds, sqlf.Sprintf("starts_with(d.document_path, %s)", args.PathPrefix))
+	}
+	if args.Exported != nil {
+		conds = append(conds, sqlf.Sprintf("d.exported = %s", *args.Exported))
+	}
+
+	totalCount, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(getDeadCodeDefinitionsCountQuery, sqlf.Join(conds, " AND "))))
+	if err != nil || totalCount == 0 || args.Limit == 0 {
+		return nil, totalCount, err
+	}
+
+	definitions, err := scanDeadCodeDefinitions(s.db.Query(ctx, sqlf.Sprintf(
+		getDeadCodeDefinitionsQuery,
+		sqlf.Join(conds, " AND "),
+		args.Limit,
+		args.Offset,
+	)))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return definitions, totalCount, nil
+}
+
+const getDeadCodeDefinitionsCountQuery = `
+SELECT COUNT(*)
+FROM codeintel_dead_code_definitions d
+WHERE %s
+`
+
+const getDeadCodeDefinitionsQuery = `
+SELECT
+	d.upload_id,
+	d.symbol_name,
+	d.document_path,
+	d.start_line,
+	d.start_character,
+	d.end_line,
+	d.end_character,
+	d.exported
+FROM codeintel_dead_code_definitions d
+WHERE %s
+ORDER BY d.document_path, d.start_line, d.start_character, d.id
+LIMIT %s
+OFFSET %s
+`
+
+var scanDeadCodeDefinitions = basestore.NewSliceScanner(func(s dbutil.Scanner) (definition shared.DeadCodeDefinition, err error) {
+	err = s.Scan(
+		&definition.UploadID,
+		&definition.SymbolName,
+		&definition.DocumentPath,
+		&definition.Range.Start.Line,
+		&definition.Range.Start.Character,
+		&definition.Range.End.Line,
+		&definition.Range.End.Character,
+		&definition.Exported,
+	)
+	return definition, err
+})
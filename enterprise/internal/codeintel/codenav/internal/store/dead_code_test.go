@@ -0,0 +1,190 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestGetUploadsForDeadCodeReport(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupDeadCodeUploads(t, db)
+
+	if err := store.InsertDeadCodeReport(ctx, 100, 0, nil); err != nil {
+		t.Fatalf("unexpected error inserting dead code report: %s", err)
+	}
+
+	uploadIDs, err := store.GetUploadsForDeadCodeReport(ctx, 10, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error getting uploads for dead code report: %s", err)
+	}
+	if diff := cmp.Diff([]int{102, 103}, uploadIDs); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+
+	uploadIDs, err = store.GetUploadsForDeadCodeReport(ctx, 10, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error getting uploads for dead code report: %s", err)
+	}
+	if diff := cmp.Diff([]int{102, 103, 100}, uploadIDs); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetReferencingUploadIDs(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupDeadCodeUploads(t, db)
+
+	packages, err := store.GetUploadPackages(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting upload packages: %s", err)
+	}
+	expectedPackages := []precise.Package{
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/foo", Version: "v1.0.0"},
+	}
+	if diff := cmp.Diff(expectedPackages, packages); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+
+	uploadIDs, err := store.GetReferencingUploadIDs(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting referencing upload ids: %s", err)
+	}
+	// 101 is not visible at the tip of the default branch; 103 references another version
+	if diff := cmp.Diff([]int{102}, uploadIDs); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeadCodeDefinitions(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupDeadCodeUploads(t, db)
+
+	definitions := []shared.DeadCodeDefinition{
+		{UploadID: 100, SymbolName: "a", DocumentPath: "cmd/main.go", Range: testRange(3), Exported: false},
+		{UploadID: 100, SymbolName: "b", DocumentPath: "lib/b.go", Range: testRange(5), Exported: true},
+		{UploadID: 100, SymbolName: "c", DocumentPath: "lib/a.go", Range: testRange(1), Exported: true},
+	}
+	if err := store.InsertDeadCodeReport(ctx, 100, 10, definitions[:1]); err != nil {
+		t.Fatalf("unexpected error inserting dead code report: %s", err)
+	}
+	// Replaces the previous report
+	if err := store.InsertDeadCodeReport(ctx, 100, 20, definitions); err != nil {
+		t.Fatalf("unexpected error inserting dead code report: %s", err)
+	}
+
+	report, ok, err := store.GetDeadCodeReport(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting dead code report: %s", err)
+	}
+	if !ok {
+		t.Fatalf("expected dead code report to exist")
+	}
+	if report.NumDefinitions != 20 {
+		t.Errorf("unexpected number of definitions. want=%d have=%d", 20, report.NumDefinitions)
+	}
+
+	testCases := []struct {
+		pathPrefix         string
+		exported           *bool
+		limit              int
+		offset             int
+		expectedTotalCount int
+		expected           []shared.DeadCodeDefinition
+	}{
+		{limit: 10, expectedTotalCount: 3, expected: []shared.DeadCodeDefinition{definitions[0], definitions[2], definitions[1]}},
+		{limit: 1, offset: 1, expectedTotalCount: 3, expected: []shared.DeadCodeDefinition{definitions[2]}},
+		{pathPrefix: "lib/", limit: 10, expectedTotalCount: 2, expected: []shared.DeadCodeDefinition{definitions[2], definitions[1]}},
+		{pathPrefix: "li_/", limit: 10, expectedTotalCount: 0, expected: nil},
+		{pathPrefix: "%", limit: 10, expectedTotalCount: 0, expected: nil},
+		{exported: pointers.Ptr(false), limit: 10, expectedTotalCount: 1, expected: []shared.DeadCodeDefinition{definitions[0]}},
+		{pathPrefix: "cmd/", exported: pointers.Ptr(true), limit: 10, expectedTotalCount: 0, expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		definitions, totalCount, err := store.GetDeadCodeDefinitions(ctx, shared.GetDeadCodeDefinitionsArgs{
+			UploadID:   100,
+			PathPrefix: testCase.pathPrefix,
+			Exported:   testCase.exported,
+			Limit:      testCase.limit,
+			Offset:     testCase.offset,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error getting dead code definitions: %s", err)
+		}
+		if totalCount != testCase.expectedTotalCount {
+			t.Errorf("unexpected total count. want=%d have=%d", testCase.expectedTotalCount, totalCount)
+		}
+		if diff := cmp.Diff(testCase.expected, definitions); diff != "" {
+			t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+		}
+	}
+
+	// Upload 100 is no longer visible at the tip of the default branch
+	if _, err := db.ExecContext(ctx, `DELETE FROM lsif_uploads_visible_at_tip WHERE upload_id = 100`); err != nil {
+		t.Fatalf("unexpected error updating visibility: %s", err)
+	}
+	if count, err := store.DeleteStaleDeadCodeReports(ctx); err != nil {
+		t.Fatalf("unexpected error deleting stale dead code reports: %s", err)
+	} else if count != 1 {
+		t.Errorf("unexpected number of deleted reports. want=%d have=%d", 1, count)
+	}
+	if _, totalCount, err := store.GetDeadCodeDefinitions(ctx, shared.GetDeadCodeDefinitionsArgs{UploadID: 100, Limit: 10}); err != nil {
+		t.Fatalf("unexpected error getting dead code definitions: %s", err)
+	} else if totalCount != 0 {
+		t.Errorf("unexpected total count. want=%d have=%d", 0, totalCount)
+	}
+}
+
+func setupDeadCodeUploads(t *testing.T, db database.DB) {
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO repo (id, name, deleted_at) VALUES (50, 'foo', NULL);
+		INSERT INTO repo (id, name, deleted_at) VALUES (51, 'bar', NULL);
+		INSERT INTO repo (id, name, deleted_at) VALUES (52, 'baz', NULL);
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (100, 50, '0000000000000000000000000000000000000001', 'scip-go', 1, '{}', 'completed');
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (101, 51, '0000000000000000000000000000000000000002', 'scip-go', 1, '{}', 'completed');
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (102, 51, '0000000000000000000000000000000000000003', 'scip-go', 1, '{}', 'completed');
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (103, 52, '0000000000000000000000000000000000000004', 'scip-go', 1, '{}', 'completed');
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (104, 52, '0000000000000000000000000000000000000005', 'scip-go', 1, '{}', 'errored');
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES (100, 50, true);
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES (101, 51, false);
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES (102, 51, true);
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES (103, 52, true);
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES (104, 52, true);
+		INSERT INTO lsif_packages (dump_id, scheme, manager, name, version) VALUES (100, 'scip-go', 'gomod', 'github.com/sourcegraph/foo', 'v1.0.0');
+		INSERT INTO lsif_references (dump_id, scheme, manager, name, version) VALUES (101, 'scip-go', 'gomod', 'github.com/sourcegraph/foo', 'v1.0.0');
+		INSERT INTO lsif_references (dump_id, scheme, manager, name, version) VALUES (102, 'scip-go', 'gomod', 'github.com/sourcegraph/foo', 'v1.0.0');
+		INSERT INTO lsif_references (dump_id, scheme, manager, name, version) VALUES (103, 'scip-go', 'gomod', 'github.com/sourcegraph/foo', 'v0.9.0');
+	`); err != nil {
+		t.Fatalf("unexpected error setting up test: %s", err)
+	}
+}
+
+func testRange(line int) shared.Range {
+	return shared.Range{
+		Start: shared.Position{Line: line, Character: 5},
+		End:   shared.Position{Line: line, Character: 10},
+	}
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type operations struct {
+	getUploadsForDeadCodeReport *observation.Operation
+	getUploadPackages           *observation.Operation
+	getReferencingUploadIDs     *observation.Operation
+	insertDeadCodeReport        *observation.Operation
+	deleteStaleDeadCodeReports  *observation.Operation
+	getDeadCodeReport           *observation.Operation
+	getDeadCodeDefinitions      *observation.Operation
+}
+
+var m = new(metrics.SingletonREDMetrics)
+
+func newOperations(observationCtx *observation.Context) *operations {
+	m := m.Get(func() *metrics.REDMetrics {
+		return metrics.NewREDMetrics(
+			observationCtx.Registerer,
+			"codeintel_codenav_store",
+			metrics.WithLabels("op"),
+			metrics.WithCountHelp("Total number of method invocations."),
+		)
+	})
+
+	op := func(name string) *observation.Operation {
+		return observationCtx.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.codenav.store.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           m,
+		})
+	}
+
+	return &operations{
+		getUploadsForDeadCodeReport: op("GetUploadsForDeadCodeReport"),
+		getUploadPackages:           op("GetUploadPackages"),
+		getReferencingUploadIDs:     op("GetReferencingUploadIDs"),
+		insertDeadCodeReport:        op("InsertDeadCodeReport"),
+		deleteStaleDeadCodeReports:  op("DeleteStaleDeadCodeReports"),
+		getDeadCodeReport:           op("GetDeadCodeReport"),
+		getDeadCodeDefinitions:      op("GetDeadCodeDefinitions"),
+	}
+}
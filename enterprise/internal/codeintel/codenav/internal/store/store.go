@@ -0,0 +1,39 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+type Store interface {
+	// Dead code
+	GetUploadsForDeadCodeReport(ctx context.Context, batchSize int, recomputeBefore time.Time) ([]int, error)
+	GetUploadPackages(ctx context.Context, uploadID int) ([]precise.Package, error)
+	GetReferencingUploadIDs(ctx context.Context, uploadID int) ([]int, error)
+	InsertDeadCodeReport(ctx context.Context, uploadID, numDefinitions int, definitions []shared.DeadCodeDefinition) error
+	DeleteStaleDeadCodeReports(ctx context.Context) (int, error)
+	GetDeadCodeReport(ctx context.Context, uploadID int) (shared.DeadCodeReport, bool, error)
+	GetDeadCodeDefinitions(ctx context.Context, args shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error)
+}
+
+type store struct {
+	db         *basestore.Store
+	logger     logger.Logger
+	operations *operations
+}
+
+func New(observationCtx *observation.Context, db database.DB) Store {
+	return &store{
+		db:         basestore.NewWithHandle(db.Handle()),
+		logger:     logger.Scoped("codenav.store", ""),
+		operations: newOperations(observationCtx),
+	}
+}
@@ -9,9 +9,11 @@ package codenav
 import (
 	"context"
 	"sync"
+	"time"
 
 	scip "github.com/sourcegraph/scip/bindings/go/scip"
 	lsifstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store"
 	shared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
 	shared1 "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	precise "github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
@@ -25,6 +27,9 @@ type MockLsifStore struct {
 	// GetBulkMonikerLocationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetBulkMonikerLocations.
 	GetBulkMonikerLocationsFunc *LsifStoreGetBulkMonikerLocationsFunc
+	// GetDefinedSymbolsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDefinedSymbols.
+	GetDefinedSymbolsFunc *LsifStoreGetDefinedSymbolsFunc
 	// GetDefinitionLocationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDefinitionLocations.
 	GetDefinitionLocationsFunc *LsifStoreGetDefinitionLocationsFunc
@@ -56,9 +61,19 @@ type MockLsifStore struct {
 	// GetReferenceLocationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetReferenceLocations.
 	GetReferenceLocationsFunc *LsifStoreGetReferenceLocationsFunc
+	// GetReferencedSymbolNamesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetReferencedSymbolNames.
+	GetReferencedSymbolNamesFunc *LsifStoreGetReferencedSymbolNamesFunc
 	// GetStencilFunc is an instance of a mock function object controlling
 	// the behavior of the method GetStencil.
 	GetStencilFunc *LsifStoreGetStencilFunc
+	// GetUnreferencedDefinitionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUnreferencedDefinitions.
+	GetUnreferencedDefinitionsFunc *LsifStoreGetUnreferencedDefinitionsFunc
+	// HasSymbolDataFunc is an instance of a mock function object controlling
+	// the behavior of the method HasSymbolData.
+	HasSymbolDataFunc *LsifStoreHasSymbolDataFunc
 	// SCIPDocumentFunc is an instance of a mock function object controlling
 	// the behavior of the method SCIPDocument.
 	SCIPDocumentFunc *LsifStoreSCIPDocumentFunc
@@ -73,6 +88,11 @@ func NewMockLsifStore() *MockLsifStore {
 				return
 			},
 		},
+		GetDefinedSymbolsFunc: &LsifStoreGetDefinedSymbolsFunc{
+			defaultHook: func(context.Context, int) (r0 []shared.DefinedSymbol, r1 error) {
+				return
+			},
+		},
 		GetDefinitionLocationsFunc: &LsifStoreGetDefinitionLocationsFunc{
 			defaultHook: func(context.Context, int, string, int, int, int, int) (r0 []shared.Location, r1 int, r2 error) {
 				return
@@ -123,11 +143,26 @@ func NewMockLsifStore() *MockLsifStore {
 				return
 			},
 		},
+		GetReferencedSymbolNamesFunc: &LsifStoreGetReferencedSymbolNamesFunc{
+			defaultHook: func(context.Context, []int, []string) (r0 []string, r1 error) {
+				return
+			},
+		},
 		GetStencilFunc: &LsifStoreGetStencilFunc{
 			defaultHook: func(context.Context, int, string) (r0 []shared.Range, r1 error) {
 				return
 			},
 		},
+		GetUnreferencedDefinitionsFunc: &LsifStoreGetUnreferencedDefinitionsFunc{
+			defaultHook: func(context.Context, int) (r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+				return
+			},
+		},
+		HasSymbolDataFunc: &LsifStoreHasSymbolDataFunc{
+			defaultHook: func(context.Context, int) (r0 bool, r1 error) {
+				return
+			},
+		},
 		SCIPDocumentFunc: &LsifStoreSCIPDocumentFunc{
 			defaultHook: func(context.Context, int, string) (r0 *scip.Document, r1 error) {
 				return
@@ -145,6 +180,11 @@ func NewStrictMockLsifStore() *MockLsifStore {
 				panic("unexpected invocation of MockLsifStore.GetBulkMonikerLocations")
 			},
 		},
+		GetDefinedSymbolsFunc: &LsifStoreGetDefinedSymbolsFunc{
+			defaultHook: func(context.Context, int) ([]shared.DefinedSymbol, error) {
+				panic("unexpected invocation of MockLsifStore.GetDefinedSymbols")
+			},
+		},
 		GetDefinitionLocationsFunc: &LsifStoreGetDefinitionLocationsFunc{
 			defaultHook: func(context.Context, int, string, int, int, int, int) ([]shared.Location, int, error) {
 				panic("unexpected invocation of MockLsifStore.GetDefinitionLocations")
@@ -195,11 +235,26 @@ func NewStrictMockLsifStore() *MockLsifStore {
 				panic("unexpected invocation of MockLsifStore.GetReferenceLocations")
 			},
 		},
+		GetReferencedSymbolNamesFunc: &LsifStoreGetReferencedSymbolNamesFunc{
+			defaultHook: func(context.Context, []int, []string) ([]string, error) {
+				panic("unexpected invocation of MockLsifStore.GetReferencedSymbolNames")
+			},
+		},
 		GetStencilFunc: &LsifStoreGetStencilFunc{
 			defaultHook: func(context.Context, int, string) ([]shared.Range, error) {
 				panic("unexpected invocation of MockLsifStore.GetStencil")
 			},
 		},
+		GetUnreferencedDefinitionsFunc: &LsifStoreGetUnreferencedDefinitionsFunc{
+			defaultHook: func(context.Context, int) ([]shared.DeadCodeDefinition, int, error) {
+				panic("unexpected invocation of MockLsifStore.GetUnreferencedDefinitions")
+			},
+		},
+		HasSymbolDataFunc: &LsifStoreHasSymbolDataFunc{
+			defaultHook: func(context.Context, int) (bool, error) {
+				panic("unexpected invocation of MockLsifStore.HasSymbolData")
+			},
+		},
 		SCIPDocumentFunc: &LsifStoreSCIPDocumentFunc{
 			defaultHook: func(context.Context, int, string) (*scip.Document, error) {
 				panic("unexpected invocation of MockLsifStore.SCIPDocument")
@@ -215,6 +270,9 @@ func NewMockLsifStoreFrom(i lsifstore.LsifStore) *MockLsifStore {
 		GetBulkMonikerLocationsFunc: &LsifStoreGetBulkMonikerLocationsFunc{
 			defaultHook: i.GetBulkMonikerLocations,
 		},
+		GetDefinedSymbolsFunc: &LsifStoreGetDefinedSymbolsFunc{
+			defaultHook: i.GetDefinedSymbols,
+		},
 		GetDefinitionLocationsFunc: &LsifStoreGetDefinitionLocationsFunc{
 			defaultHook: i.GetDefinitionLocations,
 		},
@@ -245,9 +303,18 @@ func NewMockLsifStoreFrom(i lsifstore.LsifStore) *MockLsifStore {
 		GetReferenceLocationsFunc: &LsifStoreGetReferenceLocationsFunc{
 			defaultHook: i.GetReferenceLocations,
 		},
+		GetReferencedSymbolNamesFunc: &LsifStoreGetReferencedSymbolNamesFunc{
+			defaultHook: i.GetReferencedSymbolNames,
+		},
 		GetStencilFunc: &LsifStoreGetStencilFunc{
 			defaultHook: i.GetStencil,
 		},
+		GetUnreferencedDefinitionsFunc: &LsifStoreGetUnreferencedDefinitionsFunc{
+			defaultHook: i.GetUnreferencedDefinitions,
+		},
+		HasSymbolDataFunc: &LsifStoreHasSymbolDataFunc{
+			defaultHook: i.HasSymbolData,
+		},
 		SCIPDocumentFunc: &LsifStoreSCIPDocumentFunc{
 			defaultHook: i.SCIPDocument,
 		},
@@ -380,6 +447,114 @@ func (c LsifStoreGetBulkMonikerLocationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// LsifStoreGetDefinedSymbolsFunc describes the behavior when the
+// GetDefinedSymbols method of the parent MockLsifStore instance is invoked.
+type LsifStoreGetDefinedSymbolsFunc struct {
+	defaultHook func(context.Context, int) ([]shared.DefinedSymbol, error)
+	hooks       []func(context.Context, int) ([]shared.DefinedSymbol, error)
+	history     []LsifStoreGetDefinedSymbolsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDefinedSymbols delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLsifStore) GetDefinedSymbols(v0 context.Context, v1 int) ([]shared.DefinedSymbol, error) {
+	r0, r1 := m.GetDefinedSymbolsFunc.nextHook()(v0, v1)
+	m.GetDefinedSymbolsFunc.appendCall(LsifStoreGetDefinedSymbolsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetDefinedSymbols
+// method of the parent MockLsifStore instance is invoked and the hook queue
+// is empty.
+func (f *LsifStoreGetDefinedSymbolsFunc) SetDefaultHook(hook func(context.Context, int) ([]shared.DefinedSymbol, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDefinedSymbols method of the parent MockLsifStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LsifStoreGetDefinedSymbolsFunc) PushHook(hook func(context.Context, int) ([]shared.DefinedSymbol, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreGetDefinedSymbolsFunc) SetDefaultReturn(r0 []shared.DefinedSymbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]shared.DefinedSymbol, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreGetDefinedSymbolsFunc) PushReturn(r0 []shared.DefinedSymbol, r1 error) {
+	f.PushHook(func(context.Context, int) ([]shared.DefinedSymbol, error) {
+		return r0, r1
+	})
+}
+
+func (f *LsifStoreGetDefinedSymbolsFunc) nextHook() func(context.Context, int) ([]shared.DefinedSymbol, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreGetDefinedSymbolsFunc) appendCall(r0 LsifStoreGetDefinedSymbolsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LsifStoreGetDefinedSymbolsFuncCall objects
+// describing the invocations of this function.
+func (f *LsifStoreGetDefinedSymbolsFunc) History() []LsifStoreGetDefinedSymbolsFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreGetDefinedSymbolsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreGetDefinedSymbolsFuncCall is an object that describes an
+// invocation of method GetDefinedSymbols on an instance of MockLsifStore.
+type LsifStoreGetDefinedSymbolsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.DefinedSymbol
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreGetDefinedSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreGetDefinedSymbolsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // LsifStoreGetDefinitionLocationsFunc describes the behavior when the
 // GetDefinitionLocations method of the parent MockLsifStore instance is
 // invoked.
@@ -1601,6 +1776,120 @@ func (c LsifStoreGetReferenceLocationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// LsifStoreGetReferencedSymbolNamesFunc describes the behavior when the
+// GetReferencedSymbolNames method of the parent MockLsifStore instance is
+// invoked.
+type LsifStoreGetReferencedSymbolNamesFunc struct {
+	defaultHook func(context.Context, []int, []string) ([]string, error)
+	hooks       []func(context.Context, []int, []string) ([]string, error)
+	history     []LsifStoreGetReferencedSymbolNamesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetReferencedSymbolNames delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLsifStore) GetReferencedSymbolNames(v0 context.Context, v1 []int, v2 []string) ([]string, error) {
+	r0, r1 := m.GetReferencedSymbolNamesFunc.nextHook()(v0, v1, v2)
+	m.GetReferencedSymbolNamesFunc.appendCall(LsifStoreGetReferencedSymbolNamesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetReferencedSymbolNames method of the parent MockLsifStore instance is
+// invoked and the hook queue is empty.
+func (f *LsifStoreGetReferencedSymbolNamesFunc) SetDefaultHook(hook func(context.Context, []int, []string) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetReferencedSymbolNames method of the parent MockLsifStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *LsifStoreGetReferencedSymbolNamesFunc) PushHook(hook func(context.Context, []int, []string) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreGetReferencedSymbolNamesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int, []string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreGetReferencedSymbolNamesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, []int, []string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *LsifStoreGetReferencedSymbolNamesFunc) nextHook() func(context.Context, []int, []string) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreGetReferencedSymbolNamesFunc) appendCall(r0 LsifStoreGetReferencedSymbolNamesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LsifStoreGetReferencedSymbolNamesFuncCall
+// objects describing the invocations of this function.
+func (f *LsifStoreGetReferencedSymbolNamesFunc) History() []LsifStoreGetReferencedSymbolNamesFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreGetReferencedSymbolNamesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreGetReferencedSymbolNamesFuncCall is an object that describes an
+// invocation of method GetReferencedSymbolNames on an instance of
+// MockLsifStore.
+type LsifStoreGetReferencedSymbolNamesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreGetReferencedSymbolNamesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreGetReferencedSymbolNamesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // LsifStoreGetStencilFunc describes the behavior when the GetStencil method
 // of the parent MockLsifStore instance is invoked.
 type LsifStoreGetStencilFunc struct {
@@ -1711,35 +2000,37 @@ func (c LsifStoreGetStencilFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LsifStoreSCIPDocumentFunc describes the behavior when the SCIPDocument
-// method of the parent MockLsifStore instance is invoked.
-type LsifStoreSCIPDocumentFunc struct {
-	defaultHook func(context.Context, int, string) (*scip.Document, error)
-	hooks       []func(context.Context, int, string) (*scip.Document, error)
-	history     []LsifStoreSCIPDocumentFuncCall
+// LsifStoreGetUnreferencedDefinitionsFunc describes the behavior when the
+// GetUnreferencedDefinitions method of the parent MockLsifStore instance is
+// invoked.
+type LsifStoreGetUnreferencedDefinitionsFunc struct {
+	defaultHook func(context.Context, int) ([]shared.DeadCodeDefinition, int, error)
+	hooks       []func(context.Context, int) ([]shared.DeadCodeDefinition, int, error)
+	history     []LsifStoreGetUnreferencedDefinitionsFuncCall
 	mutex       sync.Mutex
 }
 
-// SCIPDocument delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLsifStore) SCIPDocument(v0 context.Context, v1 int, v2 string) (*scip.Document, error) {
-	r0, r1 := m.SCIPDocumentFunc.nextHook()(v0, v1, v2)
-	m.SCIPDocumentFunc.appendCall(LsifStoreSCIPDocumentFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// GetUnreferencedDefinitions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockLsifStore) GetUnreferencedDefinitions(v0 context.Context, v1 int) ([]shared.DeadCodeDefinition, int, error) {
+	r0, r1, r2 := m.GetUnreferencedDefinitionsFunc.nextHook()(v0, v1)
+	m.GetUnreferencedDefinitionsFunc.appendCall(LsifStoreGetUnreferencedDefinitionsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the SCIPDocument method
-// of the parent MockLsifStore instance is invoked and the hook queue is
-// empty.
-func (f *LsifStoreSCIPDocumentFunc) SetDefaultHook(hook func(context.Context, int, string) (*scip.Document, error)) {
+// SetDefaultHook sets function that is called when the
+// GetUnreferencedDefinitions method of the parent MockLsifStore instance is
+// invoked and the hook queue is empty.
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) SetDefaultHook(hook func(context.Context, int) ([]shared.DeadCodeDefinition, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SCIPDocument method of the parent MockLsifStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LsifStoreSCIPDocumentFunc) PushHook(hook func(context.Context, int, string) (*scip.Document, error)) {
+// GetUnreferencedDefinitions method of the parent MockLsifStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) PushHook(hook func(context.Context, int) ([]shared.DeadCodeDefinition, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1747,20 +2038,20 @@ func (f *LsifStoreSCIPDocumentFunc) PushHook(hook func(context.Context, int, str
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *LsifStoreSCIPDocumentFunc) SetDefaultReturn(r0 *scip.Document, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (*scip.Document, error) {
-		return r0, r1
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) SetDefaultReturn(r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]shared.DeadCodeDefinition, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *LsifStoreSCIPDocumentFunc) PushReturn(r0 *scip.Document, r1 error) {
-	f.PushHook(func(context.Context, int, string) (*scip.Document, error) {
-		return r0, r1
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) PushReturn(r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int) ([]shared.DeadCodeDefinition, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LsifStoreSCIPDocumentFunc) nextHook() func(context.Context, int, string) (*scip.Document, error) {
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) nextHook() func(context.Context, int) ([]shared.DeadCodeDefinition, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1773,53 +2064,1186 @@ func (f *LsifStoreSCIPDocumentFunc) nextHook() func(context.Context, int, string
 	return hook
 }
 
-func (f *LsifStoreSCIPDocumentFunc) appendCall(r0 LsifStoreSCIPDocumentFuncCall) {
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) appendCall(r0 LsifStoreGetUnreferencedDefinitionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LsifStoreSCIPDocumentFuncCall objects
-// describing the invocations of this function.
-func (f *LsifStoreSCIPDocumentFunc) History() []LsifStoreSCIPDocumentFuncCall {
+// History returns a sequence of LsifStoreGetUnreferencedDefinitionsFuncCall
+// objects describing the invocations of this function.
+func (f *LsifStoreGetUnreferencedDefinitionsFunc) History() []LsifStoreGetUnreferencedDefinitionsFuncCall {
 	f.mutex.Lock()
-	history := make([]LsifStoreSCIPDocumentFuncCall, len(f.history))
+	history := make([]LsifStoreGetUnreferencedDefinitionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LsifStoreSCIPDocumentFuncCall is an object that describes an invocation
-// of method SCIPDocument on an instance of MockLsifStore.
-type LsifStoreSCIPDocumentFuncCall struct {
+// LsifStoreGetUnreferencedDefinitionsFuncCall is an object that describes
+// an invocation of method GetUnreferencedDefinitions on an instance of
+// MockLsifStore.
+type LsifStoreGetUnreferencedDefinitionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *scip.Document
+	Result0 []shared.DeadCodeDefinition
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LsifStoreSCIPDocumentFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LsifStoreGetUnreferencedDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LsifStoreSCIPDocumentFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LsifStoreGetUnreferencedDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LsifStoreHasSymbolDataFunc describes the behavior when the HasSymbolData
+// method of the parent MockLsifStore instance is invoked.
+type LsifStoreHasSymbolDataFunc struct {
+	defaultHook func(context.Context, int) (bool, error)
+	hooks       []func(context.Context, int) (bool, error)
+	history     []LsifStoreHasSymbolDataFuncCall
+	mutex       sync.Mutex
+}
+
+// HasSymbolData delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLsifStore) HasSymbolData(v0 context.Context, v1 int) (bool, error) {
+	r0, r1 := m.HasSymbolDataFunc.nextHook()(v0, v1)
+	m.HasSymbolDataFunc.appendCall(LsifStoreHasSymbolDataFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the HasSymbolData method
+// of the parent MockLsifStore instance is invoked and the hook queue is
+// empty.
+func (f *LsifStoreHasSymbolDataFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// HasSymbolData method of the parent MockLsifStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LsifStoreHasSymbolDataFunc) PushHook(hook func(context.Context, int) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreHasSymbolDataFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreHasSymbolDataFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *LsifStoreHasSymbolDataFunc) nextHook() func(context.Context, int) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreHasSymbolDataFunc) appendCall(r0 LsifStoreHasSymbolDataFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LsifStoreHasSymbolDataFuncCall objects
+// describing the invocations of this function.
+func (f *LsifStoreHasSymbolDataFunc) History() []LsifStoreHasSymbolDataFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreHasSymbolDataFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreHasSymbolDataFuncCall is an object that describes an invocation
+// of method HasSymbolData on an instance of MockLsifStore.
+type LsifStoreHasSymbolDataFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreHasSymbolDataFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreHasSymbolDataFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LsifStoreSCIPDocumentFunc describes the behavior when the SCIPDocument
+// method of the parent MockLsifStore instance is invoked.
+type LsifStoreSCIPDocumentFunc struct {
+	defaultHook func(context.Context, int, string) (*scip.Document, error)
+	hooks       []func(context.Context, int, string) (*scip.Document, error)
+	history     []LsifStoreSCIPDocumentFuncCall
+	mutex       sync.Mutex
+}
+
+// SCIPDocument delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLsifStore) SCIPDocument(v0 context.Context, v1 int, v2 string) (*scip.Document, error) {
+	r0, r1 := m.SCIPDocumentFunc.nextHook()(v0, v1, v2)
+	m.SCIPDocumentFunc.appendCall(LsifStoreSCIPDocumentFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the SCIPDocument method
+// of the parent MockLsifStore instance is invoked and the hook queue is
+// empty.
+func (f *LsifStoreSCIPDocumentFunc) SetDefaultHook(hook func(context.Context, int, string) (*scip.Document, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SCIPDocument method of the parent MockLsifStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LsifStoreSCIPDocumentFunc) PushHook(hook func(context.Context, int, string) (*scip.Document, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreSCIPDocumentFunc) SetDefaultReturn(r0 *scip.Document, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (*scip.Document, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreSCIPDocumentFunc) PushReturn(r0 *scip.Document, r1 error) {
+	f.PushHook(func(context.Context, int, string) (*scip.Document, error) {
+		return r0, r1
+	})
+}
+
+func (f *LsifStoreSCIPDocumentFunc) nextHook() func(context.Context, int, string) (*scip.Document, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreSCIPDocumentFunc) appendCall(r0 LsifStoreSCIPDocumentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LsifStoreSCIPDocumentFuncCall objects
+// describing the invocations of this function.
+func (f *LsifStoreSCIPDocumentFunc) History() []LsifStoreSCIPDocumentFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreSCIPDocumentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreSCIPDocumentFuncCall is an object that describes an invocation
+// of method SCIPDocument on an instance of MockLsifStore.
+type LsifStoreSCIPDocumentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *scip.Document
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreSCIPDocumentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreSCIPDocumentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockStore is a mock implementation of the Store interface (from the
+// package
+// github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store)
+// used for unit testing.
+type MockStore struct {
+	// DeleteStaleDeadCodeReportsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteStaleDeadCodeReports.
+	DeleteStaleDeadCodeReportsFunc *StoreDeleteStaleDeadCodeReportsFunc
+	// GetDeadCodeDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDeadCodeDefinitions.
+	GetDeadCodeDefinitionsFunc *StoreGetDeadCodeDefinitionsFunc
+	// GetDeadCodeReportFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDeadCodeReport.
+	GetDeadCodeReportFunc *StoreGetDeadCodeReportFunc
+	// GetReferencingUploadIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetReferencingUploadIDs.
+	GetReferencingUploadIDsFunc *StoreGetReferencingUploadIDsFunc
+	// GetUploadPackagesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadPackages.
+	GetUploadPackagesFunc *StoreGetUploadPackagesFunc
+	// GetUploadsForDeadCodeReportFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUploadsForDeadCodeReport.
+	GetUploadsForDeadCodeReportFunc *StoreGetUploadsForDeadCodeReportFunc
+	// InsertDeadCodeReportFunc is an instance of a mock function object
+	// controlling the behavior of the method InsertDeadCodeReport.
+	InsertDeadCodeReportFunc *StoreInsertDeadCodeReportFunc
+}
+
+// NewMockStore creates a new mock of the Store interface. All methods
+// return zero values for all results, unless overwritten.
+func NewMockStore() *MockStore {
+	return &MockStore{
+		DeleteStaleDeadCodeReportsFunc: &StoreDeleteStaleDeadCodeReportsFunc{
+			defaultHook: func(context.Context) (r0 int, r1 error) {
+				return
+			},
+		},
+		GetDeadCodeDefinitionsFunc: &StoreGetDeadCodeDefinitionsFunc{
+			defaultHook: func(context.Context, shared.GetDeadCodeDefinitionsArgs) (r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+				return
+			},
+		},
+		GetDeadCodeReportFunc: &StoreGetDeadCodeReportFunc{
+			defaultHook: func(context.Context, int) (r0 shared.DeadCodeReport, r1 bool, r2 error) {
+				return
+			},
+		},
+		GetReferencingUploadIDsFunc: &StoreGetReferencingUploadIDsFunc{
+			defaultHook: func(context.Context, int) (r0 []int, r1 error) {
+				return
+			},
+		},
+		GetUploadPackagesFunc: &StoreGetUploadPackagesFunc{
+			defaultHook: func(context.Context, int) (r0 []precise.Package, r1 error) {
+				return
+			},
+		},
+		GetUploadsForDeadCodeReportFunc: &StoreGetUploadsForDeadCodeReportFunc{
+			defaultHook: func(context.Context, int, time.Time) (r0 []int, r1 error) {
+				return
+			},
+		},
+		InsertDeadCodeReportFunc: &StoreInsertDeadCodeReportFunc{
+			defaultHook: func(context.Context, int, int, []shared.DeadCodeDefinition) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockStore creates a new mock of the Store interface. All methods
+// panic on invocation, unless overwritten.
+func NewStrictMockStore() *MockStore {
+	return &MockStore{
+		DeleteStaleDeadCodeReportsFunc: &StoreDeleteStaleDeadCodeReportsFunc{
+			defaultHook: func(context.Context) (int, error) {
+				panic("unexpected invocation of MockStore.DeleteStaleDeadCodeReports")
+			},
+		},
+		GetDeadCodeDefinitionsFunc: &StoreGetDeadCodeDefinitionsFunc{
+			defaultHook: func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error) {
+				panic("unexpected invocation of MockStore.GetDeadCodeDefinitions")
+			},
+		},
+		GetDeadCodeReportFunc: &StoreGetDeadCodeReportFunc{
+			defaultHook: func(context.Context, int) (shared.DeadCodeReport, bool, error) {
+				panic("unexpected invocation of MockStore.GetDeadCodeReport")
+			},
+		},
+		GetReferencingUploadIDsFunc: &StoreGetReferencingUploadIDsFunc{
+			defaultHook: func(context.Context, int) ([]int, error) {
+				panic("unexpected invocation of MockStore.GetReferencingUploadIDs")
+			},
+		},
+		GetUploadPackagesFunc: &StoreGetUploadPackagesFunc{
+			defaultHook: func(context.Context, int) ([]precise.Package, error) {
+				panic("unexpected invocation of MockStore.GetUploadPackages")
+			},
+		},
+		GetUploadsForDeadCodeReportFunc: &StoreGetUploadsForDeadCodeReportFunc{
+			defaultHook: func(context.Context, int, time.Time) ([]int, error) {
+				panic("unexpected invocation of MockStore.GetUploadsForDeadCodeReport")
+			},
+		},
+		InsertDeadCodeReportFunc: &StoreInsertDeadCodeReportFunc{
+			defaultHook: func(context.Context, int, int, []shared.DeadCodeDefinition) error {
+				panic("unexpected invocation of MockStore.InsertDeadCodeReport")
+			},
+		},
+	}
+}
+
+// NewMockStoreFrom creates a new mock of the MockStore interface. All
+// methods delegate to the given implementation, unless overwritten.
+func NewMockStoreFrom(i store.Store) *MockStore {
+	return &MockStore{
+		DeleteStaleDeadCodeReportsFunc: &StoreDeleteStaleDeadCodeReportsFunc{
+			defaultHook: i.DeleteStaleDeadCodeReports,
+		},
+		GetDeadCodeDefinitionsFunc: &StoreGetDeadCodeDefinitionsFunc{
+			defaultHook: i.GetDeadCodeDefinitions,
+		},
+		GetDeadCodeReportFunc: &StoreGetDeadCodeReportFunc{
+			defaultHook: i.GetDeadCodeReport,
+		},
+		GetReferencingUploadIDsFunc: &StoreGetReferencingUploadIDsFunc{
+			defaultHook: i.GetReferencingUploadIDs,
+		},
+		GetUploadPackagesFunc: &StoreGetUploadPackagesFunc{
+			defaultHook: i.GetUploadPackages,
+		},
+		GetUploadsForDeadCodeReportFunc: &StoreGetUploadsForDeadCodeReportFunc{
+			defaultHook: i.GetUploadsForDeadCodeReport,
+		},
+		InsertDeadCodeReportFunc: &StoreInsertDeadCodeReportFunc{
+			defaultHook: i.InsertDeadCodeReport,
+		},
+	}
+}
+
+// StoreDeleteStaleDeadCodeReportsFunc describes the behavior when the
+// DeleteStaleDeadCodeReports method of the parent MockStore instance is
+// invoked.
+type StoreDeleteStaleDeadCodeReportsFunc struct {
+	defaultHook func(context.Context) (int, error)
+	hooks       []func(context.Context) (int, error)
+	history     []StoreDeleteStaleDeadCodeReportsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteStaleDeadCodeReports delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) DeleteStaleDeadCodeReports(v0 context.Context) (int, error) {
+	r0, r1 := m.DeleteStaleDeadCodeReportsFunc.nextHook()(v0)
+	m.DeleteStaleDeadCodeReportsFunc.appendCall(StoreDeleteStaleDeadCodeReportsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteStaleDeadCodeReports method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreDeleteStaleDeadCodeReportsFunc) SetDefaultHook(hook func(context.Context) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteStaleDeadCodeReports method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreDeleteStaleDeadCodeReportsFunc) PushHook(hook func(context.Context) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreDeleteStaleDeadCodeReportsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreDeleteStaleDeadCodeReportsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreDeleteStaleDeadCodeReportsFunc) nextHook() func(context.Context) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreDeleteStaleDeadCodeReportsFunc) appendCall(r0 StoreDeleteStaleDeadCodeReportsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreDeleteStaleDeadCodeReportsFuncCall
+// objects describing the invocations of this function.
+func (f *StoreDeleteStaleDeadCodeReportsFunc) History() []StoreDeleteStaleDeadCodeReportsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreDeleteStaleDeadCodeReportsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreDeleteStaleDeadCodeReportsFuncCall is an object that describes an
+// invocation of method DeleteStaleDeadCodeReports on an instance of
+// MockStore.
+type StoreDeleteStaleDeadCodeReportsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreDeleteStaleDeadCodeReportsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreDeleteStaleDeadCodeReportsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetDeadCodeDefinitionsFunc describes the behavior when the
+// GetDeadCodeDefinitions method of the parent MockStore instance is
+// invoked.
+type StoreGetDeadCodeDefinitionsFunc struct {
+	defaultHook func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error)
+	hooks       []func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error)
+	history     []StoreGetDeadCodeDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDeadCodeDefinitions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) GetDeadCodeDefinitions(v0 context.Context, v1 shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error) {
+	r0, r1, r2 := m.GetDeadCodeDefinitionsFunc.nextHook()(v0, v1)
+	m.GetDeadCodeDefinitionsFunc.appendCall(StoreGetDeadCodeDefinitionsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// GetDeadCodeDefinitions method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreGetDeadCodeDefinitionsFunc) SetDefaultHook(hook func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDeadCodeDefinitions method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreGetDeadCodeDefinitionsFunc) PushHook(hook func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetDeadCodeDefinitionsFunc) SetDefaultReturn(r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetDeadCodeDefinitionsFunc) PushReturn(r0 []shared.DeadCodeDefinition, r1 int, r2 error) {
+	f.PushHook(func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *StoreGetDeadCodeDefinitionsFunc) nextHook() func(context.Context, shared.GetDeadCodeDefinitionsArgs) ([]shared.DeadCodeDefinition, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetDeadCodeDefinitionsFunc) appendCall(r0 StoreGetDeadCodeDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetDeadCodeDefinitionsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetDeadCodeDefinitionsFunc) History() []StoreGetDeadCodeDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetDeadCodeDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetDeadCodeDefinitionsFuncCall is an object that describes an
+// invocation of method GetDeadCodeDefinitions on an instance of MockStore.
+type StoreGetDeadCodeDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetDeadCodeDefinitionsArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.DeadCodeDefinition
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetDeadCodeDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetDeadCodeDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// StoreGetDeadCodeReportFunc describes the behavior when the
+// GetDeadCodeReport method of the parent MockStore instance is invoked.
+type StoreGetDeadCodeReportFunc struct {
+	defaultHook func(context.Context, int) (shared.DeadCodeReport, bool, error)
+	hooks       []func(context.Context, int) (shared.DeadCodeReport, bool, error)
+	history     []StoreGetDeadCodeReportFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDeadCodeReport delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetDeadCodeReport(v0 context.Context, v1 int) (shared.DeadCodeReport, bool, error) {
+	r0, r1, r2 := m.GetDeadCodeReportFunc.nextHook()(v0, v1)
+	m.GetDeadCodeReportFunc.appendCall(StoreGetDeadCodeReportFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetDeadCodeReport
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetDeadCodeReportFunc) SetDefaultHook(hook func(context.Context, int) (shared.DeadCodeReport, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDeadCodeReport method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetDeadCodeReportFunc) PushHook(hook func(context.Context, int) (shared.DeadCodeReport, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetDeadCodeReportFunc) SetDefaultReturn(r0 shared.DeadCodeReport, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (shared.DeadCodeReport, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetDeadCodeReportFunc) PushReturn(r0 shared.DeadCodeReport, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (shared.DeadCodeReport, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *StoreGetDeadCodeReportFunc) nextHook() func(context.Context, int) (shared.DeadCodeReport, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetDeadCodeReportFunc) appendCall(r0 StoreGetDeadCodeReportFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetDeadCodeReportFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetDeadCodeReportFunc) History() []StoreGetDeadCodeReportFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetDeadCodeReportFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetDeadCodeReportFuncCall is an object that describes an invocation
+// of method GetDeadCodeReport on an instance of MockStore.
+type StoreGetDeadCodeReportFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.DeadCodeReport
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetDeadCodeReportFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetDeadCodeReportFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// StoreGetReferencingUploadIDsFunc describes the behavior when the
+// GetReferencingUploadIDs method of the parent MockStore instance is
+// invoked.
+type StoreGetReferencingUploadIDsFunc struct {
+	defaultHook func(context.Context, int) ([]int, error)
+	hooks       []func(context.Context, int) ([]int, error)
+	history     []StoreGetReferencingUploadIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetReferencingUploadIDs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) GetReferencingUploadIDs(v0 context.Context, v1 int) ([]int, error) {
+	r0, r1 := m.GetReferencingUploadIDsFunc.nextHook()(v0, v1)
+	m.GetReferencingUploadIDsFunc.appendCall(StoreGetReferencingUploadIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetReferencingUploadIDs method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetReferencingUploadIDsFunc) SetDefaultHook(hook func(context.Context, int) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetReferencingUploadIDs method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreGetReferencingUploadIDsFunc) PushHook(hook func(context.Context, int) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetReferencingUploadIDsFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetReferencingUploadIDsFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetReferencingUploadIDsFunc) nextHook() func(context.Context, int) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetReferencingUploadIDsFunc) appendCall(r0 StoreGetReferencingUploadIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetReferencingUploadIDsFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetReferencingUploadIDsFunc) History() []StoreGetReferencingUploadIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetReferencingUploadIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetReferencingUploadIDsFuncCall is an object that describes an
+// invocation of method GetReferencingUploadIDs on an instance of MockStore.
+type StoreGetReferencingUploadIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetReferencingUploadIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetReferencingUploadIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetUploadPackagesFunc describes the behavior when the
+// GetUploadPackages method of the parent MockStore instance is invoked.
+type StoreGetUploadPackagesFunc struct {
+	defaultHook func(context.Context, int) ([]precise.Package, error)
+	hooks       []func(context.Context, int) ([]precise.Package, error)
+	history     []StoreGetUploadPackagesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadPackages delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadPackages(v0 context.Context, v1 int) ([]precise.Package, error) {
+	r0, r1 := m.GetUploadPackagesFunc.nextHook()(v0, v1)
+	m.GetUploadPackagesFunc.appendCall(StoreGetUploadPackagesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUploadPackages
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetUploadPackagesFunc) SetDefaultHook(hook func(context.Context, int) ([]precise.Package, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadPackages method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetUploadPackagesFunc) PushHook(hook func(context.Context, int) ([]precise.Package, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadPackagesFunc) SetDefaultReturn(r0 []precise.Package, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]precise.Package, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadPackagesFunc) PushReturn(r0 []precise.Package, r1 error) {
+	f.PushHook(func(context.Context, int) ([]precise.Package, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetUploadPackagesFunc) nextHook() func(context.Context, int) ([]precise.Package, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadPackagesFunc) appendCall(r0 StoreGetUploadPackagesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadPackagesFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetUploadPackagesFunc) History() []StoreGetUploadPackagesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadPackagesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadPackagesFuncCall is an object that describes an invocation
+// of method GetUploadPackages on an instance of MockStore.
+type StoreGetUploadPackagesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []precise.Package
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadPackagesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadPackagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetUploadsForDeadCodeReportFunc describes the behavior when the
+// GetUploadsForDeadCodeReport method of the parent MockStore instance is
+// invoked.
+type StoreGetUploadsForDeadCodeReportFunc struct {
+	defaultHook func(context.Context, int, time.Time) ([]int, error)
+	hooks       []func(context.Context, int, time.Time) ([]int, error)
+	history     []StoreGetUploadsForDeadCodeReportFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadsForDeadCodeReport delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadsForDeadCodeReport(v0 context.Context, v1 int, v2 time.Time) ([]int, error) {
+	r0, r1 := m.GetUploadsForDeadCodeReportFunc.nextHook()(v0, v1, v2)
+	m.GetUploadsForDeadCodeReportFunc.appendCall(StoreGetUploadsForDeadCodeReportFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUploadsForDeadCodeReport method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetUploadsForDeadCodeReportFunc) SetDefaultHook(hook func(context.Context, int, time.Time) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadsForDeadCodeReport method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetUploadsForDeadCodeReportFunc) PushHook(hook func(context.Context, int, time.Time) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadsForDeadCodeReportFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, time.Time) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadsForDeadCodeReportFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, int, time.Time) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetUploadsForDeadCodeReportFunc) nextHook() func(context.Context, int, time.Time) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadsForDeadCodeReportFunc) appendCall(r0 StoreGetUploadsForDeadCodeReportFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadsForDeadCodeReportFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetUploadsForDeadCodeReportFunc) History() []StoreGetUploadsForDeadCodeReportFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadsForDeadCodeReportFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadsForDeadCodeReportFuncCall is an object that describes an
+// invocation of method GetUploadsForDeadCodeReport on an instance of
+// MockStore.
+type StoreGetUploadsForDeadCodeReportFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadsForDeadCodeReportFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadsForDeadCodeReportFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreInsertDeadCodeReportFunc describes the behavior when the
+// InsertDeadCodeReport method of the parent MockStore instance is invoked.
+type StoreInsertDeadCodeReportFunc struct {
+	defaultHook func(context.Context, int, int, []shared.DeadCodeDefinition) error
+	hooks       []func(context.Context, int, int, []shared.DeadCodeDefinition) error
+	history     []StoreInsertDeadCodeReportFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertDeadCodeReport delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) InsertDeadCodeReport(v0 context.Context, v1 int, v2 int, v3 []shared.DeadCodeDefinition) error {
+	r0 := m.InsertDeadCodeReportFunc.nextHook()(v0, v1, v2, v3)
+	m.InsertDeadCodeReportFunc.appendCall(StoreInsertDeadCodeReportFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the InsertDeadCodeReport
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreInsertDeadCodeReportFunc) SetDefaultHook(hook func(context.Context, int, int, []shared.DeadCodeDefinition) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertDeadCodeReport method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreInsertDeadCodeReportFunc) PushHook(hook func(context.Context, int, int, []shared.DeadCodeDefinition) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreInsertDeadCodeReportFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, int, []shared.DeadCodeDefinition) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreInsertDeadCodeReportFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, int, []shared.DeadCodeDefinition) error {
+		return r0
+	})
+}
+
+func (f *StoreInsertDeadCodeReportFunc) nextHook() func(context.Context, int, int, []shared.DeadCodeDefinition) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreInsertDeadCodeReportFunc) appendCall(r0 StoreInsertDeadCodeReportFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreInsertDeadCodeReportFuncCall objects
+// describing the invocations of this function.
+func (f *StoreInsertDeadCodeReportFunc) History() []StoreInsertDeadCodeReportFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreInsertDeadCodeReportFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreInsertDeadCodeReportFuncCall is an object that describes an
+// invocation of method InsertDeadCodeReport on an instance of MockStore.
+type StoreInsertDeadCodeReportFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []shared.DeadCodeDefinition
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreInsertDeadCodeReportFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreInsertDeadCodeReportFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
 // MockGitTreeTranslator is a mock implementation of the GitTreeTranslator
@@ -19,9 +19,19 @@ type operations struct {
 	getDefinitions         *observation.Operation
 	getRanges              *observation.Operation
 	getStencil             *observation.Operation
+	getIncomingCalls       *observation.Operation
+	getOutgoingCalls       *observation.Operation
+	getSupertypes          *observation.Operation
+	getSubtypes            *observation.Operation
+	getDeadCode            *observation.Operation
+	getRenameImpact        *observation.Operation
+	getAPIChanges          *observation.Operation
+	diffAPIs               *observation.Operation
 	getClosestDumpsForBlob *observation.Operation
 	snapshotForDocument    *observation.Operation
 	visibleUploadsForPath  *observation.Operation
+	getSymbolReferences    *observation.Operation
+	hasSymbolData          *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -52,9 +62,19 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getDefinitions:         op("getDefinitions"),
 		getRanges:              op("getRanges"),
 		getStencil:             op("getStencil"),
+		getIncomingCalls:       op("getIncomingCalls"),
+		getOutgoingCalls:       op("getOutgoingCalls"),
+		getSupertypes:          op("getSupertypes"),
+		getSubtypes:            op("getSubtypes"),
+		getDeadCode:            op("getDeadCode"),
+		getRenameImpact:        op("getRenameImpact"),
+		getAPIChanges:          op("getAPIChanges"),
+		diffAPIs:               op("DiffAPIs"),
 		getClosestDumpsForBlob: op("GetClosestDumpsForBlob"),
 		snapshotForDocument:    op("SnapshotForDocument"),
 		visibleUploadsForPath:  op("VisibleUploadsForPath"),
+		getSymbolReferences:    op("GetSymbolReferences"),
+		hasSymbolData:          op("HasSymbolData"),
 	}
 }
 
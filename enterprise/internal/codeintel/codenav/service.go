@@ -12,6 +12,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
@@ -27,6 +28,7 @@ import (
 type Service struct {
 	repoStore  database.RepoStore
 	lsifstore  lsifstore.LsifStore
+	store      store.Store
 	gitserver  gitserver.Client
 	uploadSvc  UploadService
 	operations *operations
@@ -37,12 +39,14 @@ func newService(
 	observationCtx *observation.Context,
 	repoStore database.RepoStore,
 	lsifstore lsifstore.LsifStore,
+	store store.Store,
 	uploadSvc UploadService,
 	gitserver gitserver.Client,
 ) *Service {
 	return &Service{
 		repoStore:  repoStore,
 		lsifstore:  lsifstore,
+		store:      store,
 		gitserver:  gitserver,
 		uploadSvc:  uploadSvc,
 		operations: newOperations(observationCtx),
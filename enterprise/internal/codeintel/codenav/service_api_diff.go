@@ -0,0 +1,261 @@
+package codenav
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrIncomparableUploads occurs when the API of uploads of different repositories or roots is compared.
+var ErrIncomparableUploads = errors.New("uploads must belong to the same repository and root")
+
+// GetAPIChanges returns the changes to the public API defined within documents having the requested
+// path as prefix between the given base commit and the requested commit. Each upload visible from the
+// requested commit is compared to the closest upload of the same root and indexer visible from the
+// base commit; uploads without such a counterpart are skipped. This method also returns the size of
+// the complete result set.
+func (s *Service) GetAPIChanges(ctx context.Context, args RequestArgs, requestState RequestState, baseCommit string, kind *APIChangeKind) (_ []APIChange, _ int, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getAPIChanges, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.String("baseCommit", baseCommit),
+		attribute.Int("limit", args.Limit),
+	}})
+	defer endObservation()
+
+	visibleUploads, err := s.getUploadPaths(ctx, args.Path, requestState)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var changes []APIChange
+	for _, visibleUpload := range visibleUploads {
+		head := visibleUpload.Upload
+
+		base, ok, err := s.getBaseUpload(ctx, head, baseCommit)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !ok {
+			trace.AddEvent("TODO Domain Owner", attribute.Int("skippedUploadID", head.ID))
+			continue
+		}
+		trace.AddEvent("TODO Domain Owner",
+			attribute.Int("baseUploadID", base.ID),
+			attribute.Int("headUploadID", head.ID))
+
+		uploadChanges, err := s.diffAPIs(ctx, base, head, visibleUpload.TargetPathWithoutRoot)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, change := range uploadChanges {
+			if kind == nil || change.Kind == *kind {
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	totalCount := len(changes)
+	if len(changes) > args.Limit {
+		changes = changes[:args.Limit]
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("totalCount", totalCount))
+
+	return changes, totalCount, nil
+}
+
+// DiffAPIs returns the changes to the public API between the given uploads, which must belong to the
+// same repository and root.
+func (s *Service) DiffAPIs(ctx context.Context, base, head uploadsshared.Dump) (_ []APIChange, err error) {
+	ctx, _, endObservation := s.operations.diffAPIs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("baseUploadID", base.ID),
+		attribute.Int("headUploadID", head.ID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.diffAPIs(ctx, base, head, "")
+}
+
+// getBaseUpload returns the closest upload visible from the given commit with the same root and
+// indexer as the given upload.
+func (s *Service) getBaseUpload(ctx context.Context, head uploadsshared.Dump, baseCommit string) (uploadsshared.Dump, bool, error) {
+	candidates, err := s.GetClosestDumpsForBlob(ctx, head.RepositoryID, baseCommit, head.Root, false, head.Indexer)
+	if err != nil {
+		return uploadsshared.Dump{}, false, err
+	}
+
+	for _, candidate := range candidates {
+		if candidate.Root == head.Root {
+			return candidate, true, nil
+		}
+	}
+
+	return uploadsshared.Dump{}, false, nil
+}
+
+// diffAPIs returns the changes to the public symbols defined in documents having the given path
+// (relative to the root of the uploads) as prefix between the given uploads.
+func (s *Service) diffAPIs(ctx context.Context, base, head uploadsshared.Dump, pathPrefix string) ([]APIChange, error) {
+	if base.RepositoryID != head.RepositoryID || base.Root != head.Root {
+		return nil, ErrIncomparableUploads
+	}
+
+	baseSymbols, err := s.getAPISymbols(ctx, base, pathPrefix)
+	if err != nil {
+		return nil, err
+	}
+	headSymbols, err := s.getAPISymbols(ctx, head, pathPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffAPISymbols(baseSymbols, headSymbols), nil
+}
+
+// getAPISymbols returns the public symbols defined by the given upload in documents having the given
+// path as prefix, keyed by their symbol without package version.
+func (s *Service) getAPISymbols(ctx context.Context, upload uploadsshared.Dump, pathPrefix string) (map[string]APISymbolAtUpload, error) {
+	packages, err := s.store.GetUploadPackages(ctx, upload.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "store.GetUploadPackages")
+	}
+
+	definedSymbols, err := s.lsifstore.GetDefinedSymbols(ctx, upload.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifstore.GetDefinedSymbols")
+	}
+
+	return filterAPISymbols(upload, packages, definedSymbols, pathPrefix), nil
+}
+
+// apiSymbolFormatter formats symbols without their package version, which usually changes between
+// two uploads of the same repository.
+var apiSymbolFormatter = func() scip.SymbolFormatter {
+	formatter := scip.VerboseSymbolFormatter
+	formatter.IncludePackageVersion = func(_ string) bool { return false }
+	return formatter
+}()
+
+// filterAPISymbols returns the given symbols that are part of the public API of the given upload,
+// keyed by their symbol without package version. Symbols are public when they belong to a package
+// provided by the upload, or to any package if the upload provides none. Parameters and type
+// parameters are covered by the signature of their enclosing symbol and are never public.
+func filterAPISymbols(upload uploadsshared.Dump, packages []precise.Package, definedSymbols []shared.DefinedSymbol, pathPrefix string) map[string]APISymbolAtUpload {
+	type packageKey struct{ scheme, manager, name string }
+	providedPackages := make(map[packageKey]struct{}, len(packages))
+	for _, pkg := range packages {
+		providedPackages[packageKey{pkg.Scheme, pkg.Manager, pkg.Name}] = struct{}{}
+	}
+
+	symbols := make(map[string]APISymbolAtUpload, len(definedSymbols))
+	for _, definedSymbol := range definedSymbols {
+		if !strings.HasPrefix(definedSymbol.DocumentPath, pathPrefix) {
+			continue
+		}
+
+		symbol, err := scip.ParseSymbol(definedSymbol.SymbolName)
+		if err != nil || symbol.Package == nil || !isAPIDescriptor(symbol.Descriptors) {
+			continue
+		}
+		if len(providedPackages) > 0 {
+			if _, ok := providedPackages[packageKey{symbol.Scheme, symbol.Package.Manager, symbol.Package.Name}]; !ok {
+				continue
+			}
+		}
+
+		symbols[apiSymbolFormatter.FormatSymbol(symbol)] = APISymbolAtUpload{
+			Symbol:        definedSymbol.SymbolName,
+			Signature:     definedSymbol.Signature,
+			Documentation: definedSymbol.Documentation,
+			Location: shared.UploadLocation{
+				Dump:         upload,
+				Path:         upload.Root + definedSymbol.DocumentPath,
+				TargetCommit: upload.Commit,
+				TargetRange:  definedSymbol.Range,
+			},
+		}
+	}
+
+	return symbols
+}
+
+func isAPIDescriptor(descriptors []*scip.Descriptor) bool {
+	if len(descriptors) == 0 {
+		return false
+	}
+
+	switch descriptors[len(descriptors)-1].Suffix {
+	case scip.Descriptor_Parameter, scip.Descriptor_TypeParameter, scip.Descriptor_Local:
+		return false
+	}
+
+	return true
+}
+
+// diffAPISymbols returns the added, removed, and changed symbols between the given sets of symbols,
+// ordered by symbol.
+func diffAPISymbols(baseSymbols, headSymbols map[string]APISymbolAtUpload) []APIChange {
+	keys := make([]string, 0, len(baseSymbols)+len(headSymbols))
+	for key := range baseSymbols {
+		keys = append(keys, key)
+	}
+	for key := range headSymbols {
+		if _, ok := baseSymbols[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []APIChange
+	for _, key := range keys {
+		baseSymbol, inBase := baseSymbols[key]
+		headSymbol, inHead := headSymbols[key]
+
+		switch {
+		case !inBase:
+			changes = append(changes, APIChange{
+				Kind: APIChangeKindAdded,
+				Name: symbolDisplayName(headSymbol.Symbol),
+				Head: &headSymbol,
+			})
+
+		case !inHead:
+			changes = append(changes, APIChange{
+				Kind: APIChangeKindRemoved,
+				Name: symbolDisplayName(baseSymbol.Symbol),
+				Base: &baseSymbol,
+			})
+
+		default:
+			signatureChanged := baseSymbol.Signature != headSymbol.Signature
+			documentationChanged := baseSymbol.Documentation != headSymbol.Documentation
+			if !signatureChanged && !documentationChanged {
+				continue
+			}
+
+			changes = append(changes, APIChange{
+				Kind:                 APIChangeKindChanged,
+				Name:                 symbolDisplayName(headSymbol.Symbol),
+				Base:                 &baseSymbol,
+				Head:                 &headSymbol,
+				SignatureChanged:     signatureChanged,
+				DocumentationChanged: documentationChanged,
+			})
+		}
+	}
+
+	return changes
+}
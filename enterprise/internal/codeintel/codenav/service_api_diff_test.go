@@ -0,0 +1,89 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestDiffAPIs(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockStore := NewMockStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockStore, mockUploadSvc, mockGitserverClient)
+
+	base := uploadsshared.Dump{ID: 50, RepositoryID: 42, Commit: "deadbeef", Root: "lib/"}
+	head := uploadsshared.Dump{ID: 51, RepositoryID: 42, Commit: "cafebabe", Root: "lib/"}
+
+	mockStore.GetUploadPackagesFunc.SetDefaultHook(func(_ context.Context, uploadID int) ([]precise.Package, error) {
+		version := map[int]string{50: "v1.0.0", 51: "v1.1.0"}[uploadID]
+		return []precise.Package{{Scheme: "scip-go", Manager: "gomod", Name: "example", Version: version}}, nil
+	})
+
+	baseSymbols := []shared.DefinedSymbol{
+		{SymbolName: "scip-go gomod example v1.0.0 `example`/New().", DocumentPath: "a.go", Range: testRange1, Signature: "func New() *Server"},
+		{SymbolName: "scip-go gomod example v1.0.0 `example`/Server#", DocumentPath: "a.go", Range: testRange2, Signature: "type Server struct", Documentation: "Server serves."},
+		{SymbolName: "scip-go gomod example v1.0.0 `example`/Removed().", DocumentPath: "b.go", Range: testRange3, Signature: "func Removed()"},
+		{SymbolName: "scip-go gomod example v1.0.0 `example`/Unchanged().", DocumentPath: "b.go", Range: testRange4, Signature: "func Unchanged()"},
+	}
+	headSymbols := []shared.DefinedSymbol{
+		{SymbolName: "scip-go gomod example v1.1.0 `example`/New().", DocumentPath: "a.go", Range: testRange1, Signature: "func New(opts Options) *Server"},
+		{SymbolName: "scip-go gomod example v1.1.0 `example`/New().(opts)", DocumentPath: "a.go", Range: testRange5, Signature: "opts Options"},
+		{SymbolName: "scip-go gomod example v1.1.0 `example`/Server#", DocumentPath: "a.go", Range: testRange2, Signature: "type Server struct", Documentation: "Server serves requests."},
+		{SymbolName: "scip-go gomod example v1.1.0 `example`/Unchanged().", DocumentPath: "b.go", Range: testRange4, Signature: "func Unchanged()"},
+		{SymbolName: "scip-go gomod example v1.1.0 `example`/Added().", DocumentPath: "c.go", Range: testRange3, Signature: "func Added()"},
+		{SymbolName: "scip-go gomod fmt v1.20.0 `fmt`/Println().", DocumentPath: "c.go", Range: testRange6, Signature: "func Println()"},
+	}
+	mockLsifStore.GetDefinedSymbolsFunc.SetDefaultHook(func(_ context.Context, uploadID int) ([]shared.DefinedSymbol, error) {
+		if uploadID == base.ID {
+			return baseSymbols, nil
+		}
+		return headSymbols, nil
+	})
+
+	changes, err := svc.DiffAPIs(context.Background(), base, head)
+	if err != nil {
+		t.Fatalf("unexpected error diffing APIs: %s", err)
+	}
+
+	apiSymbol := func(upload uploadsshared.Dump, symbol shared.DefinedSymbol) *APISymbolAtUpload {
+		return &APISymbolAtUpload{
+			Symbol:        symbol.SymbolName,
+			Signature:     symbol.Signature,
+			Documentation: symbol.Documentation,
+			Location: shared.UploadLocation{
+				Dump:         upload,
+				Path:         "lib/" + symbol.DocumentPath,
+				TargetCommit: upload.Commit,
+				TargetRange:  symbol.Range,
+			},
+		}
+	}
+
+	expectedChanges := []APIChange{
+		{Kind: APIChangeKindAdded, Name: "Added", Head: apiSymbol(head, headSymbols[4])},
+		{Kind: APIChangeKindChanged, Name: "New", Base: apiSymbol(base, baseSymbols[0]), Head: apiSymbol(head, headSymbols[0]), SignatureChanged: true},
+		{Kind: APIChangeKindRemoved, Name: "Removed", Base: apiSymbol(base, baseSymbols[2])},
+		{Kind: APIChangeKindChanged, Name: "Server", Base: apiSymbol(base, baseSymbols[1]), Head: apiSymbol(head, headSymbols[2]), DocumentationChanged: true},
+	}
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("unexpected API changes (-want +got):\n%s", diff)
+	}
+
+	if _, err := svc.DiffAPIs(context.Background(), base, uploadsshared.Dump{ID: 52, RepositoryID: 42, Root: "cmd/"}); !errors.Is(err, ErrIncomparableUploads) {
+		t.Errorf("unexpected error. want=%q have=%q", ErrIncomparableUploads, err)
+	}
+}
@@ -0,0 +1,335 @@
+package codenav
+
+import (
+	"context"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CallHierarchyMaxDepth is the maximum number of levels a call hierarchy is expanded to.
+const CallHierarchyMaxDepth = 5
+
+// callHierarchyMaxCalls is the maximum number of calls collected for a single call hierarchy.
+// The expansion stops once it's reached, even if the requested depth hasn't been reached yet.
+const callHierarchyMaxCalls = 1000
+
+// callHierarchyReferencesLimit is the maximum number of references of a single function that
+// are searched for its callers.
+const callHierarchyReferencesLimit = 1000
+
+// GetIncomingCalls returns the calls to the function at the given position and, up to the given
+// depth, the calls to its callers. The caller of a reference is the innermost function whose
+// definition encloses it, so callers are only found in indexes that emit enclosing ranges.
+func (s *Service) GetIncomingCalls(ctx context.Context, args RequestArgs, requestState RequestState, depth int) (_ []CallHierarchyCall, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getIncomingCalls, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	return s.getCallHierarchy(ctx, args, requestState, depth, true, trace)
+}
+
+// GetOutgoingCalls returns the calls made by the function at the given position and, up to the
+// given depth, the calls made by its callees. The calls of a function are the references to other
+// functions within the enclosing range of its definition.
+func (s *Service) GetOutgoingCalls(ctx context.Context, args RequestArgs, requestState RequestState, depth int) (_ []CallHierarchyCall, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getOutgoingCalls, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	return s.getCallHierarchy(ctx, args, requestState, depth, false, trace)
+}
+
+// callHierarchyEdge is a call from one function to another. The call sites are ranges within
+// the document defining the calling function.
+type callHierarchyEdge struct {
+	from      symbolDefinition
+	to        symbolDefinition
+	callSites []shared.Range
+}
+
+// getCallHierarchy expands the call hierarchy of the function at the given position breadth-first,
+// in the direction of callers if incoming is true, and of callees otherwise. Each function is only
+// expanded once, so recursive calls don't lead to cycles.
+func (s *Service) getCallHierarchy(ctx context.Context, args RequestArgs, requestState RequestState, depth int, incoming bool, trace observation.TraceLogger) ([]CallHierarchyCall, error) {
+	if depth < 1 {
+		depth = 1
+	} else if depth > CallHierarchyMaxDepth {
+		depth = CallHierarchyMaxDepth
+	}
+
+	documents := newSCIPDocumentCache(s.lsifstore)
+
+	roots, err := s.getSymbolDefinitionsAtPosition(ctx, args, requestState, documents, isCallableSymbol)
+	if err != nil {
+		return nil, err
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numRoots", len(roots)))
+
+	visited := make(map[string]struct{}, len(roots))
+	for _, root := range roots {
+		visited[root.key()] = struct{}{}
+	}
+
+	var calls []CallHierarchyCall
+	for level := 1; level <= depth && len(roots) > 0; level++ {
+		var next []symbolDefinition
+		for _, node := range roots {
+			var edges []callHierarchyEdge
+			if incoming {
+				edges, err = s.getIncomingCallEdges(ctx, args, requestState, documents, node)
+			} else {
+				edges, err = s.getOutgoingCallEdges(ctx, requestState, documents, node)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, edge := range edges {
+				call, ok, err := s.resolveCallHierarchyCall(ctx, args, requestState, edge, level)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+
+				calls = append(calls, call)
+				if len(calls) >= callHierarchyMaxCalls {
+					trace.AddEvent("TODO Domain Owner", attribute.Int("numCalls", len(calls)), attribute.Bool("truncated", true))
+					return calls, nil
+				}
+
+				other := edge.to
+				if incoming {
+					other = edge.from
+				}
+				if _, ok := visited[other.key()]; !ok {
+					visited[other.key()] = struct{}{}
+					next = append(next, other)
+				}
+			}
+		}
+
+		roots = next
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numCalls", len(calls)))
+
+	return calls, nil
+}
+
+// getIncomingCallEdges returns the calls to the given function, grouped by the calling function.
+func (s *Service) getIncomingCallEdges(ctx context.Context, args RequestArgs, requestState RequestState, documents *scipDocumentCache, node symbolDefinition) ([]callHierarchyEdge, error) {
+	locations, _, err := s.lsifstore.GetReferenceLocations(ctx, node.upload.ID, node.path, node.rng.Start.Line, node.rng.Start.Character, callHierarchyReferencesLimit, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.GetReferenceLocations")
+	}
+
+	remoteLocations, err := s.getRemoteSymbolLocations(ctx, args, requestState, node, "references", callHierarchyReferencesLimit-len(locations))
+	if err != nil {
+		return nil, err
+	}
+	locations = append(locations, remoteLocations...)
+
+	var edges []callHierarchyEdge
+	edgeIndexes := map[string]int{}
+	for _, location := range locations {
+		upload, ok := requestState.dataLoader.GetUploadFromCacheMap(location.DumpID)
+		if !ok {
+			continue
+		}
+
+		document, err := documents.get(ctx, location.DumpID, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil || isDefinitionRange(document, location.Range) {
+			continue
+		}
+
+		caller, ok := enclosingCallableDefinition(callableDefinitions(document), location.Range)
+		if !ok {
+			// References outside of any function, e.g. in the initializer of a
+			// global variable, are not calls.
+			continue
+		}
+
+		from := symbolDefinition{
+			upload: upload,
+			path:   location.Path,
+			rng:    rangeFromSCIP(caller.Range),
+			symbol: caller.Symbol,
+		}
+
+		i, ok := edgeIndexes[from.key()]
+		if !ok {
+			i = len(edges)
+			edgeIndexes[from.key()] = i
+			edges = append(edges, callHierarchyEdge{from: from, to: node})
+		}
+		edges[i].callSites = append(edges[i].callSites, location.Range)
+	}
+
+	return edges, nil
+}
+
+// getOutgoingCallEdges returns the calls made by the given function, grouped by the called function.
+// Calls made by functions nested in the given one are attributed to the nested functions instead.
+func (s *Service) getOutgoingCallEdges(ctx context.Context, requestState RequestState, documents *scipDocumentCache, node symbolDefinition) ([]callHierarchyEdge, error) {
+	document, err := documents.get(ctx, node.upload.ID, node.path)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, nil
+	}
+
+	definitions := callableDefinitions(document)
+
+	var symbols []string
+	callSitesBySymbol := map[string][]shared.Range{}
+	for _, occurrence := range document.Occurrences {
+		if isDefinitionOccurrence(occurrence) || !isCallableSymbol(occurrence.Symbol) {
+			continue
+		}
+
+		rng := rangeFromSCIP(occurrence.Range)
+		caller, ok := enclosingCallableDefinition(definitions, rng)
+		if !ok || caller.Symbol != node.symbol || rangeFromSCIP(caller.Range) != node.rng {
+			continue
+		}
+
+		if _, ok := callSitesBySymbol[occurrence.Symbol]; !ok {
+			symbols = append(symbols, occurrence.Symbol)
+		}
+		callSitesBySymbol[occurrence.Symbol] = append(callSitesBySymbol[occurrence.Symbol], rng)
+	}
+
+	var edges []callHierarchyEdge
+	for _, symbol := range symbols {
+		callSites := callSitesBySymbol[symbol]
+
+		callees, err := s.getSymbolDefinitions(ctx, requestState, documents, node.upload, node.path, callSites[0].Start, isCallableSymbol)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, callee := range callees {
+			edges = append(edges, callHierarchyEdge{from: node, to: callee, callSites: callSites})
+		}
+	}
+
+	return edges, nil
+}
+
+// resolveCallHierarchyCall translates the locations of the given edge into the requested commit. A
+// false-valued flag is returned if either function is hidden from the current user.
+func (s *Service) resolveCallHierarchyCall(ctx context.Context, args RequestArgs, requestState RequestState, edge callHierarchyEdge, depth int) (CallHierarchyCall, bool, error) {
+	from, ok, err := s.resolveCallHierarchyItem(ctx, args, requestState, edge.from)
+	if err != nil || !ok {
+		return CallHierarchyCall{}, false, err
+	}
+
+	to, ok, err := s.resolveCallHierarchyItem(ctx, args, requestState, edge.to)
+	if err != nil || !ok {
+		return CallHierarchyCall{}, false, err
+	}
+
+	callSites := make([]shared.UploadLocation, 0, len(edge.callSites))
+	for _, rng := range edge.callSites {
+		callSite, _, err := s.getUploadLocation(ctx, args, requestState, edge.from.upload, shared.Location{
+			DumpID: edge.from.upload.ID,
+			Path:   edge.from.path,
+			Range:  rng,
+		})
+		if err != nil {
+			return CallHierarchyCall{}, false, err
+		}
+
+		callSites = append(callSites, callSite)
+	}
+
+	return CallHierarchyCall{
+		From:      from,
+		To:        to,
+		CallSites: callSites,
+		Depth:     depth,
+	}, true, nil
+}
+
+func (s *Service) resolveCallHierarchyItem(ctx context.Context, args RequestArgs, requestState RequestState, node symbolDefinition) (CallHierarchyItem, bool, error) {
+	location, ok, err := s.resolveSymbolDefinition(ctx, args, requestState, node)
+	if err != nil || !ok {
+		return CallHierarchyItem{}, false, err
+	}
+
+	return CallHierarchyItem{
+		Symbol:   node.symbol,
+		Name:     symbolDisplayName(node.symbol),
+		Location: location,
+	}, true, nil
+}
+
+// callableDefinitions returns the definitions of functions within the given document that have an
+// enclosing range.
+func callableDefinitions(document *scip.Document) []*scip.Occurrence {
+	var definitions []*scip.Occurrence
+	for _, occurrence := range document.Occurrences {
+		if isDefinitionOccurrence(occurrence) && len(occurrence.EnclosingRange) > 0 && isCallableSymbol(occurrence.Symbol) {
+			definitions = append(definitions, occurrence)
+		}
+	}
+
+	return definitions
+}
+
+// enclosingCallableDefinition returns the definition among the given ones with the innermost
+// enclosing range containing the given range.
+func enclosingCallableDefinition(definitions []*scip.Occurrence, rng shared.Range) (*scip.Occurrence, bool) {
+	var innermost *scip.Occurrence
+	var innermostRange shared.Range
+	for _, definition := range definitions {
+		enclosingRange := rangeFromSCIP(definition.EnclosingRange)
+		if !rangeContainsRange(enclosingRange, rng) {
+			continue
+		}
+
+		if innermost == nil || rangeContainsRange(innermostRange, enclosingRange) {
+			innermost = definition
+			innermostRange = enclosingRange
+		}
+	}
+
+	return innermost, innermost != nil
+}
+
+// isDefinitionRange returns true if a definition occurs at exactly the given range of the document.
+func isDefinitionRange(document *scip.Document, rng shared.Range) bool {
+	for _, occurrence := range document.Occurrences {
+		if isDefinitionOccurrence(occurrence) && rangeFromSCIP(occurrence.Range) == rng {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,226 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	callHierarchyFoo = "scip-go gomod example v1 `example`/Foo()."
+	callHierarchyBar = "scip-go gomod example v1 `example`/Bar()."
+	callHierarchyBaz = "scip-go gomod example v1 `example`/Server#Baz()."
+	callHierarchyVar = "scip-go gomod example v1 `example`/config."
+)
+
+// callHierarchyDocument describes the following file:
+//
+//	func Foo() {      // line 0
+//	    Foo()         // line 1
+//	}
+//	func Bar() {      // line 3
+//	    Foo()         // line 4
+//	    Foo()         // line 5
+//	    _ = config    // line 6
+//	}
+//	func (s *Server) Baz() { // line 8
+//	    Bar()         // line 9
+//	}
+var callHierarchyDocument = &scip.Document{
+	RelativePath: "a.go",
+	Occurrences: []*scip.Occurrence{
+		{Range: []int32{0, 5, 8}, Symbol: callHierarchyFoo, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{0, 0, 2, 1}},
+		{Range: []int32{1, 4, 7}, Symbol: callHierarchyFoo},
+		{Range: []int32{3, 5, 8}, Symbol: callHierarchyBar, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{3, 0, 7, 1}},
+		{Range: []int32{4, 4, 7}, Symbol: callHierarchyFoo},
+		{Range: []int32{5, 4, 7}, Symbol: callHierarchyFoo},
+		{Range: []int32{6, 8, 14}, Symbol: callHierarchyVar},
+		{Range: []int32{8, 17, 20}, Symbol: callHierarchyBaz, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{8, 0, 10, 1}},
+		{Range: []int32{9, 4, 7}, Symbol: callHierarchyBar},
+	},
+}
+
+func TestIncomingCalls(t *testing.T) {
+	svc, requestState, upload := newCallHierarchyTestService()
+
+	calls, err := svc.GetIncomingCalls(context.Background(), RequestArgs{
+		RepositoryID: 51,
+		Commit:       mockCommit,
+		Path:         mockPath,
+		Line:         0,
+		Character:    6,
+	}, requestState, 2)
+	if err != nil {
+		t.Fatalf("unexpected error querying incoming calls: %s", err)
+	}
+
+	foo := callHierarchyTestItem(upload, callHierarchyFoo, "Foo", 0, 5, 8)
+	bar := callHierarchyTestItem(upload, callHierarchyBar, "Bar", 3, 5, 8)
+	baz := callHierarchyTestItem(upload, callHierarchyBaz, "Server.Baz", 8, 17, 20)
+
+	expectedCalls := []CallHierarchyCall{
+		{From: foo, To: foo, CallSites: callHierarchyTestLocations(upload, 1), Depth: 1},
+		{From: bar, To: foo, CallSites: callHierarchyTestLocations(upload, 4, 5), Depth: 1},
+		{From: baz, To: bar, CallSites: callHierarchyTestLocations(upload, 9), Depth: 2},
+	}
+	if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+}
+
+func TestIncomingCallsDepth(t *testing.T) {
+	svc, requestState, upload := newCallHierarchyTestService()
+
+	calls, err := svc.GetIncomingCalls(context.Background(), RequestArgs{
+		RepositoryID: 51,
+		Commit:       mockCommit,
+		Path:         mockPath,
+		Line:         0,
+		Character:    6,
+	}, requestState, 1)
+	if err != nil {
+		t.Fatalf("unexpected error querying incoming calls: %s", err)
+	}
+
+	foo := callHierarchyTestItem(upload, callHierarchyFoo, "Foo", 0, 5, 8)
+	bar := callHierarchyTestItem(upload, callHierarchyBar, "Bar", 3, 5, 8)
+
+	expectedCalls := []CallHierarchyCall{
+		{From: foo, To: foo, CallSites: callHierarchyTestLocations(upload, 1), Depth: 1},
+		{From: bar, To: foo, CallSites: callHierarchyTestLocations(upload, 4, 5), Depth: 1},
+	}
+	if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutgoingCalls(t *testing.T) {
+	svc, requestState, upload := newCallHierarchyTestService()
+
+	calls, err := svc.GetOutgoingCalls(context.Background(), RequestArgs{
+		RepositoryID: 51,
+		Commit:       mockCommit,
+		Path:         mockPath,
+		Line:         8,
+		Character:    18,
+	}, requestState, 3)
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+
+	foo := callHierarchyTestItem(upload, callHierarchyFoo, "Foo", 0, 5, 8)
+	bar := callHierarchyTestItem(upload, callHierarchyBar, "Bar", 3, 5, 8)
+	baz := callHierarchyTestItem(upload, callHierarchyBaz, "Server.Baz", 8, 17, 20)
+
+	expectedCalls := []CallHierarchyCall{
+		{From: baz, To: bar, CallSites: callHierarchyTestLocations(upload, 9), Depth: 1},
+		{From: bar, To: foo, CallSites: callHierarchyTestLocations(upload, 4, 5), Depth: 2},
+		{From: foo, To: foo, CallSites: callHierarchyTestLocations(upload, 1), Depth: 3},
+	}
+	if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+}
+
+func TestSymbolDisplayName(t *testing.T) {
+	for symbol, expected := range map[string]string{
+		callHierarchyFoo: "Foo",
+		callHierarchyBaz: "Server.Baz",
+		"scip-typescript npm pkg 1.0.0 src/`a.ts`/Server#start().": "Server.start",
+		"scip-typescript npm pkg 1.0.0 src/`a.ts`/helper().":       "helper",
+	} {
+		if name := symbolDisplayName(symbol); name != expected {
+			t.Errorf("unexpected name for %q. want=%q have=%q", symbol, expected, name)
+		}
+	}
+}
+
+func newCallHierarchyTestService() (*Service, RequestState, uploadsshared.Dump) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, nil, mockUploadSvc, mockGitserverClient)
+
+	upload := uploadsshared.Dump{ID: 50, Commit: mockCommit, Root: "sub1/"}
+	requestState := RequestState{}
+	requestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	requestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	requestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	mockLsifStore.SCIPDocumentFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string) (*scip.Document, error) {
+		if uploadID == upload.ID && path == callHierarchyDocument.RelativePath {
+			return callHierarchyDocument, nil
+		}
+		return nil, nil
+	})
+	mockLsifStore.GetDefinitionLocationsFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string, line, character, _, _ int) ([]shared.Location, int, error) {
+		locations := callHierarchyTestOccurrences(uploadID, line, character, true)
+		return locations, len(locations), nil
+	})
+	mockLsifStore.GetReferenceLocationsFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string, line, character, _, _ int) ([]shared.Location, int, error) {
+		locations := callHierarchyTestOccurrences(uploadID, line, character, false)
+		return locations, len(locations), nil
+	})
+
+	return svc, requestState, upload
+}
+
+// callHierarchyTestOccurrences returns the locations of the definition (or all occurrences) of
+// the symbol occurring at the given position of the test document.
+func callHierarchyTestOccurrences(uploadID, line, character int, definitionsOnly bool) []shared.Location {
+	var symbol string
+	for _, occurrence := range callHierarchyDocument.Occurrences {
+		if rangeContainsPosition(rangeFromSCIP(occurrence.Range), shared.Position{Line: line, Character: character}) {
+			symbol = occurrence.Symbol
+		}
+	}
+
+	var locations []shared.Location
+	for _, occurrence := range callHierarchyDocument.Occurrences {
+		if occurrence.Symbol != symbol || (definitionsOnly && !isDefinitionOccurrence(occurrence)) {
+			continue
+		}
+		locations = append(locations, shared.Location{DumpID: uploadID, Path: "a.go", Range: rangeFromSCIP(occurrence.Range)})
+	}
+
+	return locations
+}
+
+func callHierarchyTestItem(upload uploadsshared.Dump, symbol, name string, line, start, end int) CallHierarchyItem {
+	return CallHierarchyItem{
+		Symbol:   symbol,
+		Name:     name,
+		Location: callHierarchyTestLocation(upload, line, start, end),
+	}
+}
+
+func callHierarchyTestLocations(upload uploadsshared.Dump, lines ...int) []shared.UploadLocation {
+	locations := make([]shared.UploadLocation, 0, len(lines))
+	for _, line := range lines {
+		locations = append(locations, callHierarchyTestLocation(upload, line, 4, 7))
+	}
+	return locations
+}
+
+func callHierarchyTestLocation(upload uploadsshared.Dump, line, start, end int) shared.UploadLocation {
+	return shared.UploadLocation{
+		Dump:         upload,
+		Path:         upload.Root + "a.go",
+		TargetCommit: mockCommit,
+		TargetRange: shared.Range{
+			Start: shared.Position{Line: line, Character: start},
+			End:   shared.Position{Line: line, Character: end},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package codenav
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GetDeadCode returns the definitions within documents having the requested path as prefix that are
+// not referenced by any upload visible at the tip of a default branch. Definitions are read from the
+// reports computed in the background for the uploads visible from the requested commit. If exported
+// is non-nil, only definitions of symbols with the given visibility are returned. This method also
+// returns the size of the complete result set.
+func (s *Service) GetDeadCode(ctx context.Context, args RequestArgs, requestState RequestState, exported *bool) (_ []DeadCodeAtUpload, _ int, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getDeadCode, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("limit", args.Limit),
+	}})
+	defer endObservation()
+
+	visibleUploads, err := s.getUploadPaths(ctx, args.Path, requestState)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalCount := 0
+	deadCode := make([]DeadCodeAtUpload, 0, args.Limit)
+	for _, visibleUpload := range visibleUploads {
+		trace.AddEvent("TODO Domain Owner", attribute.Int("uploadID", visibleUpload.Upload.ID))
+
+		limit := args.Limit - len(deadCode)
+		if limit < 0 {
+			limit = 0
+		}
+
+		definitions, count, err := s.store.GetDeadCodeDefinitions(ctx, shared.GetDeadCodeDefinitionsArgs{
+			UploadID:   visibleUpload.Upload.ID,
+			PathPrefix: visibleUpload.TargetPathWithoutRoot,
+			Exported:   exported,
+			Limit:      limit,
+		})
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "store.GetDeadCodeDefinitions")
+		}
+		totalCount += count
+
+		for _, definition := range definitions {
+			location, ok, err := s.resolveSymbolDefinition(ctx, args, requestState, symbolDefinition{
+				upload: visibleUpload.Upload,
+				path:   definition.DocumentPath,
+				rng:    definition.Range,
+				symbol: definition.SymbolName,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			if !ok {
+				continue
+			}
+
+			deadCode = append(deadCode, DeadCodeAtUpload{
+				Symbol:   definition.SymbolName,
+				Name:     symbolDisplayName(definition.SymbolName),
+				Exported: definition.Exported,
+				Location: location,
+			})
+		}
+	}
+
+	trace.AddEvent("TODO Domain Owner",
+		attribute.Int("totalCount", totalCount),
+		attribute.Int("numDeadCode", len(deadCode)))
+
+	return deadCode, totalCount, nil
+}
@@ -0,0 +1,96 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestDeadCode(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockStore := NewMockStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockStore, mockUploadSvc, mockGitserverClient)
+
+	// Set up request state
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	uploads := []uploadsshared.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+		{ID: 52, Commit: "deadbeef", Root: "sub3/"},
+	}
+	mockRequestState.SetUploadsDataLoader(uploads)
+
+	definitions := []shared.DeadCodeDefinition{
+		{UploadID: 50, SymbolName: "scip-go gomod example v1 `example`/Unused().", DocumentPath: "a.go", Range: testRange1, Exported: true},
+		{UploadID: 51, SymbolName: "scip-go gomod example v1 `example`/Server#unused().", DocumentPath: "b.go", Range: testRange2},
+		{UploadID: 51, SymbolName: "scip-go gomod example v1 `example`/helper().", DocumentPath: "c.go", Range: testRange3},
+	}
+	mockStore.GetDeadCodeDefinitionsFunc.PushReturn(definitions[0:1], 1, nil)
+	mockStore.GetDeadCodeDefinitionsFunc.PushReturn(definitions[1:], 4, nil)
+	mockStore.GetDeadCodeDefinitionsFunc.PushReturn(nil, 7, nil)
+
+	mockRequest := RequestArgs{
+		RepositoryID: 42,
+		Commit:       mockCommit,
+		Path:         mockPath,
+		Limit:        3,
+	}
+	deadCode, totalCount, err := svc.GetDeadCode(context.Background(), mockRequest, mockRequestState, pointers.Ptr(false))
+	if err != nil {
+		t.Fatalf("unexpected error querying dead code: %s", err)
+	}
+
+	if totalCount != 12 {
+		t.Errorf("unexpected count. want=%d have=%d", 12, totalCount)
+	}
+
+	expectedDeadCode := []DeadCodeAtUpload{
+		{
+			Symbol:   definitions[0].SymbolName,
+			Name:     "Unused",
+			Exported: true,
+			Location: shared.UploadLocation{Dump: uploads[0], Path: "sub1/a.go", TargetCommit: "deadbeef", TargetRange: testRange1},
+		},
+		{
+			Symbol:   definitions[1].SymbolName,
+			Name:     "Server.unused",
+			Location: shared.UploadLocation{Dump: uploads[1], Path: "sub2/b.go", TargetCommit: "deadbeef", TargetRange: testRange2},
+		},
+		{
+			Symbol:   definitions[2].SymbolName,
+			Name:     "helper",
+			Location: shared.UploadLocation{Dump: uploads[1], Path: "sub2/c.go", TargetCommit: "deadbeef", TargetRange: testRange3},
+		},
+	}
+	if diff := cmp.Diff(expectedDeadCode, deadCode); diff != "" {
+		t.Errorf("unexpected dead code (-want +got):\n%s", diff)
+	}
+
+	var limits []int
+	for _, call := range mockStore.GetDeadCodeDefinitionsFunc.History() {
+		if call.Arg1.Exported == nil || *call.Arg1.Exported {
+			t.Errorf("unexpected exported filter. want=%v have=%v", false, call.Arg1.Exported)
+		}
+		limits = append(limits, call.Arg1.Limit)
+	}
+	if diff := cmp.Diff([]int{3, 2, 0}, limits); diff != "" {
+		t.Errorf("unexpected limits (-want +got):\n%s", diff)
+	}
+}
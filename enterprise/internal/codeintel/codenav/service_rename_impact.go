@@ -0,0 +1,305 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// renameImpactReferencesPageSize is the number of references requested at once while collecting
+// the references of the symbol being renamed.
+const renameImpactReferencesPageSize = 500
+
+// RenameImpactMaxLocations is the maximum number of locations a rename impact is computed for.
+const RenameImpactMaxLocations = 10000
+
+// ErrInvalidRenameName occurs when the proposed name of a symbol is empty or contains whitespace.
+var ErrInvalidRenameName = errors.New("new name must be non-empty and must not contain whitespace")
+
+// GetRenameImpact returns the definitions and references of the symbol at the given position that
+// would be touched by renaming it to the given name, grouped by repository and file, along with the
+// unified diff applying the rename to each file. Ranges whose text is not the name of the symbol at
+// the requested position (e.g. aliased imports) are reported as mismatches and left out of the diffs.
+// This method returns nil if there is no symbol at the given position.
+func (s *Service) GetRenameImpact(ctx context.Context, args RequestArgs, requestState RequestState, newName string) (_ *RenameImpact, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getRenameImpact, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.String("newName", newName),
+	}})
+	defer endObservation()
+
+	if newName == "" || strings.IndexFunc(newName, unicode.IsSpace) >= 0 {
+		return nil, ErrInvalidRenameName
+	}
+
+	definitions, err := s.GetDefinitions(ctx, args, requestState)
+	if err != nil {
+		return nil, err
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numDefinitions", len(definitions)))
+
+	var references []shared.UploadLocation
+	cursor := ReferencesCursor{Phase: "local"}
+	for cursor.Phase != "done" {
+		pageArgs := args
+		pageArgs.Limit = renameImpactReferencesPageSize
+
+		var page []shared.UploadLocation
+		page, cursor, err = s.GetReferences(ctx, pageArgs, requestState, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		references = append(references, page...)
+		if len(definitions)+len(references) > RenameImpactMaxLocations {
+			return nil, errors.Newf("symbol has more than %d locations", RenameImpactMaxLocations)
+		}
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numReferences", len(references)))
+
+	impact, err := s.buildRenameImpact(ctx, args, definitions, references, newName)
+	if err != nil || impact == nil {
+		return nil, err
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numRepositories", len(impact.Repositories)))
+
+	return impact, nil
+}
+
+// renameImpactKey identifies the repository and commit at which a location was adjusted.
+type renameImpactKey struct {
+	repositoryID int
+	commit       string
+}
+
+// renameImpactRangeKey identifies a range within a file of a repository and commit.
+type renameImpactRangeKey struct {
+	renameImpactKey
+	path string
+	rng  shared.Range
+}
+
+func newRenameImpactRangeKey(location shared.UploadLocation) renameImpactRangeKey {
+	return renameImpactRangeKey{
+		renameImpactKey: renameImpactKey{repositoryID: location.Dump.RepositoryID, commit: location.TargetCommit},
+		path:            location.Path,
+		rng:             location.TargetRange,
+	}
+}
+
+// buildRenameImpact groups the given locations by repository, commit, and file, and computes the
+// diff of each file. The old name of the symbol is read from the location enclosing the requested
+// position. This method returns nil if no location encloses the requested position.
+func (s *Service) buildRenameImpact(ctx context.Context, args RequestArgs, definitions, references []shared.UploadLocation, newName string) (*RenameImpact, error) {
+	definitionRanges := map[renameImpactRangeKey]struct{}{}
+	for _, location := range definitions {
+		definitionRanges[newRenameImpactRangeKey(location)] = struct{}{}
+	}
+
+	var keys []renameImpactKey
+	repositoryNames := map[renameImpactKey]string{}
+	rangesByFile := map[renameImpactKey]map[string][]shared.Range{}
+	seen := map[renameImpactRangeKey]struct{}{}
+	var requested *shared.UploadLocation
+
+	for _, locations := range [][]shared.UploadLocation{definitions, references} {
+		for i, location := range locations {
+			rangeKey := newRenameImpactRangeKey(location)
+			if _, ok := seen[rangeKey]; ok {
+				continue
+			}
+			seen[rangeKey] = struct{}{}
+
+			key := rangeKey.renameImpactKey
+			if _, ok := rangesByFile[key]; !ok {
+				keys = append(keys, key)
+				repositoryNames[key] = location.Dump.RepositoryName
+				rangesByFile[key] = map[string][]shared.Range{}
+			}
+			rangesByFile[key][location.Path] = append(rangesByFile[key][location.Path], location.TargetRange)
+
+			if requested == nil && isRequestedLocation(args, location) {
+				requested = &locations[i]
+			}
+		}
+	}
+	if requested == nil {
+		return nil, nil
+	}
+
+	oldName, err := s.readRange(ctx, *requested)
+	if err != nil {
+		return nil, err
+	}
+
+	// List the requested repository first, then all others by name
+	sort.SliceStable(keys, func(i, j int) bool {
+		if iRequested, jRequested := keys[i].repositoryID == args.RepositoryID, keys[j].repositoryID == args.RepositoryID; iRequested != jRequested {
+			return iRequested
+		}
+		if repositoryNames[keys[i]] != repositoryNames[keys[j]] {
+			return repositoryNames[keys[i]] < repositoryNames[keys[j]]
+		}
+		return keys[i].commit < keys[j].commit
+	})
+
+	repositories := make([]RenameImpactRepository, 0, len(keys))
+	for _, key := range keys {
+		repository := RenameImpactRepository{
+			RepositoryID:   key.repositoryID,
+			RepositoryName: repositoryNames[key],
+			Commit:         key.commit,
+		}
+
+		paths := make([]string, 0, len(rangesByFile[key]))
+		for path := range rangesByFile[key] {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			content, err := s.gitserver.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, api.RepoName(repository.RepositoryName), api.CommitID(key.commit), path)
+			if err != nil {
+				return nil, errors.Wrap(err, "gitserver.ReadFile")
+			}
+
+			newContent, renamed, mismatches := renameRanges(string(content), rangesByFile[key][path], oldName, newName)
+
+			file := RenameImpactFile{
+				Path:       path,
+				Mismatches: mismatches,
+			}
+			for _, r := range renamed {
+				if _, ok := definitionRanges[renameImpactRangeKey{renameImpactKey: key, path: path, rng: r}]; ok {
+					file.Definitions = append(file.Definitions, r)
+				} else {
+					file.References = append(file.References, r)
+				}
+			}
+			if len(renamed) > 0 {
+				file.Diff = unifiedDiff(path, string(content), newContent)
+			}
+
+			repository.Files = append(repository.Files, file)
+			repository.Diff += file.Diff
+		}
+
+		repositories = append(repositories, repository)
+	}
+
+	return &RenameImpact{
+		OldName:      oldName,
+		NewName:      newName,
+		Repositories: repositories,
+	}, nil
+}
+
+// isRequestedLocation returns true if the given location encloses the requested position.
+func isRequestedLocation(args RequestArgs, location shared.UploadLocation) bool {
+	return location.Dump.RepositoryID == args.RepositoryID &&
+		location.TargetCommit == args.Commit &&
+		location.Path == args.Path &&
+		rangeContainsPosition(location.TargetRange, shared.Position{Line: args.Line, Character: args.Character})
+}
+
+// readRange returns the text of the given single-line location.
+func (s *Service) readRange(ctx context.Context, location shared.UploadLocation) (string, error) {
+	content, err := s.gitserver.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, api.RepoName(location.Dump.RepositoryName), api.CommitID(location.TargetCommit), location.Path)
+	if err != nil {
+		return "", errors.Wrap(err, "gitserver.ReadFile")
+	}
+
+	text, ok := textAtRange(strings.SplitAfter(string(content), "\n"), location.TargetRange)
+	if !ok || text == "" {
+		return "", errors.Newf("no symbol name at %s:%d:%d", location.Path, location.TargetRange.Start.Line, location.TargetRange.Start.Character)
+	}
+
+	return text, nil
+}
+
+// renameRanges replaces each of the given ranges of the given content whose text is the old name
+// with the new name. This method returns the updated content, along with the replaced ranges and the
+// ranges left untouched because their text differs from the old name, both in document order.
+func renameRanges(content string, ranges []shared.Range, oldName, newName string) (_ string, renamed, mismatches []shared.Range) {
+	ranges = append([]shared.Range(nil), ranges...)
+	sort.Slice(ranges, func(i, j int) bool {
+		if cmp := comparePositions(ranges[i].Start, ranges[j].Start); cmp != 0 {
+			return cmp < 0
+		}
+		return comparePositions(ranges[i].End, ranges[j].End) < 0
+	})
+
+	lines := strings.SplitAfter(content, "\n")
+
+	// Replace from the end of the document so that replacing a range does not shift the offsets of
+	// the ranges preceding it on the same line
+	for i := len(ranges) - 1; i >= 0; i-- {
+		r := ranges[i]
+		if text, ok := textAtRange(lines, r); !ok || text != oldName {
+			mismatches = append(mismatches, r)
+			continue
+		}
+
+		runes := []rune(lines[r.Start.Line])
+		lines[r.Start.Line] = string(runes[:r.Start.Character]) + newName + string(runes[r.End.Character:])
+		renamed = append(renamed, r)
+	}
+
+	reverseRanges(renamed)
+	reverseRanges(mismatches)
+	return strings.Join(lines, ""), renamed, mismatches
+}
+
+// textAtRange returns the text of the given single-line range, where characters are counted in
+// runes from the start of the line.
+func textAtRange(lines []string, r shared.Range) (string, bool) {
+	if r.Start.Line != r.End.Line || r.Start.Line < 0 || r.Start.Line >= len(lines) {
+		return "", false
+	}
+
+	runes := []rune(strings.TrimRight(lines[r.Start.Line], "\r\n"))
+	if r.Start.Character < 0 || r.Start.Character > r.End.Character || r.End.Character > len(runes) {
+		return "", false
+	}
+
+	return string(runes[r.Start.Character:r.End.Character]), true
+}
+
+func comparePositions(a, b shared.Position) int {
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+
+	return a.Character - b.Character
+}
+
+func reverseRanges(ranges []shared.Range) {
+	for i, j := 0, len(ranges)-1; i < j; i, j = i+1, j-1 {
+		ranges[i], ranges[j] = ranges[j], ranges[i]
+	}
+}
+
+// unifiedDiff returns a git-style unified diff of the given file, as accepted by changeset specs.
+func unifiedDiff(path, before, after string) string {
+	edits := myers.ComputeEdits(span.URIFromPath(path), before, after)
+	return fmt.Sprintf("diff --git a/%s b/%s\n%s", path, path, gotextdiff.ToUnified("a/"+path, "b/"+path, before, edits))
+}
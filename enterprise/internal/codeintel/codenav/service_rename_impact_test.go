@@ -0,0 +1,186 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestRenameImpact(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	files := map[string]string{
+		"github.com/sourcegraph/foo@deadbeef:foo.go":  "package foo\n\nfunc Bar() {}\n\nvar _ = Bar\n",
+		"github.com/sourcegraph/baz@cafebabe:main.go": "package main\n\nimport Baz \"github.com/sourcegraph/foo\"\n\nfunc main() { Baz.Bar(); Baz.Bar() }\n",
+	}
+	mockGitserverClient.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, path string) ([]byte, error) {
+		content, ok := files[string(repo)+"@"+string(commit)+":"+path]
+		if !ok {
+			return nil, errors.Newf("unexpected file %s@%s:%s", repo, commit, path)
+		}
+		return []byte(content), nil
+	})
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, nil, mockUploadSvc, mockGitserverClient)
+
+	foo := uploadsshared.Dump{ID: 50, RepositoryID: 42, RepositoryName: "github.com/sourcegraph/foo", Commit: "deadbeef"}
+	baz := uploadsshared.Dump{ID: 51, RepositoryID: 43, RepositoryName: "github.com/sourcegraph/baz", Commit: "cafebabe"}
+
+	definition := shared.UploadLocation{Dump: foo, Path: "foo.go", TargetCommit: "deadbeef", TargetRange: singleLineRange(2, 5, 8)}
+	definitions := []shared.UploadLocation{definition}
+	references := []shared.UploadLocation{
+		{Dump: baz, Path: "main.go", TargetCommit: "cafebabe", TargetRange: singleLineRange(4, 29, 32)},
+		{Dump: baz, Path: "main.go", TargetCommit: "cafebabe", TargetRange: singleLineRange(4, 18, 21)},
+		{Dump: baz, Path: "main.go", TargetCommit: "cafebabe", TargetRange: singleLineRange(2, 7, 10)},
+		definition,
+		{Dump: foo, Path: "foo.go", TargetCommit: "deadbeef", TargetRange: singleLineRange(4, 8, 11)},
+	}
+
+	mockRequest := RequestArgs{
+		RepositoryID: 42,
+		Commit:       "deadbeef",
+		Path:         "foo.go",
+		Line:         4,
+		Character:    9,
+	}
+	impact, err := svc.buildRenameImpact(context.Background(), mockRequest, definitions, references, "Qux")
+	if err != nil {
+		t.Fatalf("unexpected error computing rename impact: %s", err)
+	}
+
+	fooDiff := "diff --git a/foo.go b/foo.go\n" +
+		"--- a/foo.go\n" +
+		"+++ b/foo.go\n" +
+		"@@ -1,5 +1,5 @@\n" +
+		" package foo\n" +
+		" \n" +
+		"-func Bar() {}\n" +
+		"+func Qux() {}\n" +
+		" \n" +
+		"-var _ = Bar\n" +
+		"+var _ = Qux\n"
+	mainDiff := "diff --git a/main.go b/main.go\n" +
+		"--- a/main.go\n" +
+		"+++ b/main.go\n" +
+		"@@ -2,4 +2,4 @@\n" +
+		" \n" +
+		" import Baz \"github.com/sourcegraph/foo\"\n" +
+		" \n" +
+		"-func main() { Baz.Bar(); Baz.Bar() }\n" +
+		"+func main() { Baz.Qux(); Baz.Qux() }\n"
+
+	expectedImpact := &RenameImpact{
+		OldName: "Bar",
+		NewName: "Qux",
+		Repositories: []RenameImpactRepository{
+			{
+				RepositoryID:   42,
+				RepositoryName: "github.com/sourcegraph/foo",
+				Commit:         "deadbeef",
+				Files: []RenameImpactFile{
+					{
+						Path:        "foo.go",
+						Definitions: []shared.Range{singleLineRange(2, 5, 8)},
+						References:  []shared.Range{singleLineRange(4, 8, 11)},
+						Diff:        fooDiff,
+					},
+				},
+				Diff: fooDiff,
+			},
+			{
+				RepositoryID:   43,
+				RepositoryName: "github.com/sourcegraph/baz",
+				Commit:         "cafebabe",
+				Files: []RenameImpactFile{
+					{
+						Path:       "main.go",
+						References: []shared.Range{singleLineRange(4, 18, 21), singleLineRange(4, 29, 32)},
+						Mismatches: []shared.Range{singleLineRange(2, 7, 10)},
+						Diff:       mainDiff,
+					},
+				},
+				Diff: mainDiff,
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedImpact, impact); diff != "" {
+		t.Errorf("unexpected rename impact (-want +got):\n%s", diff)
+	}
+
+	// No location encloses the requested position
+	mockRequest.Line = 3
+	if impact, err := svc.buildRenameImpact(context.Background(), mockRequest, definitions, references, "Qux"); err != nil {
+		t.Fatalf("unexpected error computing rename impact: %s", err)
+	} else if impact != nil {
+		t.Errorf("unexpected rename impact. want=nil have=%v", impact)
+	}
+}
+
+func TestRenameImpactInvalidName(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, NewMockLsifStore(), nil, NewMockUploadService(), mockGitserverClient)
+
+	// Set up request state
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetUploadsDataLoader(nil)
+
+	for _, newName := range []string{"", "foo bar", "foo\n"} {
+		if _, err := svc.GetRenameImpact(context.Background(), RequestArgs{}, mockRequestState, newName); !errors.Is(err, ErrInvalidRenameName) {
+			t.Errorf("unexpected error for name %q. want=%q have=%q", newName, ErrInvalidRenameName, err)
+		}
+	}
+}
+
+func TestRenameRanges(t *testing.T) {
+	content := "héllo := hé + hé\nx := hé\r\n"
+	ranges := []shared.Range{
+		singleLineRange(1, 5, 7),
+		singleLineRange(0, 14, 16),
+		singleLineRange(0, 9, 11),
+		singleLineRange(0, 0, 5),
+		{Start: shared.Position{Line: 0, Character: 0}, End: shared.Position{Line: 1, Character: 2}},
+		singleLineRange(5, 0, 2),
+	}
+
+	newContent, renamed, mismatches := renameRanges(content, ranges, "hé", "world")
+	if expected := "héllo := world + world\nx := world\r\n"; newContent != expected {
+		t.Errorf("unexpected content. want=%q have=%q", expected, newContent)
+	}
+	if diff := cmp.Diff([]shared.Range{singleLineRange(0, 9, 11), singleLineRange(0, 14, 16), singleLineRange(1, 5, 7)}, renamed); diff != "" {
+		t.Errorf("unexpected renamed ranges (-want +got):\n%s", diff)
+	}
+	expectedMismatches := []shared.Range{
+		singleLineRange(0, 0, 5),
+		{Start: shared.Position{Line: 0, Character: 0}, End: shared.Position{Line: 1, Character: 2}},
+		singleLineRange(5, 0, 2),
+	}
+	if diff := cmp.Diff(expectedMismatches, mismatches); diff != "" {
+		t.Errorf("unexpected mismatched ranges (-want +got):\n%s", diff)
+	}
+}
+
+func singleLineRange(line, startCharacter, endCharacter int) shared.Range {
+	return shared.Range{
+		Start: shared.Position{Line: line, Character: startCharacter},
+		End:   shared.Position{Line: line, Character: endCharacter},
+	}
+}
@@ -0,0 +1,195 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// symbolDefinition is the definition of a symbol within an upload. The path and range are
+// relative to the root and the indexed commit of the upload.
+type symbolDefinition struct {
+	upload uploadsshared.Dump
+	path   string
+	rng    shared.Range
+	symbol string
+}
+
+func (d symbolDefinition) key() string {
+	return fmt.Sprintf("%d:%s", d.upload.ID, d.symbol)
+}
+
+func (d symbolDefinition) visibleUpload() visibleUpload {
+	return visibleUpload{
+		Upload:                d.upload,
+		TargetPath:            d.path,
+		TargetPosition:        d.rng.Start,
+		TargetPathWithoutRoot: d.path,
+	}
+}
+
+// getSymbolDefinitionsAtPosition returns the definitions of the symbol at the requested position
+// for which include returns true.
+func (s *Service) getSymbolDefinitionsAtPosition(ctx context.Context, args RequestArgs, requestState RequestState, documents *scipDocumentCache, include func(symbol string) bool) ([]symbolDefinition, error) {
+	visibleUploads, err := s.getVisibleUploads(ctx, args.Line, args.Character, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []symbolDefinition
+	seen := map[string]struct{}{}
+	for i := range visibleUploads {
+		uploadDefinitions, err := s.getSymbolDefinitions(ctx, requestState, documents, visibleUploads[i].Upload, visibleUploads[i].TargetPathWithoutRoot, visibleUploads[i].TargetPosition, include)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, definition := range uploadDefinitions {
+			if _, ok := seen[definition.key()]; !ok {
+				seen[definition.key()] = struct{}{}
+				definitions = append(definitions, definition)
+			}
+		}
+	}
+
+	return definitions, nil
+}
+
+// getSymbolDefinitions returns the definitions of the symbols referenced at the given position
+// within an upload for which include returns true. Like GetDefinitions, definitions within the
+// same upload are preferred over the ones found by a moniker search over other uploads.
+func (s *Service) getSymbolDefinitions(ctx context.Context, requestState RequestState, documents *scipDocumentCache, upload uploadsshared.Dump, path string, position shared.Position, include func(symbol string) bool) ([]symbolDefinition, error) {
+	locations, _, err := s.lsifstore.GetDefinitionLocations(ctx, upload.ID, path, position.Line, position.Character, DefinitionsLimit, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.GetDefinitionLocations")
+	}
+
+	if len(locations) == 0 {
+		orderedMonikers, err := s.getOrderedMonikers(ctx, []visibleUpload{{Upload: upload, TargetPathWithoutRoot: path, TargetPosition: position}}, "import")
+		if err != nil {
+			return nil, err
+		}
+
+		if len(orderedMonikers) > 0 {
+			uploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, orderedMonikers, requestState)
+			if err != nil {
+				return nil, err
+			}
+
+			locations, _, err = s.getBulkMonikerLocations(ctx, uploads, orderedMonikers, "definitions", DefinitionsLimit, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return s.getSymbolDefinitionsAtLocations(ctx, requestState, documents, locations, include)
+}
+
+// getSymbolDefinitionsAtLocations returns the symbols defined at exactly the given locations for
+// which include returns true. Locations within uploads that are not known to the request are skipped.
+func (s *Service) getSymbolDefinitionsAtLocations(ctx context.Context, requestState RequestState, documents *scipDocumentCache, locations []shared.Location, include func(symbol string) bool) ([]symbolDefinition, error) {
+	definitions := make([]symbolDefinition, 0, len(locations))
+	for _, location := range locations {
+		upload, ok := requestState.dataLoader.GetUploadFromCacheMap(location.DumpID)
+		if !ok {
+			continue
+		}
+
+		document, err := documents.get(ctx, location.DumpID, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range document.Occurrences {
+			if isDefinitionOccurrence(occurrence) && include(occurrence.Symbol) && rangeFromSCIP(occurrence.Range) == location.Range {
+				definitions = append(definitions, symbolDefinition{
+					upload: upload,
+					path:   location.Path,
+					rng:    location.Range,
+					symbol: occurrence.Symbol,
+				})
+				break
+			}
+		}
+	}
+
+	return definitions, nil
+}
+
+// getRemoteSymbolLocations returns the locations of the given table (references or implementations)
+// attached to the given symbol in other uploads, found by a moniker search. Only the first batch of
+// uploads referencing the symbol is searched.
+func (s *Service) getRemoteSymbolLocations(ctx context.Context, args RequestArgs, requestState RequestState, definition symbolDefinition, tableName string, limit int) ([]shared.Location, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	orderedMonikers, err := s.getOrderedMonikers(ctx, []visibleUpload{definition.visibleUpload()}, "import", "export")
+	if err != nil {
+		return nil, err
+	}
+	if len(orderedMonikers) == 0 {
+		return nil, nil
+	}
+
+	uploadIDs, _, _, err := s.uploadSvc.GetUploadIDsWithReferences(
+		ctx,
+		orderedMonikers,
+		[]int{definition.upload.ID},
+		args.RepositoryID,
+		args.Commit,
+		requestState.maximumIndexesPerMonikerSearch,
+		0,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "uploadSvc.GetUploadIDsWithReferences")
+	}
+
+	uploads, err := s.getUploadsByIDs(ctx, uploadIDs, requestState)
+	if err != nil {
+		return nil, err
+	}
+	if len(uploads) == 0 {
+		return nil, nil
+	}
+
+	locations, _, err := s.getBulkMonikerLocations(ctx, uploads, orderedMonikers, tableName, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// resolveSymbolDefinition translates the location of the given definition into the requested commit.
+// A false-valued flag is returned if the definition is hidden from the current user.
+func (s *Service) resolveSymbolDefinition(ctx context.Context, args RequestArgs, requestState RequestState, definition symbolDefinition) (shared.UploadLocation, bool, error) {
+	location, _, err := s.getUploadLocation(ctx, args, requestState, definition.upload, shared.Location{
+		DumpID: definition.upload.ID,
+		Path:   definition.path,
+		Range:  definition.rng,
+	})
+	if err != nil {
+		return shared.UploadLocation{}, false, err
+	}
+
+	// 🚨 SECURITY: Hide symbols defined in paths the current user can't see.
+	if authz.SubRepoEnabled(requestState.authChecker) {
+		include, err := authz.FilterActorPath(ctx, requestState.authChecker, actor.FromContext(ctx), api.RepoName(location.Dump.RepositoryName), location.Path)
+		if err != nil || !include {
+			return shared.UploadLocation{}, false, err
+		}
+	}
+
+	return location, true, nil
+}
@@ -0,0 +1,51 @@
+package codenav
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GetSymbolReferences returns the locations within the given upload that reference any of the
+// given SCIP symbol names. This method also returns the size of the complete result set.
+func (s *Service) GetSymbolReferences(ctx context.Context, uploadID int, symbolNames []string, limit int) (_ []shared.Location, _ int, err error) {
+	ctx, _, endObservation := s.operations.getSymbolReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.Int("numSymbolNames", len(symbolNames)),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	monikers := make([]precise.MonikerData, 0, len(symbolNames))
+	for _, symbolName := range symbolNames {
+		monikers = append(monikers, precise.MonikerData{Kind: "import", Identifier: symbolName})
+	}
+
+	locations, totalCount, err := s.lsifstore.GetBulkMonikerLocations(ctx, "references", []int{uploadID}, monikers, limit, 0)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "lsifstore.GetBulkMonikerLocations")
+	}
+
+	return locations, totalCount, nil
+}
+
+// HasSymbolData determines if the given upload defines or references any symbol. Uploads without
+// symbol data cannot be used to conclude that a symbol is not referenced.
+func (s *Service) HasSymbolData(ctx context.Context, uploadID int) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.hasSymbolData.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	exists, err := s.lsifstore.HasSymbolData(ctx, uploadID)
+	if err != nil {
+		return false, errors.Wrap(err, "lsifstore.HasSymbolData")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,53 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetSymbolReferences(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, nil, mockUploadSvc, nil)
+
+	locations := []shared.Location{
+		{DumpID: 42, Path: "main.go", Range: testRange1},
+		{DumpID: 42, Path: "util.go", Range: testRange2},
+	}
+	mockLsifStore.GetBulkMonikerLocationsFunc.PushReturn(locations, 5, nil)
+
+	symbolNames := []string{"scip-go gomod github.com/foo/bar v1.0.0 `github.com/foo/bar`/Baz()."}
+	refs, totalCount, err := svc.GetSymbolReferences(context.Background(), 42, symbolNames, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(locations, refs); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+	if totalCount != 5 {
+		t.Errorf("unexpected total count. want=%d have=%d", 5, totalCount)
+	}
+
+	history := mockLsifStore.GetBulkMonikerLocationsFunc.History()
+	if len(history) != 1 {
+		t.Fatalf("unexpected number of calls. want=%d have=%d", 1, len(history))
+	}
+	if history[0].Arg1 != "references" {
+		t.Errorf("unexpected table name. want=%q have=%q", "references", history[0].Arg1)
+	}
+	if diff := cmp.Diff([]int{42}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+	if len(history[0].Arg3) != 1 || history[0].Arg3[0].Identifier != symbolNames[0] {
+		t.Errorf("unexpected monikers: %v", history[0].Arg3)
+	}
+}
@@ -0,0 +1,299 @@
+package codenav
+
+import (
+	"context"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// TypeHierarchyMaxDepth is the maximum number of levels a type hierarchy is expanded to.
+const TypeHierarchyMaxDepth = 10
+
+// typeHierarchyMaxItems is the maximum number of items within a single type hierarchy. The
+// expansion stops once it's reached, even if the requested depth hasn't been reached yet.
+const typeHierarchyMaxItems = 1000
+
+// typeHierarchyImplementationsLimit is the maximum number of implementations of a single type
+// that are searched for its subtypes.
+const typeHierarchyImplementationsLimit = 1000
+
+// GetSupertypes returns the type hierarchy rooted at the definitions of the type at the given
+// position, in which the children of each type are the types it implements or extends, up to
+// the given depth. Supertypes are read from the implementation relationships of SCIP symbols.
+func (s *Service) GetSupertypes(ctx context.Context, args RequestArgs, requestState RequestState, depth int) (_ []TypeHierarchyItem, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getSupertypes, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	return s.getTypeHierarchy(ctx, args, requestState, depth, true, trace)
+}
+
+// GetSubtypes returns the type hierarchy rooted at the definitions of the type at the given
+// position, in which the children of each type are the types implementing or extending it, up
+// to the given depth. Subtypes in other repositories are found by a moniker search.
+func (s *Service) GetSubtypes(ctx context.Context, args RequestArgs, requestState RequestState, depth int) (_ []TypeHierarchyItem, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getSubtypes, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	return s.getTypeHierarchy(ctx, args, requestState, depth, false, trace)
+}
+
+// typeHierarchyBuilder expands a type hierarchy depth-first. The related types of each type are
+// only looked up once, even if the type occurs multiple times within the tree.
+type typeHierarchyBuilder struct {
+	s            *Service
+	args         RequestArgs
+	requestState RequestState
+	documents    *scipDocumentCache
+	supertypes   bool
+	related      map[string][]symbolDefinition
+	ancestors    map[string]struct{}
+	numItems     int
+}
+
+// getTypeHierarchy expands the type hierarchy of the type at the given position in the direction
+// of supertypes if supertypes is true, and of subtypes otherwise.
+func (s *Service) getTypeHierarchy(ctx context.Context, args RequestArgs, requestState RequestState, depth int, supertypes bool, trace observation.TraceLogger) ([]TypeHierarchyItem, error) {
+	if depth < 1 {
+		depth = 1
+	} else if depth > TypeHierarchyMaxDepth {
+		depth = TypeHierarchyMaxDepth
+	}
+
+	documents := newSCIPDocumentCache(s.lsifstore)
+
+	roots, err := s.getSymbolDefinitionsAtPosition(ctx, args, requestState, documents, isGlobalSymbol)
+	if err != nil {
+		return nil, err
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numRoots", len(roots)))
+
+	b := &typeHierarchyBuilder{
+		s:            s,
+		args:         args,
+		requestState: requestState,
+		documents:    documents,
+		supertypes:   supertypes,
+		related:      map[string][]symbolDefinition{},
+		ancestors:    map[string]struct{}{},
+	}
+
+	items := make([]TypeHierarchyItem, 0, len(roots))
+	for _, root := range roots {
+		item, ok, err := b.expand(ctx, root, depth)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			items = append(items, item)
+		}
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numItems", b.numItems), attribute.Bool("truncated", b.numItems >= typeHierarchyMaxItems))
+
+	return items, nil
+}
+
+// expand returns the item for the given definition along with its related types up to the given
+// depth. A false-valued flag is returned if the definition is hidden from the current user.
+func (b *typeHierarchyBuilder) expand(ctx context.Context, definition symbolDefinition, depth int) (TypeHierarchyItem, bool, error) {
+	location, ok, err := b.s.resolveSymbolDefinition(ctx, b.args, b.requestState, definition)
+	if err != nil || !ok {
+		return TypeHierarchyItem{}, false, err
+	}
+	b.numItems++
+
+	item := TypeHierarchyItem{
+		Symbol:   definition.symbol,
+		Name:     symbolDisplayName(definition.symbol),
+		Location: location,
+	}
+
+	key := definition.key()
+	if _, ok := b.ancestors[key]; ok {
+		item.Cycle = true
+		return item, true, nil
+	}
+	if depth == 0 || b.numItems >= typeHierarchyMaxItems {
+		return item, true, nil
+	}
+
+	related, ok := b.related[key]
+	if !ok {
+		if b.supertypes {
+			related, err = b.s.getSupertypeDefinitions(ctx, b.requestState, b.documents, definition)
+		} else {
+			related, err = b.s.getSubtypeDefinitions(ctx, b.args, b.requestState, b.documents, definition)
+		}
+		if err != nil {
+			return TypeHierarchyItem{}, false, err
+		}
+		b.related[key] = related
+	}
+
+	b.ancestors[key] = struct{}{}
+	defer delete(b.ancestors, key)
+
+	for _, relatedDefinition := range related {
+		if b.numItems >= typeHierarchyMaxItems {
+			break
+		}
+
+		child, ok, err := b.expand(ctx, relatedDefinition, depth-1)
+		if err != nil {
+			return TypeHierarchyItem{}, false, err
+		}
+		if ok {
+			item.Children = append(item.Children, child)
+		}
+	}
+
+	return item, true, nil
+}
+
+// getSupertypeDefinitions returns the definitions of the symbols the given symbol has an
+// implementation relationship with. Definitions within the same upload are preferred over the
+// ones found by a moniker search over other uploads.
+func (s *Service) getSupertypeDefinitions(ctx context.Context, requestState RequestState, documents *scipDocumentCache, definition symbolDefinition) ([]symbolDefinition, error) {
+	document, err := documents.get(ctx, definition.upload.ID, definition.path)
+	if err != nil || document == nil {
+		return nil, err
+	}
+
+	symbol := scip.FindSymbol(document, definition.symbol)
+	if symbol == nil {
+		return nil, nil
+	}
+
+	var supertypes []symbolDefinition
+	for _, relationship := range symbol.Relationships {
+		if !relationship.IsImplementation || !isGlobalSymbol(relationship.Symbol) {
+			continue
+		}
+
+		moniker, err := symbolMoniker(relationship.Symbol, precise.Import)
+		if err != nil {
+			// Skip symbols we can't parse rather than failing the entire hierarchy
+			continue
+		}
+		orderedMonikers := []precise.QualifiedMonikerData{moniker}
+
+		locations, _, err := s.getBulkMonikerLocations(ctx, []uploadsshared.Dump{definition.upload}, orderedMonikers, "definitions", DefinitionsLimit, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(locations) == 0 {
+			uploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, orderedMonikers, requestState)
+			if err != nil {
+				return nil, err
+			}
+
+			locations, _, err = s.getBulkMonikerLocations(ctx, uploads, orderedMonikers, "definitions", DefinitionsLimit, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		definitions, err := s.getSymbolDefinitionsAtLocations(ctx, requestState, documents, locations, func(symbol string) bool {
+			return symbol == relationship.Symbol
+		})
+		if err != nil {
+			return nil, err
+		}
+		supertypes = append(supertypes, definitions...)
+	}
+
+	return supertypes, nil
+}
+
+// getSubtypeDefinitions returns the definitions of the symbols that have an implementation
+// relationship with the given symbol, within the same upload and within the uploads referencing
+// the symbol.
+func (s *Service) getSubtypeDefinitions(ctx context.Context, args RequestArgs, requestState RequestState, documents *scipDocumentCache, definition symbolDefinition) ([]symbolDefinition, error) {
+	moniker, err := symbolMoniker(definition.symbol, precise.Export)
+	if err != nil {
+		// Symbols we can't parse have no subtypes we can search for
+		return nil, nil
+	}
+
+	locations, _, err := s.getBulkMonikerLocations(ctx, []uploadsshared.Dump{definition.upload}, []precise.QualifiedMonikerData{moniker}, "implementations", typeHierarchyImplementationsLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteLocations, err := s.getRemoteSymbolLocations(ctx, args, requestState, definition, "implementations", typeHierarchyImplementationsLimit-len(locations))
+	if err != nil {
+		return nil, err
+	}
+	locations = append(locations, remoteLocations...)
+
+	var subtypes []symbolDefinition
+	seen := map[string]struct{}{}
+	for _, location := range locations {
+		document, err := documents.get(ctx, location.DumpID, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		// Implementation ranges are the definition ranges of the implementing symbols, which
+		// may be shared with the definitions of other symbols (e.g., constructors).
+		definitions, err := s.getSymbolDefinitionsAtLocations(ctx, requestState, documents, []shared.Location{location}, func(symbol string) bool {
+			return symbol != definition.symbol && implementsSymbol(document, symbol, definition.symbol)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, subtype := range definitions {
+			if _, ok := seen[subtype.key()]; !ok {
+				seen[subtype.key()] = struct{}{}
+				subtypes = append(subtypes, subtype)
+			}
+		}
+	}
+
+	return subtypes, nil
+}
+
+// implementsSymbol returns true if the given symbol of the document has an implementation
+// relationship with the target symbol.
+func implementsSymbol(document *scip.Document, symbol, target string) bool {
+	information := scip.FindSymbol(document, symbol)
+	if information == nil {
+		return false
+	}
+
+	for _, relationship := range information.Relationships {
+		if relationship.IsImplementation && relationship.Symbol == target {
+			return true
+		}
+	}
+
+	return false
+}
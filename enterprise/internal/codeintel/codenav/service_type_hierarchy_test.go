@@ -0,0 +1,288 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	typeHierarchyShape   = "scip-java maven example 1.0 shapes/Shape#"
+	typeHierarchyPolygon = "scip-java maven example 1.0 shapes/Polygon#"
+	typeHierarchySquare  = "scip-java maven example 1.0 shapes/Square#"
+	typeHierarchyCircle  = "scip-java maven example 1.0 shapes/Circle#"
+	typeHierarchyA       = "scip-java maven example 1.0 shapes/A#"
+	typeHierarchyB       = "scip-java maven example 1.0 shapes/B#"
+	typeHierarchyBase    = "scip-java maven lib 2.0 base/Base#"
+)
+
+// typeHierarchyDocument describes the following file:
+//
+//	interface Shape {}                           // line 0
+//	class Polygon implements Shape {}            // line 2
+//	class Square extends Polygon {}              // line 4
+//	class Circle implements Shape, lib.Base {}   // line 6
+//	interface A extends B {}                     // line 8
+//	interface B extends A {}                     // line 9
+var typeHierarchyDocument = &scip.Document{
+	RelativePath: "shapes.java",
+	Occurrences: []*scip.Occurrence{
+		{Range: []int32{0, 10, 15}, Symbol: typeHierarchyShape, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{2, 6, 13}, Symbol: typeHierarchyPolygon, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{2, 25, 30}, Symbol: typeHierarchyShape},
+		{Range: []int32{4, 6, 12}, Symbol: typeHierarchySquare, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{4, 21, 28}, Symbol: typeHierarchyPolygon},
+		{Range: []int32{6, 6, 12}, Symbol: typeHierarchyCircle, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{6, 24, 29}, Symbol: typeHierarchyShape},
+		{Range: []int32{6, 35, 39}, Symbol: typeHierarchyBase},
+		{Range: []int32{8, 10, 11}, Symbol: typeHierarchyA, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{8, 20, 21}, Symbol: typeHierarchyB},
+		{Range: []int32{9, 10, 11}, Symbol: typeHierarchyB, SymbolRoles: int32(scip.SymbolRole_Definition)},
+		{Range: []int32{9, 20, 21}, Symbol: typeHierarchyA},
+	},
+	Symbols: []*scip.SymbolInformation{
+		{Symbol: typeHierarchyShape},
+		{Symbol: typeHierarchyPolygon, Relationships: []*scip.Relationship{{Symbol: typeHierarchyShape, IsImplementation: true}}},
+		{Symbol: typeHierarchySquare, Relationships: []*scip.Relationship{{Symbol: typeHierarchyPolygon, IsImplementation: true}}},
+		{Symbol: typeHierarchyCircle, Relationships: []*scip.Relationship{
+			{Symbol: typeHierarchyShape, IsImplementation: true},
+			{Symbol: typeHierarchyBase, IsImplementation: true},
+		}},
+		{Symbol: typeHierarchyA, Relationships: []*scip.Relationship{{Symbol: typeHierarchyB, IsImplementation: true}}},
+		{Symbol: typeHierarchyB, Relationships: []*scip.Relationship{{Symbol: typeHierarchyA, IsImplementation: true}}},
+	},
+}
+
+// typeHierarchyBaseDocument is indexed in a dependency of the repository.
+var typeHierarchyBaseDocument = &scip.Document{
+	RelativePath: "base.java",
+	Occurrences: []*scip.Occurrence{
+		{Range: []int32{0, 17, 21}, Symbol: typeHierarchyBase, SymbolRoles: int32(scip.SymbolRole_Definition)},
+	},
+	Symbols: []*scip.SymbolInformation{
+		{Symbol: typeHierarchyBase},
+	},
+}
+
+func TestSupertypes(t *testing.T) {
+	svc, requestState, uploads := newTypeHierarchyTestService()
+
+	items, err := svc.GetSupertypes(context.Background(), typeHierarchyTestRequest(4, 8), requestState, 3)
+	if err != nil {
+		t.Fatalf("unexpected error querying supertypes: %s", err)
+	}
+
+	expectedItems := []TypeHierarchyItem{
+		typeHierarchyTestItem(uploads[0], typeHierarchySquare, "Square", 4, 6, 12,
+			typeHierarchyTestItem(uploads[0], typeHierarchyPolygon, "Polygon", 2, 6, 13,
+				typeHierarchyTestItem(uploads[0], typeHierarchyShape, "Shape", 0, 10, 15),
+			),
+		),
+	}
+	if diff := cmp.Diff(expectedItems, items); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func TestSupertypesRemote(t *testing.T) {
+	svc, requestState, uploads := newTypeHierarchyTestService()
+
+	items, err := svc.GetSupertypes(context.Background(), typeHierarchyTestRequest(6, 8), requestState, 1)
+	if err != nil {
+		t.Fatalf("unexpected error querying supertypes: %s", err)
+	}
+
+	expectedItems := []TypeHierarchyItem{
+		typeHierarchyTestItem(uploads[0], typeHierarchyCircle, "Circle", 6, 6, 12,
+			typeHierarchyTestItem(uploads[0], typeHierarchyShape, "Shape", 0, 10, 15),
+			typeHierarchyTestItem(uploads[1], typeHierarchyBase, "Base", 0, 17, 21),
+		),
+	}
+	if diff := cmp.Diff(expectedItems, items); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func TestSubtypes(t *testing.T) {
+	svc, requestState, uploads := newTypeHierarchyTestService()
+
+	items, err := svc.GetSubtypes(context.Background(), typeHierarchyTestRequest(0, 12), requestState, 5)
+	if err != nil {
+		t.Fatalf("unexpected error querying subtypes: %s", err)
+	}
+
+	expectedItems := []TypeHierarchyItem{
+		typeHierarchyTestItem(uploads[0], typeHierarchyShape, "Shape", 0, 10, 15,
+			typeHierarchyTestItem(uploads[0], typeHierarchyPolygon, "Polygon", 2, 6, 13,
+				typeHierarchyTestItem(uploads[0], typeHierarchySquare, "Square", 4, 6, 12),
+			),
+			typeHierarchyTestItem(uploads[0], typeHierarchyCircle, "Circle", 6, 6, 12),
+		),
+	}
+	if diff := cmp.Diff(expectedItems, items); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypeHierarchyCycle(t *testing.T) {
+	svc, requestState, uploads := newTypeHierarchyTestService()
+
+	items, err := svc.GetSubtypes(context.Background(), typeHierarchyTestRequest(8, 10), requestState, 5)
+	if err != nil {
+		t.Fatalf("unexpected error querying subtypes: %s", err)
+	}
+
+	cycle := typeHierarchyTestItem(uploads[0], typeHierarchyA, "A", 8, 10, 11)
+	cycle.Cycle = true
+
+	expectedItems := []TypeHierarchyItem{
+		typeHierarchyTestItem(uploads[0], typeHierarchyA, "A", 8, 10, 11,
+			typeHierarchyTestItem(uploads[0], typeHierarchyB, "B", 9, 10, 11, cycle),
+		),
+	}
+	if diff := cmp.Diff(expectedItems, items); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func newTypeHierarchyTestService() (*Service, RequestState, []uploadsshared.Dump) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, nil, mockUploadSvc, mockGitserverClient)
+
+	uploads := []uploadsshared.Dump{
+		{ID: 50, Commit: mockCommit, Root: "sub1/"},
+		{ID: 52, RepositoryID: 52, Commit: "cafebabe", Root: "lib/"},
+	}
+	documents := map[int]*scip.Document{
+		uploads[0].ID: typeHierarchyDocument,
+		uploads[1].ID: typeHierarchyBaseDocument,
+	}
+
+	requestState := RequestState{}
+	requestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	requestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	requestState.SetUploadsDataLoader(uploads[:1])
+
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for range rcs {
+			exists = append(exists, true)
+		}
+		return exists, nil
+	})
+	mockUploadSvc.GetDumpsWithDefinitionsForMonikersFunc.SetDefaultHook(func(_ context.Context, monikers []precise.QualifiedMonikerData) ([]uploadsshared.Dump, error) {
+		for _, moniker := range monikers {
+			if moniker.Identifier == typeHierarchyBase && moniker.Name == "lib" && moniker.Version == "2.0" {
+				return []uploadsshared.Dump{uploads[1]}, nil
+			}
+		}
+		return nil, nil
+	})
+
+	mockLsifStore.SCIPDocumentFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string) (*scip.Document, error) {
+		if document, ok := documents[uploadID]; ok && document.RelativePath == path {
+			return document, nil
+		}
+		return nil, nil
+	})
+	mockLsifStore.GetDefinitionLocationsFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string, line, character, _, _ int) ([]shared.Location, int, error) {
+		document, ok := documents[uploadID]
+		if !ok {
+			return nil, 0, nil
+		}
+
+		var symbol string
+		for _, occurrence := range document.Occurrences {
+			if rangeContainsPosition(rangeFromSCIP(occurrence.Range), shared.Position{Line: line, Character: character}) {
+				symbol = occurrence.Symbol
+			}
+		}
+
+		locations := typeHierarchyTestLocations(uploadID, document, func(occurrence *scip.Occurrence) bool { return occurrence.Symbol == symbol })
+		return locations, len(locations), nil
+	})
+	mockLsifStore.GetBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, uploadIDs []int, monikers []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		var locations []shared.Location
+		for _, uploadID := range uploadIDs {
+			document, ok := documents[uploadID]
+			if !ok {
+				continue
+			}
+
+			for _, moniker := range monikers {
+				locations = append(locations, typeHierarchyTestLocations(uploadID, document, func(occurrence *scip.Occurrence) bool {
+					if tableName == "implementations" {
+						return implementsSymbol(document, occurrence.Symbol, moniker.Identifier)
+					}
+					return occurrence.Symbol == moniker.Identifier
+				})...)
+			}
+		}
+
+		return locations, len(locations), nil
+	})
+
+	return svc, requestState, uploads
+}
+
+// typeHierarchyTestLocations returns the locations of the definitions within the given document
+// that match the given predicate.
+func typeHierarchyTestLocations(uploadID int, document *scip.Document, match func(occurrence *scip.Occurrence) bool) []shared.Location {
+	var locations []shared.Location
+	for _, occurrence := range document.Occurrences {
+		if isDefinitionOccurrence(occurrence) && match(occurrence) {
+			locations = append(locations, shared.Location{DumpID: uploadID, Path: document.RelativePath, Range: rangeFromSCIP(occurrence.Range)})
+		}
+	}
+
+	return locations
+}
+
+func typeHierarchyTestRequest(line, character int) RequestArgs {
+	return RequestArgs{
+		RepositoryID: 51,
+		Commit:       mockCommit,
+		Path:         mockPath,
+		Line:         line,
+		Character:    character,
+	}
+}
+
+func typeHierarchyTestItem(upload uploadsshared.Dump, symbol, name string, line, start, end int, children ...TypeHierarchyItem) TypeHierarchyItem {
+	return TypeHierarchyItem{
+		Symbol: symbol,
+		Name:   name,
+		Location: shared.UploadLocation{
+			Dump:         upload,
+			Path:         upload.Root + typeHierarchyTestPath(upload),
+			TargetCommit: upload.Commit,
+			TargetRange: shared.Range{
+				Start: shared.Position{Line: line, Character: start},
+				End:   shared.Position{Line: line, Character: end},
+			},
+		},
+		Children: children,
+	}
+}
+
+func typeHierarchyTestPath(upload uploadsshared.Dump) string {
+	if upload.ID == 52 {
+		return typeHierarchyBaseDocument.RelativePath
+	}
+	return typeHierarchyDocument.RelativePath
+}
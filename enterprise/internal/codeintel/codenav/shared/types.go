@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"time"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -54,3 +56,39 @@ type Position struct {
 	Line      int
 	Character int
 }
+
+// DeadCodeDefinition is a definition of a global symbol that is referenced neither by its own
+// upload, nor by any upload visible at the tip of the default branch of its repository. The path
+// is relative to the root of the upload.
+type DeadCodeDefinition struct {
+	UploadID     int
+	SymbolName   string
+	DocumentPath string
+	Range        Range
+	Exported     bool
+}
+
+// DeadCodeReport describes the last computation of the unreferenced definitions of an upload.
+type DeadCodeReport struct {
+	UploadID       int
+	ComputedAt     time.Time
+	NumDefinitions int
+}
+
+// DefinedSymbol is a global symbol defined by an upload, along with its signature and documentation
+// as emitted by the indexer. The path is relative to the root of the upload.
+type DefinedSymbol struct {
+	SymbolName    string
+	DocumentPath  string
+	Range         Range
+	Signature     string
+	Documentation string
+}
+
+type GetDeadCodeDefinitionsArgs struct {
+	UploadID   int
+	PathPrefix string
+	Exported   *bool
+	Limit      int
+	Offset     int
+}
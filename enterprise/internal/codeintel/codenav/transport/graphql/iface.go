@@ -13,8 +13,15 @@ type CodeNavService interface {
 	GetReferences(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ReferencesCursor) (_ []shared.UploadLocation, nextCursor codenav.ReferencesCursor, err error)
 	GetImplementations(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
 	GetPrototypes(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
+	GetIncomingCalls(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.CallHierarchyCall, error)
+	GetOutgoingCalls(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.CallHierarchyCall, error)
+	GetSupertypes(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.TypeHierarchyItem, error)
+	GetSubtypes(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.TypeHierarchyItem, error)
 	GetDefinitions(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState) (_ []shared.UploadLocation, err error)
 	GetDiagnostics(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState) (diagnosticsAtUploads []codenav.DiagnosticAtUpload, _ int, err error)
+	GetAPIChanges(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, baseCommit string, kind *codenav.APIChangeKind) (_ []codenav.APIChange, _ int, err error)
+	GetRenameImpact(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, newName string) (*codenav.RenameImpact, error)
+	GetDeadCode(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, exported *bool) (_ []codenav.DeadCodeAtUpload, _ int, err error)
 	GetRanges(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, startLine, endLine int) (adjustedRanges []codenav.AdjustedCodeIntelligenceRange, err error)
 	GetStencil(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState) (adjustedRanges []shared.Range, err error)
 	GetClosestDumpsForBlob(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) (_ []uploadsshared.Dump, err error)
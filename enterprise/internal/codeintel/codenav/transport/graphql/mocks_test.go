@@ -178,9 +178,15 @@ func (c AutoIndexingServiceQueueRepoRevFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/transport/graphql)
 // used for unit testing.
 type MockCodeNavService struct {
+	// GetAPIChangesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAPIChanges.
+	GetAPIChangesFunc *CodeNavServiceGetAPIChangesFunc
 	// GetClosestDumpsForBlobFunc is an instance of a mock function object
 	// controlling the behavior of the method GetClosestDumpsForBlob.
 	GetClosestDumpsForBlobFunc *CodeNavServiceGetClosestDumpsForBlobFunc
+	// GetDeadCodeFunc is an instance of a mock function object controlling
+	// the behavior of the method GetDeadCode.
+	GetDeadCodeFunc *CodeNavServiceGetDeadCodeFunc
 	// GetDefinitionsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDefinitions.
 	GetDefinitionsFunc *CodeNavServiceGetDefinitionsFunc
@@ -193,6 +199,12 @@ type MockCodeNavService struct {
 	// GetImplementationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetImplementations.
 	GetImplementationsFunc *CodeNavServiceGetImplementationsFunc
+	// GetIncomingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIncomingCalls.
+	GetIncomingCallsFunc *CodeNavServiceGetIncomingCallsFunc
+	// GetOutgoingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetOutgoingCalls.
+	GetOutgoingCallsFunc *CodeNavServiceGetOutgoingCallsFunc
 	// GetPrototypesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetPrototypes.
 	GetPrototypesFunc *CodeNavServiceGetPrototypesFunc
@@ -202,9 +214,18 @@ type MockCodeNavService struct {
 	// GetReferencesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetReferences.
 	GetReferencesFunc *CodeNavServiceGetReferencesFunc
+	// GetRenameImpactFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRenameImpact.
+	GetRenameImpactFunc *CodeNavServiceGetRenameImpactFunc
 	// GetStencilFunc is an instance of a mock function object controlling
 	// the behavior of the method GetStencil.
 	GetStencilFunc *CodeNavServiceGetStencilFunc
+	// GetSubtypesFunc is an instance of a mock function object controlling
+	// the behavior of the method GetSubtypes.
+	GetSubtypesFunc *CodeNavServiceGetSubtypesFunc
+	// GetSupertypesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSupertypes.
+	GetSupertypesFunc *CodeNavServiceGetSupertypesFunc
 	// SnapshotForDocumentFunc is an instance of a mock function object
 	// controlling the behavior of the method SnapshotForDocument.
 	SnapshotForDocumentFunc *CodeNavServiceSnapshotForDocumentFunc
@@ -217,11 +238,21 @@ type MockCodeNavService struct {
 // All methods return zero values for all results, unless overwritten.
 func NewMockCodeNavService() *MockCodeNavService {
 	return &MockCodeNavService{
+		GetAPIChangesFunc: &CodeNavServiceGetAPIChangesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) (r0 []codenav.APIChange, r1 int, r2 error) {
+				return
+			},
+		},
 		GetClosestDumpsForBlobFunc: &CodeNavServiceGetClosestDumpsForBlobFunc{
 			defaultHook: func(context.Context, int, string, string, bool, string) (r0 []shared.Dump, r1 error) {
 				return
 			},
 		},
+		GetDeadCodeFunc: &CodeNavServiceGetDeadCodeFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) (r0 []codenav.DeadCodeAtUpload, r1 int, r2 error) {
+				return
+			},
+		},
 		GetDefinitionsFunc: &CodeNavServiceGetDefinitionsFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState) (r0 []shared1.UploadLocation, r1 error) {
 				return
@@ -242,6 +273,16 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) (r0 []codenav.CallHierarchyCall, r1 error) {
+				return
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) (r0 []codenav.CallHierarchyCall, r1 error) {
+				return
+			},
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) (r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
 				return
@@ -257,11 +298,26 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetRenameImpactFunc: &CodeNavServiceGetRenameImpactFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (r0 *codenav.RenameImpact, r1 error) {
+				return
+			},
+		},
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState) (r0 []shared1.Range, r1 error) {
 				return
 			},
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) (r0 []codenav.TypeHierarchyItem, r1 error) {
+				return
+			},
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) (r0 []codenav.TypeHierarchyItem, r1 error) {
+				return
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) (r0 []shared1.SnapshotData, r1 error) {
 				return
@@ -279,11 +335,21 @@ func NewMockCodeNavService() *MockCodeNavService {
 // interface. All methods panic on invocation, unless overwritten.
 func NewStrictMockCodeNavService() *MockCodeNavService {
 	return &MockCodeNavService{
+		GetAPIChangesFunc: &CodeNavServiceGetAPIChangesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error) {
+				panic("unexpected invocation of MockCodeNavService.GetAPIChanges")
+			},
+		},
 		GetClosestDumpsForBlobFunc: &CodeNavServiceGetClosestDumpsForBlobFunc{
 			defaultHook: func(context.Context, int, string, string, bool, string) ([]shared.Dump, error) {
 				panic("unexpected invocation of MockCodeNavService.GetClosestDumpsForBlob")
 			},
 		},
+		GetDeadCodeFunc: &CodeNavServiceGetDeadCodeFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error) {
+				panic("unexpected invocation of MockCodeNavService.GetDeadCode")
+			},
+		},
 		GetDefinitionsFunc: &CodeNavServiceGetDefinitionsFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
 				panic("unexpected invocation of MockCodeNavService.GetDefinitions")
@@ -304,6 +370,16 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetImplementations")
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+				panic("unexpected invocation of MockCodeNavService.GetIncomingCalls")
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+				panic("unexpected invocation of MockCodeNavService.GetOutgoingCalls")
+			},
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
 				panic("unexpected invocation of MockCodeNavService.GetPrototypes")
@@ -319,11 +395,26 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetReferences")
 			},
 		},
+		GetRenameImpactFunc: &CodeNavServiceGetRenameImpactFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error) {
+				panic("unexpected invocation of MockCodeNavService.GetRenameImpact")
+			},
+		},
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState) ([]shared1.Range, error) {
 				panic("unexpected invocation of MockCodeNavService.GetStencil")
 			},
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+				panic("unexpected invocation of MockCodeNavService.GetSubtypes")
+			},
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+				panic("unexpected invocation of MockCodeNavService.GetSupertypes")
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) ([]shared1.SnapshotData, error) {
 				panic("unexpected invocation of MockCodeNavService.SnapshotForDocument")
@@ -342,9 +433,15 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 // overwritten.
 func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 	return &MockCodeNavService{
+		GetAPIChangesFunc: &CodeNavServiceGetAPIChangesFunc{
+			defaultHook: i.GetAPIChanges,
+		},
 		GetClosestDumpsForBlobFunc: &CodeNavServiceGetClosestDumpsForBlobFunc{
 			defaultHook: i.GetClosestDumpsForBlob,
 		},
+		GetDeadCodeFunc: &CodeNavServiceGetDeadCodeFunc{
+			defaultHook: i.GetDeadCode,
+		},
 		GetDefinitionsFunc: &CodeNavServiceGetDefinitionsFunc{
 			defaultHook: i.GetDefinitions,
 		},
@@ -357,6 +454,12 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetImplementationsFunc: &CodeNavServiceGetImplementationsFunc{
 			defaultHook: i.GetImplementations,
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: i.GetIncomingCalls,
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: i.GetOutgoingCalls,
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: i.GetPrototypes,
 		},
@@ -366,9 +469,18 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetReferencesFunc: &CodeNavServiceGetReferencesFunc{
 			defaultHook: i.GetReferences,
 		},
+		GetRenameImpactFunc: &CodeNavServiceGetRenameImpactFunc{
+			defaultHook: i.GetRenameImpact,
+		},
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: i.GetStencil,
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: i.GetSubtypes,
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: i.GetSupertypes,
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: i.SnapshotForDocument,
 		},
@@ -378,6 +490,127 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 	}
 }
 
+// CodeNavServiceGetAPIChangesFunc describes the behavior when the
+// GetAPIChanges method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetAPIChangesFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error)
+	history     []CodeNavServiceGetAPIChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAPIChanges delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetAPIChanges(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 string, v4 *codenav.APIChangeKind) ([]codenav.APIChange, int, error) {
+	r0, r1, r2 := m.GetAPIChangesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetAPIChangesFunc.appendCall(CodeNavServiceGetAPIChangesFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetAPIChanges method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetAPIChangesFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAPIChanges method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetAPIChangesFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetAPIChangesFunc) SetDefaultReturn(r0 []codenav.APIChange, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetAPIChangesFunc) PushReturn(r0 []codenav.APIChange, r1 int, r2 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetAPIChangesFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, string, *codenav.APIChangeKind) ([]codenav.APIChange, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetAPIChangesFunc) appendCall(r0 CodeNavServiceGetAPIChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetAPIChangesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetAPIChangesFunc) History() []CodeNavServiceGetAPIChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetAPIChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetAPIChangesFuncCall is an object that describes an
+// invocation of method GetAPIChanges on an instance of MockCodeNavService.
+type CodeNavServiceGetAPIChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 *codenav.APIChangeKind
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.APIChange
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetAPIChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetAPIChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeNavServiceGetClosestDumpsForBlobFunc describes the behavior when the
 // GetClosestDumpsForBlob method of the parent MockCodeNavService instance
 // is invoked.
@@ -502,6 +735,123 @@ func (c CodeNavServiceGetClosestDumpsForBlobFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeNavServiceGetDeadCodeFunc describes the behavior when the GetDeadCode
+// method of the parent MockCodeNavService instance is invoked.
+type CodeNavServiceGetDeadCodeFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error)
+	history     []CodeNavServiceGetDeadCodeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDeadCode delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetDeadCode(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 *bool) ([]codenav.DeadCodeAtUpload, int, error) {
+	r0, r1, r2 := m.GetDeadCodeFunc.nextHook()(v0, v1, v2, v3)
+	m.GetDeadCodeFunc.appendCall(CodeNavServiceGetDeadCodeFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetDeadCode method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetDeadCodeFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDeadCode method of the parent MockCodeNavService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetDeadCodeFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetDeadCodeFunc) SetDefaultReturn(r0 []codenav.DeadCodeAtUpload, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetDeadCodeFunc) PushReturn(r0 []codenav.DeadCodeAtUpload, r1 int, r2 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetDeadCodeFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, *bool) ([]codenav.DeadCodeAtUpload, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetDeadCodeFunc) appendCall(r0 CodeNavServiceGetDeadCodeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetDeadCodeFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetDeadCodeFunc) History() []CodeNavServiceGetDeadCodeFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetDeadCodeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetDeadCodeFuncCall is an object that describes an
+// invocation of method GetDeadCode on an instance of MockCodeNavService.
+type CodeNavServiceGetDeadCodeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.DeadCodeAtUpload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetDeadCodeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetDeadCodeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeNavServiceGetDefinitionsFunc describes the behavior when the
 // GetDefinitions method of the parent MockCodeNavService instance is
 // invoked.
@@ -966,36 +1316,36 @@ func (c CodeNavServiceGetImplementationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// CodeNavServiceGetPrototypesFunc describes the behavior when the
-// GetPrototypes method of the parent MockCodeNavService instance is
+// CodeNavServiceGetIncomingCallsFunc describes the behavior when the
+// GetIncomingCalls method of the parent MockCodeNavService instance is
 // invoked.
-type CodeNavServiceGetPrototypesFunc struct {
-	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)
-	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)
-	history     []CodeNavServiceGetPrototypesFuncCall
+type CodeNavServiceGetIncomingCallsFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)
+	history     []CodeNavServiceGetIncomingCallsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetPrototypes delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockCodeNavService) GetPrototypes(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
-	r0, r1, r2 := m.GetPrototypesFunc.nextHook()(v0, v1, v2, v3)
-	m.GetPrototypesFunc.appendCall(CodeNavServiceGetPrototypesFuncCall{v0, v1, v2, v3, r0, r1, r2})
-	return r0, r1, r2
+// GetIncomingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetIncomingCalls(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.CallHierarchyCall, error) {
+	r0, r1 := m.GetIncomingCallsFunc.nextHook()(v0, v1, v2, v3)
+	m.GetIncomingCallsFunc.appendCall(CodeNavServiceGetIncomingCallsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetPrototypes method
-// of the parent MockCodeNavService instance is invoked and the hook queue
-// is empty.
-func (f *CodeNavServiceGetPrototypesFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)) {
+// SetDefaultHook sets function that is called when the GetIncomingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetPrototypes method of the parent MockCodeNavService instance invokes
+// GetIncomingCalls method of the parent MockCodeNavService instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *CodeNavServiceGetPrototypesFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)) {
+func (f *CodeNavServiceGetIncomingCallsFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1003,20 +1353,20 @@ func (f *CodeNavServiceGetPrototypesFunc) PushHook(hook func(context.Context, co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeNavServiceGetPrototypesFunc) SetDefaultReturn(r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
-	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
-		return r0, r1, r2
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeNavServiceGetPrototypesFunc) PushReturn(r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
-	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
-		return r0, r1, r2
+func (f *CodeNavServiceGetIncomingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+		return r0, r1
 	})
 }
 
-func (f *CodeNavServiceGetPrototypesFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
+func (f *CodeNavServiceGetIncomingCallsFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1029,15 +1379,247 @@ func (f *CodeNavServiceGetPrototypesFunc) nextHook() func(context.Context, coden
 	return hook
 }
 
-func (f *CodeNavServiceGetPrototypesFunc) appendCall(r0 CodeNavServiceGetPrototypesFuncCall) {
+func (f *CodeNavServiceGetIncomingCallsFunc) appendCall(r0 CodeNavServiceGetIncomingCallsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeNavServiceGetPrototypesFuncCall objects
-// describing the invocations of this function.
-func (f *CodeNavServiceGetPrototypesFunc) History() []CodeNavServiceGetPrototypesFuncCall {
+// History returns a sequence of CodeNavServiceGetIncomingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetIncomingCallsFunc) History() []CodeNavServiceGetIncomingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetIncomingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetIncomingCallsFuncCall is an object that describes an
+// invocation of method GetIncomingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetIncomingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeNavServiceGetOutgoingCallsFunc describes the behavior when the
+// GetOutgoingCalls method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetOutgoingCallsFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)
+	history     []CodeNavServiceGetOutgoingCallsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetOutgoingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetOutgoingCalls(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.CallHierarchyCall, error) {
+	r0, r1 := m.GetOutgoingCallsFunc.nextHook()(v0, v1, v2, v3)
+	m.GetOutgoingCallsFunc.appendCall(CodeNavServiceGetOutgoingCallsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetOutgoingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetOutgoingCalls method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.CallHierarchyCall, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) appendCall(r0 CodeNavServiceGetOutgoingCallsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetOutgoingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetOutgoingCallsFunc) History() []CodeNavServiceGetOutgoingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetOutgoingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetOutgoingCallsFuncCall is an object that describes an
+// invocation of method GetOutgoingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetOutgoingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeNavServiceGetPrototypesFunc describes the behavior when the
+// GetPrototypes method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetPrototypesFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)
+	history     []CodeNavServiceGetPrototypesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPrototypes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetPrototypes(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
+	r0, r1, r2 := m.GetPrototypesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetPrototypesFunc.appendCall(CodeNavServiceGetPrototypesFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetPrototypes method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetPrototypesFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPrototypes method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetPrototypesFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetPrototypesFunc) SetDefaultReturn(r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetPrototypesFunc) PushReturn(r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetPrototypesFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetPrototypesFunc) appendCall(r0 CodeNavServiceGetPrototypesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetPrototypesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetPrototypesFunc) History() []CodeNavServiceGetPrototypesFuncCall {
 	f.mutex.Lock()
 	history := make([]CodeNavServiceGetPrototypesFuncCall, len(f.history))
 	copy(history, f.history)
@@ -1319,6 +1901,122 @@ func (c CodeNavServiceGetReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// CodeNavServiceGetRenameImpactFunc describes the behavior when the
+// GetRenameImpact method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetRenameImpactFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error)
+	history     []CodeNavServiceGetRenameImpactFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRenameImpact delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetRenameImpact(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 string) (*codenav.RenameImpact, error) {
+	r0, r1 := m.GetRenameImpactFunc.nextHook()(v0, v1, v2, v3)
+	m.GetRenameImpactFunc.appendCall(CodeNavServiceGetRenameImpactFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRenameImpact
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetRenameImpactFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRenameImpact method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetRenameImpactFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetRenameImpactFunc) SetDefaultReturn(r0 *codenav.RenameImpact, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetRenameImpactFunc) PushReturn(r0 *codenav.RenameImpact, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetRenameImpactFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, string) (*codenav.RenameImpact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetRenameImpactFunc) appendCall(r0 CodeNavServiceGetRenameImpactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetRenameImpactFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetRenameImpactFunc) History() []CodeNavServiceGetRenameImpactFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetRenameImpactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetRenameImpactFuncCall is an object that describes an
+// invocation of method GetRenameImpact on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetRenameImpactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *codenav.RenameImpact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetRenameImpactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetRenameImpactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceGetStencilFunc describes the behavior when the GetStencil
 // method of the parent MockCodeNavService instance is invoked.
 type CodeNavServiceGetStencilFunc struct {
@@ -1430,6 +2128,235 @@ func (c CodeNavServiceGetStencilFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeNavServiceGetSubtypesFunc describes the behavior when the GetSubtypes
+// method of the parent MockCodeNavService instance is invoked.
+type CodeNavServiceGetSubtypesFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)
+	history     []CodeNavServiceGetSubtypesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSubtypes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetSubtypes(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.TypeHierarchyItem, error) {
+	r0, r1 := m.GetSubtypesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetSubtypesFunc.appendCall(CodeNavServiceGetSubtypesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSubtypes method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetSubtypesFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSubtypes method of the parent MockCodeNavService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetSubtypesFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetSubtypesFunc) SetDefaultReturn(r0 []codenav.TypeHierarchyItem, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetSubtypesFunc) PushReturn(r0 []codenav.TypeHierarchyItem, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetSubtypesFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetSubtypesFunc) appendCall(r0 CodeNavServiceGetSubtypesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetSubtypesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetSubtypesFunc) History() []CodeNavServiceGetSubtypesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetSubtypesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetSubtypesFuncCall is an object that describes an
+// invocation of method GetSubtypes on an instance of MockCodeNavService.
+type CodeNavServiceGetSubtypesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.TypeHierarchyItem
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetSubtypesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetSubtypesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeNavServiceGetSupertypesFunc describes the behavior when the
+// GetSupertypes method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetSupertypesFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)
+	history     []CodeNavServiceGetSupertypesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSupertypes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetSupertypes(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.TypeHierarchyItem, error) {
+	r0, r1 := m.GetSupertypesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetSupertypesFunc.appendCall(CodeNavServiceGetSupertypesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSupertypes method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetSupertypesFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSupertypes method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetSupertypesFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetSupertypesFunc) SetDefaultReturn(r0 []codenav.TypeHierarchyItem, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetSupertypesFunc) PushReturn(r0 []codenav.TypeHierarchyItem, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetSupertypesFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyItem, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetSupertypesFunc) appendCall(r0 CodeNavServiceGetSupertypesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetSupertypesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetSupertypesFunc) History() []CodeNavServiceGetSupertypesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetSupertypesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetSupertypesFuncCall is an object that describes an
+// invocation of method GetSupertypes on an instance of MockCodeNavService.
+type CodeNavServiceGetSupertypesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.TypeHierarchyItem
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetSupertypesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetSupertypesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceSnapshotForDocumentFunc describes the behavior when the
 // SnapshotForDocument method of the parent MockCodeNavService instance is
 // invoked.
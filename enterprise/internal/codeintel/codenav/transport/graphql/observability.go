@@ -20,7 +20,14 @@ type operations struct {
 	references      *observation.Operation
 	implementations *observation.Operation
 	prototypes      *observation.Operation
+	incomingCalls   *observation.Operation
+	outgoingCalls   *observation.Operation
+	supertypes      *observation.Operation
+	subtypes        *observation.Operation
+	renameImpact    *observation.Operation
 	diagnostics     *observation.Operation
+	deadCode        *observation.Operation
+	apiChanges      *observation.Operation
 	stencil         *observation.Operation
 	ranges          *observation.Operation
 	snapshot        *observation.Operation
@@ -50,7 +57,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 		references:      op("References"),
 		implementations: op("Implementations"),
 		prototypes:      op("Prototypes"),
+		incomingCalls:   op("IncomingCalls"),
+		outgoingCalls:   op("OutgoingCalls"),
+		supertypes:      op("Supertypes"),
+		subtypes:        op("Subtypes"),
+		renameImpact:    op("RenameImpact"),
 		diagnostics:     op("Diagnostics"),
+		deadCode:        op("DeadCode"),
+		apiChanges:      op("APIChanges"),
 		stencil:         op("Stencil"),
 		ranges:          op("Ranges"),
 		snapshot:        op("Snapshot"),
@@ -0,0 +1,120 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// DefaultAPIChangesPageSize is the API changes result page size when no limit is supplied.
+const DefaultAPIChangesPageSize = 100
+
+// APIChanges returns the public API changes between the given base commit and the current commit for
+// documents with the given path prefix.
+func (r *gitBlobLSIFDataResolver) APIChanges(ctx context.Context, args *resolverstubs.LSIFAPIChangesArgs) (_ resolverstubs.APIChangeConnectionResolver, err error) {
+	limit := int(pointers.Deref(args.First, DefaultAPIChangesPageSize))
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+
+	var kind *codenav.APIChangeKind
+	if args.Kind != nil {
+		kind = pointers.Ptr(codenav.APIChangeKind(*args.Kind))
+	}
+
+	requestArgs := codenav.RequestArgs{RepositoryID: r.requestState.RepositoryID, Commit: r.requestState.Commit, Path: r.requestState.Path, Limit: limit}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.apiChanges, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	baseCommit, err := r.locationResolver.Commit(ctx, api.RepoID(r.requestState.RepositoryID), args.Base)
+	if err != nil {
+		return nil, err
+	}
+	if baseCommit == nil {
+		return nil, errors.Newf("unknown base commit %q", args.Base)
+	}
+
+	changes, totalCount, err := r.codeNavSvc.GetAPIChanges(ctx, requestArgs, r.requestState, string(baseCommit.OID()), kind)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetAPIChanges")
+	}
+
+	resolvers := make([]resolverstubs.APIChangeResolver, 0, len(changes))
+	for _, change := range changes {
+		base, ok, err := r.resolveAPISymbol(ctx, change.Base)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		head, ok, err := r.resolveAPISymbol(ctx, change.Head)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		resolvers = append(resolvers, &apiChangeResolver{
+			change: change,
+			base:   base,
+			head:   head,
+		})
+	}
+
+	return resolverstubs.NewTotalCountConnectionResolver(resolvers, 0, int32(totalCount)), nil
+}
+
+// resolveAPISymbol creates a resolver for the given symbol, which may be nil. This method returns
+// false if the symbol's commit is not known by gitserver.
+func (r *gitBlobLSIFDataResolver) resolveAPISymbol(ctx context.Context, symbol *codenav.APISymbolAtUpload) (resolverstubs.APISymbolResolver, bool, error) {
+	if symbol == nil {
+		return nil, true, nil
+	}
+
+	location, err := resolveLocation(ctx, r.locationResolver, symbol.Location)
+	if err != nil || location == nil {
+		return nil, false, err
+	}
+
+	return &apiSymbolResolver{
+		symbol:   *symbol,
+		location: location,
+	}, true, nil
+}
+
+//
+//
+
+type apiChangeResolver struct {
+	change codenav.APIChange
+	base   resolverstubs.APISymbolResolver
+	head   resolverstubs.APISymbolResolver
+}
+
+func (r *apiChangeResolver) Kind() string                          { return string(r.change.Kind) }
+func (r *apiChangeResolver) Name() string                          { return r.change.Name }
+func (r *apiChangeResolver) Base() resolverstubs.APISymbolResolver { return r.base }
+func (r *apiChangeResolver) Head() resolverstubs.APISymbolResolver { return r.head }
+func (r *apiChangeResolver) SignatureChanged() bool                { return r.change.SignatureChanged }
+func (r *apiChangeResolver) DocumentationChanged() bool            { return r.change.DocumentationChanged }
+
+//
+//
+
+type apiSymbolResolver struct {
+	symbol   codenav.APISymbolAtUpload
+	location resolverstubs.LocationResolver
+}
+
+func (r *apiSymbolResolver) Symbol() string                           { return r.symbol.Symbol }
+func (r *apiSymbolResolver) Signature() string                        { return r.symbol.Signature }
+func (r *apiSymbolResolver) Documentation() string                    { return r.symbol.Documentation }
+func (r *apiSymbolResolver) Location() resolverstubs.LocationResolver { return r.location }
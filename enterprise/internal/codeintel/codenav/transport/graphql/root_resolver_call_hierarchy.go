@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultCallHierarchyPageSize is the call hierarchy result page size when no limit is supplied.
+const DefaultCallHierarchyPageSize = 100
+
+type getCallsFn = func(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.CallHierarchyCall, error)
+
+func (r *gitBlobLSIFDataResolver) IncomingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.incomingCalls, r.codeNavSvc.GetIncomingCalls)
+}
+
+func (r *gitBlobLSIFDataResolver) OutgoingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.outgoingCalls, r.codeNavSvc.GetOutgoingCalls)
+}
+
+// callHierarchy returns a page of the calls returned by getCalls. The call hierarchy is computed
+// up to the requested depth on every request, and the cursor is an offset into it.
+func (r *gitBlobLSIFDataResolver) callHierarchy(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs, operation *observation.Operation, getCalls getCallsFn) (_ resolverstubs.CallHierarchyConnectionResolver, err error) {
+	limit, offset, err := args.ParseLimitOffset(DefaultCallHierarchyPageSize)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	if offset < 0 {
+		return nil, errors.Newf("illegal cursor %q", *args.After)
+	}
+
+	depth := int(args.Depth)
+	if depth < 1 || depth > codenav.CallHierarchyMaxDepth {
+		return nil, errors.Newf("depth must be between 1 and %d", codenav.CallHierarchyMaxDepth)
+	}
+
+	requestArgs := codenav.RequestArgs{RepositoryID: r.requestState.RepositoryID, Commit: r.requestState.Commit, Path: r.requestState.Path, Line: int(args.Line), Character: int(args.Character), Limit: int(limit)}
+	ctx, _, endObservation := observeResolver(ctx, &err, operation, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	calls, err := getCalls(ctx, requestArgs, r.requestState, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(calls)
+	if int(offset) >= len(calls) {
+		calls = nil
+	} else {
+		calls = calls[offset:]
+	}
+
+	nextCursor := ""
+	if len(calls) > int(limit) {
+		calls = calls[:limit]
+		nextCursor = strconv.Itoa(int(offset) + len(calls))
+	}
+
+	resolvers := make([]resolverstubs.CallHierarchyCallResolver, 0, len(calls))
+	for _, call := range calls {
+		resolver, err := r.resolveCall(ctx, call)
+		if err != nil {
+			return nil, err
+		}
+		if resolver == nil {
+			continue
+		}
+
+		resolvers = append(resolvers, resolver)
+	}
+
+	return resolverstubs.NewCursorWithTotalCountConnectionResolver(resolvers, nextCursor, int32(totalCount)), nil
+}
+
+// resolveCall creates a resolver for the given call. This function may return a nil resolver if the
+// commit of either function is not known by gitserver.
+func (r *gitBlobLSIFDataResolver) resolveCall(ctx context.Context, call codenav.CallHierarchyCall) (resolverstubs.CallHierarchyCallResolver, error) {
+	from, err := resolveLocation(ctx, r.locationResolver, call.From.Location)
+	if err != nil || from == nil {
+		return nil, err
+	}
+
+	to, err := resolveLocation(ctx, r.locationResolver, call.To.Location)
+	if err != nil || to == nil {
+		return nil, err
+	}
+
+	callSites, err := resolveLocations(ctx, r.locationResolver, call.CallSites)
+	if err != nil {
+		return nil, err
+	}
+
+	return &callHierarchyCallResolver{
+		from:      &callHierarchyItemResolver{item: call.From, location: from},
+		to:        &callHierarchyItemResolver{item: call.To, location: to},
+		callSites: callSites,
+		depth:     int32(call.Depth),
+	}, nil
+}
+
+//
+//
+
+type callHierarchyCallResolver struct {
+	from      resolverstubs.CallHierarchyItemResolver
+	to        resolverstubs.CallHierarchyItemResolver
+	callSites []resolverstubs.LocationResolver
+	depth     int32
+}
+
+func (r *callHierarchyCallResolver) From() resolverstubs.CallHierarchyItemResolver { return r.from }
+func (r *callHierarchyCallResolver) To() resolverstubs.CallHierarchyItemResolver   { return r.to }
+func (r *callHierarchyCallResolver) CallSites() []resolverstubs.LocationResolver {
+	return r.callSites
+}
+func (r *callHierarchyCallResolver) Depth() int32 { return r.depth }
+
+type callHierarchyItemResolver struct {
+	item     codenav.CallHierarchyItem
+	location resolverstubs.LocationResolver
+}
+
+func (r *callHierarchyItemResolver) Symbol() string                           { return r.item.Symbol }
+func (r *callHierarchyItemResolver) Name() string                             { return r.item.Name }
+func (r *callHierarchyItemResolver) Location() resolverstubs.LocationResolver { return r.location }
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// DefaultDeadCodePageSize is the dead code result page size when no limit is supplied.
+const DefaultDeadCodePageSize = 100
+
+// DeadCode returns the unreferenced definitions for documents with the given path prefix.
+func (r *gitBlobLSIFDataResolver) DeadCode(ctx context.Context, args *resolverstubs.LSIFDeadCodeArgs) (_ resolverstubs.DeadCodeConnectionResolver, err error) {
+	limit := int(pointers.Deref(args.First, DefaultDeadCodePageSize))
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+
+	requestArgs := codenav.RequestArgs{RepositoryID: r.requestState.RepositoryID, Commit: r.requestState.Commit, Path: r.requestState.Path, Limit: limit}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.deadCode, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	deadCode, totalCount, err := r.codeNavSvc.GetDeadCode(ctx, requestArgs, r.requestState, args.Exported)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetDeadCode")
+	}
+
+	resolvers := make([]resolverstubs.DeadCodeDefinitionResolver, 0, len(deadCode))
+	for _, definition := range deadCode {
+		location, err := resolveLocation(ctx, r.locationResolver, definition.Location)
+		if err != nil {
+			return nil, err
+		}
+		if location == nil {
+			continue
+		}
+
+		resolvers = append(resolvers, &deadCodeDefinitionResolver{
+			definition: definition,
+			location:   location,
+		})
+	}
+
+	return resolverstubs.NewTotalCountConnectionResolver(resolvers, 0, int32(totalCount)), nil
+}
+
+//
+//
+
+type deadCodeDefinitionResolver struct {
+	definition codenav.DeadCodeAtUpload
+	location   resolverstubs.LocationResolver
+}
+
+func (r *deadCodeDefinitionResolver) Symbol() string                           { return r.definition.Symbol }
+func (r *deadCodeDefinitionResolver) Name() string                             { return r.definition.Name }
+func (r *deadCodeDefinitionResolver) Exported() bool                           { return r.definition.Exported }
+func (r *deadCodeDefinitionResolver) Location() resolverstubs.LocationResolver { return r.location }
@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// RenameImpact returns the edits renaming the symbol at the given position to the given name.
+func (r *gitBlobLSIFDataResolver) RenameImpact(ctx context.Context, args *resolverstubs.LSIFRenameImpactArgs) (_ resolverstubs.RenameImpactResolver, err error) {
+	requestArgs := codenav.RequestArgs{RepositoryID: r.requestState.RepositoryID, Commit: r.requestState.Commit, Path: r.requestState.Path, Line: int(args.Line), Character: int(args.Character)}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.renameImpact, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	impact, err := r.codeNavSvc.GetRenameImpact(ctx, requestArgs, r.requestState, args.NewName)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetRenameImpact")
+	}
+	if impact == nil {
+		return nil, nil
+	}
+
+	repositories := make([]resolverstubs.RenameImpactRepositoryResolver, 0, len(impact.Repositories))
+	for _, repository := range impact.Repositories {
+		resolver, err := r.resolveRenameImpactRepository(ctx, repository)
+		if err != nil {
+			return nil, err
+		}
+		if resolver == nil {
+			continue
+		}
+
+		repositories = append(repositories, resolver)
+	}
+
+	return &renameImpactResolver{
+		impact:       *impact,
+		repositories: repositories,
+	}, nil
+}
+
+// resolveRenameImpactRepository creates a resolver for the given repository. This method may return
+// a nil resolver if the repository's commit is not known by gitserver.
+func (r *gitBlobLSIFDataResolver) resolveRenameImpactRepository(ctx context.Context, repository codenav.RenameImpactRepository) (resolverstubs.RenameImpactRepositoryResolver, error) {
+	commit, err := r.locationResolver.Commit(ctx, api.RepoID(repository.RepositoryID), repository.Commit)
+	if err != nil || commit == nil {
+		return nil, err
+	}
+
+	files := make([]resolverstubs.RenameImpactFileResolver, 0, len(repository.Files))
+	for _, file := range repository.Files {
+		blob, err := r.locationResolver.Path(ctx, api.RepoID(repository.RepositoryID), repository.Commit, file.Path, false)
+		if err != nil {
+			return nil, err
+		}
+		if blob == nil {
+			continue
+		}
+
+		files = append(files, &renameImpactFileResolver{
+			file: file,
+			blob: blob,
+		})
+	}
+
+	return &renameImpactRepositoryResolver{
+		repository: repository,
+		commit:     commit,
+		files:      files,
+	}, nil
+}
+
+//
+//
+
+type renameImpactResolver struct {
+	impact       codenav.RenameImpact
+	repositories []resolverstubs.RenameImpactRepositoryResolver
+}
+
+func (r *renameImpactResolver) OldName() string { return r.impact.OldName }
+func (r *renameImpactResolver) NewName() string { return r.impact.NewName }
+func (r *renameImpactResolver) Repositories() []resolverstubs.RenameImpactRepositoryResolver {
+	return r.repositories
+}
+
+//
+//
+
+type renameImpactRepositoryResolver struct {
+	repository codenav.RenameImpactRepository
+	commit     resolverstubs.GitCommitResolver
+	files      []resolverstubs.RenameImpactFileResolver
+}
+
+func (r *renameImpactRepositoryResolver) Commit() resolverstubs.GitCommitResolver { return r.commit }
+func (r *renameImpactRepositoryResolver) Files() []resolverstubs.RenameImpactFileResolver {
+	return r.files
+}
+func (r *renameImpactRepositoryResolver) Diff() string { return r.repository.Diff }
+
+//
+//
+
+type renameImpactFileResolver struct {
+	file codenav.RenameImpactFile
+	blob resolverstubs.GitTreeEntryResolver
+}
+
+func (r *renameImpactFileResolver) Blob() resolverstubs.GitTreeEntryResolver { return r.blob }
+func (r *renameImpactFileResolver) Definitions() []resolverstubs.RangeResolver {
+	return newRangeResolvers(r.file.Definitions)
+}
+func (r *renameImpactFileResolver) References() []resolverstubs.RangeResolver {
+	return newRangeResolvers(r.file.References)
+}
+func (r *renameImpactFileResolver) Mismatches() []resolverstubs.RangeResolver {
+	return newRangeResolvers(r.file.Mismatches)
+}
+func (r *renameImpactFileResolver) Diff() string { return r.file.Diff }
+
+func newRangeResolvers(ranges []shared.Range) []resolverstubs.RangeResolver {
+	resolvers := make([]resolverstubs.RangeResolver, 0, len(ranges))
+	for _, r := range ranges {
+		resolvers = append(resolvers, newRangeResolver(convertRange(r)))
+	}
+
+	return resolvers
+}
@@ -351,6 +351,187 @@ func TestDiagnosticsDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestDeadCode(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	exported := true
+	args := &resolverstubs.LSIFDeadCodeArgs{
+		Exported: &exported,
+	}
+
+	if _, err := resolver.DeadCode(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.GetDeadCodeFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetDeadCodeFunc.History()))
+	}
+	call := mockCodeNavService.GetDeadCodeFunc.History()[0]
+	if call.Arg1.Limit != DefaultDeadCodePageSize {
+		t.Fatalf("unexpected limit. want=%v have=%v", DefaultDeadCodePageSize, call.Arg1.Limit)
+	}
+	if call.Arg3 == nil || !*call.Arg3 {
+		t.Fatalf("unexpected exported filter. want=%v have=%v", true, call.Arg3)
+	}
+}
+
+func TestRenameImpact(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	args := &resolverstubs.LSIFRenameImpactArgs{
+		Line:      10,
+		Character: 15,
+		NewName:   "renamed",
+	}
+
+	impact, err := resolver.RenameImpact(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if impact != nil {
+		t.Fatalf("unexpected rename impact. want=nil have=%v", impact)
+	}
+
+	if len(mockCodeNavService.GetRenameImpactFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetRenameImpactFunc.History()))
+	}
+	call := mockCodeNavService.GetRenameImpactFunc.History()[0]
+	if call.Arg1.Line != 10 || call.Arg1.Character != 15 {
+		t.Fatalf("unexpected position. want=%d:%d have=%d:%d", 10, 15, call.Arg1.Line, call.Arg1.Character)
+	}
+	if call.Arg3 != "renamed" {
+		t.Fatalf("unexpected new name. want=%q have=%q", "renamed", call.Arg3)
+	}
+}
+
+func TestAPIChanges(t *testing.T) {
+	repos := database.NewStrictMockRepoStore()
+	repos.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*sgtypes.Repo, error) {
+		return &sgtypes.Repo{ID: id, Name: api.RepoName(fmt.Sprintf("repo%d", id))}, nil
+	})
+
+	gsClient := gitserver.NewMockClient()
+	gsClient.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if spec == "deadbeef3" {
+			return "", &gitdomain.RevisionNotFoundError{}
+		}
+		return api.CommitID(spec), nil
+	})
+
+	mockCodeNavService := NewMockCodeNavService()
+	mockCodeNavService.GetAPIChangesFunc.SetDefaultReturn([]codenav.APIChange{
+		{
+			Kind: codenav.APIChangeKindAdded,
+			Name: "Added",
+			Head: &codenav.APISymbolAtUpload{Symbol: "added", Location: shared.UploadLocation{Dump: uploadsshared.Dump{RepositoryID: 1}, TargetCommit: "deadbeef1", Path: "p1"}},
+		},
+		{
+			Kind: codenav.APIChangeKindRemoved,
+			Name: "Removed",
+			Base: &codenav.APISymbolAtUpload{Symbol: "removed", Location: shared.UploadLocation{Dump: uploadsshared.Dump{RepositoryID: 1}, TargetCommit: "deadbeef3", Path: "p2"}},
+		},
+	}, 2, nil)
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		gitresolvers.NewCachedLocationResolverFactory(repos, gsClient).Create(),
+		mockOperations,
+	)
+
+	kind := "ADDED"
+	args := &resolverstubs.LSIFAPIChangesArgs{
+		Base: "deadbeef3",
+		Kind: &kind,
+	}
+
+	if _, err := resolver.APIChanges(context.Background(), args); err == nil {
+		t.Fatalf("expected error for unknown base commit")
+	}
+
+	args.Base = "deadbeef2"
+	changes, err := resolver.APIChanges(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.GetAPIChangesFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetAPIChangesFunc.History()))
+	}
+	call := mockCodeNavService.GetAPIChangesFunc.History()[0]
+	if call.Arg1.Limit != DefaultAPIChangesPageSize {
+		t.Fatalf("unexpected limit. want=%v have=%v", DefaultAPIChangesPageSize, call.Arg1.Limit)
+	}
+	if call.Arg3 != "deadbeef2" {
+		t.Fatalf("unexpected base commit. want=%q have=%q", "deadbeef2", call.Arg3)
+	}
+	if call.Arg4 == nil || *call.Arg4 != codenav.APIChangeKindAdded {
+		t.Fatalf("unexpected kind. want=%v have=%v", codenav.APIChangeKindAdded, call.Arg4)
+	}
+
+	// The removed symbol is defined at a commit unknown to gitserver
+	nodes, err := changes.Nodes(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(nodes) != 1 {
+		t.Fatalf("unexpected number of changes. want=%d have=%d", 1, len(nodes))
+	}
+	if name := nodes[0].Name(); name != "Added" {
+		t.Errorf("unexpected name. want=%q have=%q", "Added", name)
+	}
+	if nodes[0].Base() != nil {
+		t.Errorf("unexpected base symbol. want=nil have=%v", nodes[0].Base())
+	}
+	if url := nodes[0].Head().Location().CanonicalURL(); url != "/repo1@deadbeef1/-/blob/p1?L1" {
+		t.Errorf("unexpected canonical url. want=%s have=%s", "/repo1@deadbeef1/-/blob/p1?L1", url)
+	}
+	if totalCount := changes.TotalCount(); totalCount == nil || *totalCount != 2 {
+		t.Errorf("unexpected total count. want=%d have=%v", 2, totalCount)
+	}
+}
+
 func TestResolveLocations(t *testing.T) {
 	repos := database.NewStrictMockRepoStore()
 	repos.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*sgtypes.Repo, error) {
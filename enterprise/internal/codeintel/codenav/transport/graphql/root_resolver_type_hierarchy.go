@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type getTypeHierarchyFn = func(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, depth int) ([]codenav.TypeHierarchyItem, error)
+
+func (r *gitBlobLSIFDataResolver) Supertypes(ctx context.Context, args *resolverstubs.LSIFTypeHierarchyArgs) (_ []resolverstubs.TypeHierarchyItemResolver, err error) {
+	return r.typeHierarchy(ctx, args, r.operations.supertypes, r.codeNavSvc.GetSupertypes)
+}
+
+func (r *gitBlobLSIFDataResolver) Subtypes(ctx context.Context, args *resolverstubs.LSIFTypeHierarchyArgs) (_ []resolverstubs.TypeHierarchyItemResolver, err error) {
+	return r.typeHierarchy(ctx, args, r.operations.subtypes, r.codeNavSvc.GetSubtypes)
+}
+
+// typeHierarchy returns the type hierarchy returned by getTypeHierarchy.
+func (r *gitBlobLSIFDataResolver) typeHierarchy(ctx context.Context, args *resolverstubs.LSIFTypeHierarchyArgs, operation *observation.Operation, getTypeHierarchy getTypeHierarchyFn) (_ []resolverstubs.TypeHierarchyItemResolver, err error) {
+	depth := int(args.Depth)
+	if depth < 1 || depth > codenav.TypeHierarchyMaxDepth {
+		return nil, errors.Newf("depth must be between 1 and %d", codenav.TypeHierarchyMaxDepth)
+	}
+
+	requestArgs := codenav.RequestArgs{RepositoryID: r.requestState.RepositoryID, Commit: r.requestState.Commit, Path: r.requestState.Path, Line: int(args.Line), Character: int(args.Character)}
+	ctx, _, endObservation := observeResolver(ctx, &err, operation, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	items, err := getTypeHierarchy(ctx, requestArgs, r.requestState, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.resolveTypeHierarchyItems(ctx, items)
+}
+
+// resolveTypeHierarchyItems creates resolvers for the given items and their children. Items
+// whose commit is not known by gitserver are skipped along with their children.
+func (r *gitBlobLSIFDataResolver) resolveTypeHierarchyItems(ctx context.Context, items []codenav.TypeHierarchyItem) ([]resolverstubs.TypeHierarchyItemResolver, error) {
+	resolvers := make([]resolverstubs.TypeHierarchyItemResolver, 0, len(items))
+	for _, item := range items {
+		location, err := resolveLocation(ctx, r.locationResolver, item.Location)
+		if err != nil {
+			return nil, err
+		}
+		if location == nil {
+			continue
+		}
+
+		children, err := r.resolveTypeHierarchyItems(ctx, item.Children)
+		if err != nil {
+			return nil, err
+		}
+
+		resolvers = append(resolvers, &typeHierarchyItemResolver{
+			item:     item,
+			location: location,
+			children: children,
+		})
+	}
+
+	return resolvers, nil
+}
+
+//
+//
+
+type typeHierarchyItemResolver struct {
+	item     codenav.TypeHierarchyItem
+	location resolverstubs.LocationResolver
+	children []resolverstubs.TypeHierarchyItemResolver
+}
+
+func (r *typeHierarchyItemResolver) Symbol() string                           { return r.item.Symbol }
+func (r *typeHierarchyItemResolver) Name() string                             { return r.item.Name }
+func (r *typeHierarchyItemResolver) Location() resolverstubs.LocationResolver { return r.location }
+func (r *typeHierarchyItemResolver) Children() []resolverstubs.TypeHierarchyItemResolver {
+	return r.children
+}
+func (r *typeHierarchyItemResolver) Cycle() bool { return r.item.Cycle }
@@ -120,3 +120,119 @@ type RemoteCursor struct {
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
+
+// CallHierarchyItem is a function or method taking part in a call hierarchy.
+type CallHierarchyItem struct {
+	// Symbol is the SCIP symbol of the function.
+	Symbol string
+	// Name is the display name of the function derived from its symbol.
+	Name string
+	// Location is the range of the name of the function at its definition.
+	Location shared.UploadLocation
+}
+
+// CallHierarchyCall is an edge of a call hierarchy: From calls To at each of the
+// call sites, which are all located within the body of From. Depth is the number
+// of edges between the requested function and the edge, starting at 1 for direct
+// callers or callees.
+type CallHierarchyCall struct {
+	From      CallHierarchyItem
+	To        CallHierarchyItem
+	CallSites []shared.UploadLocation
+	Depth     int
+}
+
+// TypeHierarchyItem is a type (or a method implementing or overriding others) within a type
+// hierarchy. The children of an item are its direct supertypes or subtypes, depending on the
+// direction in which the hierarchy is walked.
+type TypeHierarchyItem struct {
+	// Symbol is the SCIP symbol of the type.
+	Symbol string
+	// Name is the display name of the type derived from its symbol.
+	Name string
+	// Location is the range of the name of the type at its definition.
+	Location shared.UploadLocation
+	// Children are the direct supertypes or subtypes of the type.
+	Children []TypeHierarchyItem
+	// Cycle is true if the type is also one of its own ancestors in the tree. The children
+	// of such an item are not expanded again.
+	Cycle bool
+}
+
+// DeadCodeAtUpload is a definition that is not referenced by any upload visible at the tip of a
+// default branch, along with the location of that definition in the requested commit.
+type DeadCodeAtUpload struct {
+	// Symbol is the SCIP symbol of the definition.
+	Symbol string
+	// Name is the display name of the definition derived from its symbol.
+	Name string
+	// Exported is true if the symbol belongs to a package provided by the upload.
+	Exported bool
+	// Location is the range of the name of the symbol at its definition.
+	Location shared.UploadLocation
+}
+
+// RenameImpact describes the edits renaming a symbol to a new name across all the repositories
+// defining or referencing it.
+type RenameImpact struct {
+	// OldName is the text of the symbol at the requested position.
+	OldName string
+	NewName string
+	// Repositories are the repositories touched by the rename, requested repository first.
+	Repositories []RenameImpactRepository
+}
+
+// RenameImpactRepository groups the files touched by a rename within a repository at the commit
+// to which their locations were adjusted.
+type RenameImpactRepository struct {
+	RepositoryID   int
+	RepositoryName string
+	Commit         string
+	Files          []RenameImpactFile
+	// Diff is the concatenation of the diffs of all files, suitable as the diff of a changeset spec.
+	Diff string
+}
+
+// RenameImpactFile is a file touched by a rename. Definitions and references are the ranges replaced
+// by the new name. Mismatches are the ranges whose text differs from the old name (e.g. aliased
+// imports), which are left untouched by the diff.
+type RenameImpactFile struct {
+	Path        string
+	Definitions []shared.Range
+	References  []shared.Range
+	Mismatches  []shared.Range
+	// Diff is the unified diff applying the rename to the file, empty if nothing is replaced.
+	Diff string
+}
+
+// APIChangeKind describes how a public symbol changed between two uploads.
+type APIChangeKind string
+
+const (
+	APIChangeKindAdded   APIChangeKind = "ADDED"
+	APIChangeKindRemoved APIChangeKind = "REMOVED"
+	APIChangeKindChanged APIChangeKind = "CHANGED"
+)
+
+// APIChange is a public symbol added, removed, or changed between a base and a head upload of the
+// same repository and root. Symbols are matched across uploads regardless of their package version.
+type APIChange struct {
+	Kind APIChangeKind
+	// Name is the display name of the symbol derived from its symbol.
+	Name string
+	// Base is the symbol in the base upload, nil if the symbol was added.
+	Base *APISymbolAtUpload
+	// Head is the symbol in the head upload, nil if the symbol was removed.
+	Head                 *APISymbolAtUpload
+	SignatureChanged     bool
+	DocumentationChanged bool
+}
+
+// APISymbolAtUpload is a public symbol along with the location of its definition at the commit of
+// the upload defining it.
+type APISymbolAtUpload struct {
+	Symbol        string
+	Signature     string
+	Documentation string
+	Location      shared.UploadLocation
+}
@@ -1,14 +1,19 @@
 package codenav
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func monikersToString(vs []precise.QualifiedMonikerData) string {
@@ -72,6 +77,21 @@ func rangeContainsPosition(r shared.Range, pos shared.Position) bool {
 	return true
 }
 
+// rangeContainsRange returns true if the outer range encloses the inner range.
+func rangeContainsRange(outer, inner shared.Range) bool {
+	return rangeContainsPosition(outer, inner.Start) && rangeContainsPosition(outer, inner.End)
+}
+
+// rangeFromSCIP converts a range in the encoding of SCIP occurrences.
+func rangeFromSCIP(scipRange []int32) shared.Range {
+	r := scip.NewRange(scipRange)
+
+	return shared.Range{
+		Start: shared.Position{Line: int(r.Start.Line), Character: int(r.Start.Character)},
+		End:   shared.Position{Line: int(r.End.Line), Character: int(r.End.Character)},
+	}
+}
+
 func sortRanges(ranges []shared.Range) []shared.Range {
 	sort.Slice(ranges, func(i, j int) bool {
 		iStart := ranges[i].Start
@@ -151,3 +171,101 @@ func newLinemap(source string) linemap {
 	l.positions = append(l.positions, lastNewline+lenToEnd+1)
 	return l
 }
+
+func isDefinitionOccurrence(occurrence *scip.Occurrence) bool {
+	return occurrence.SymbolRoles&int32(scip.SymbolRole_Definition) != 0
+}
+
+// isCallableSymbol returns true if the given symbol is a global function or method.
+func isCallableSymbol(symbol string) bool {
+	if !isGlobalSymbol(symbol) {
+		return false
+	}
+
+	parsed, err := scip.ParseSymbol(symbol)
+	if err != nil || len(parsed.Descriptors) == 0 {
+		return false
+	}
+
+	return parsed.Descriptors[len(parsed.Descriptors)-1].Suffix == scip.Descriptor_Method
+}
+
+// isGlobalSymbol returns true if the given symbol can be referenced from other documents.
+func isGlobalSymbol(symbol string) bool {
+	return symbol != "" && !scip.IsLocalSymbol(symbol)
+}
+
+// symbolMoniker returns the moniker of the given global symbol of the given kind, along with the
+// package information parsed from the symbol.
+func symbolMoniker(symbol, kind string) (precise.QualifiedMonikerData, error) {
+	parsed, err := scip.ParseSymbol(symbol)
+	if err != nil {
+		return precise.QualifiedMonikerData{}, err
+	}
+
+	return precise.QualifiedMonikerData{
+		MonikerData: precise.MonikerData{
+			Scheme:     parsed.Scheme,
+			Kind:       kind,
+			Identifier: symbol,
+		},
+		PackageInformationData: precise.PackageInformationData{
+			Manager: parsed.Package.Manager,
+			Name:    parsed.Package.Name,
+			Version: parsed.Package.Version,
+		},
+	}, nil
+}
+
+// symbolDisplayName returns the name of the given symbol, qualified with the name of its
+// enclosing type if there is one.
+func symbolDisplayName(symbol string) string {
+	parsed, err := scip.ParseSymbol(symbol)
+	if err != nil || len(parsed.Descriptors) == 0 {
+		return symbol
+	}
+
+	descriptors := parsed.Descriptors
+	name := descriptors[len(descriptors)-1].Name
+	if len(descriptors) > 1 && descriptors[len(descriptors)-2].Suffix == scip.Descriptor_Type {
+		name = descriptors[len(descriptors)-2].Name + "." + name
+	}
+
+	return name
+}
+
+// scipDocumentCache caches the SCIP documents read while following symbols across documents
+// and uploads within a single request.
+type scipDocumentCache struct {
+	lsifstore lsifstore.LsifStore
+	documents map[scipDocumentKey]*scip.Document
+}
+
+type scipDocumentKey struct {
+	uploadID int
+	path     string
+}
+
+func newSCIPDocumentCache(lsifstore lsifstore.LsifStore) *scipDocumentCache {
+	return &scipDocumentCache{
+		lsifstore: lsifstore,
+		documents: map[scipDocumentKey]*scip.Document{},
+	}
+}
+
+// get returns the document with the given path (relative to the root of the upload). A nil
+// document is returned if the upload does not contain the path.
+func (c *scipDocumentCache) get(ctx context.Context, uploadID int, path string) (*scip.Document, error) {
+	key := scipDocumentKey{uploadID: uploadID, path: path}
+	if document, ok := c.documents[key]; ok {
+		return document, nil
+	}
+
+	document, err := c.lsifstore.SCIPDocument(ctx, uploadID, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+	}
+
+	c.documents[key] = document
+	return document, nil
+}
@@ -1 +1,12 @@
 package context
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+type SymbolsClient interface {
+	Search(ctx context.Context, args search.SymbolsParameters) (result.Symbols, error)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/context/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
 )
 
 func NewService(
@@ -15,6 +16,7 @@ func NewService(
 	return newService(
 		observationCtx,
 		store,
+		symbols.DefaultClient,
 	)
 }
 
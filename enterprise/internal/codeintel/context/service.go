@@ -2,26 +2,101 @@ package context
 
 import (
 	"context"
+	"regexp"
+
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/context/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search"
 )
 
 type Service struct {
-	store      store.Store
-	operations *operations
+	store         store.Store
+	symbolsClient SymbolsClient
+	operations    *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	symbolsClient SymbolsClient,
 ) *Service {
 	return &Service{
-		store:      store,
-		operations: newOperations(observationCtx),
+		store:         store,
+		symbolsClient: symbolsClient,
+		operations:    newOperations(observationCtx),
+	}
+}
+
+// maxSymbolsPerFile is the maximum number of symbols requested from the symbols service to
+// split a single file.
+const maxSymbolsPerFile = 1000
+
+// SplitIntoEmbeddableChunks splits the given file into embeddable chunks. Chunks follow the
+// boundaries of the symbols defined in the file as reported by the symbols service. If the
+// symbols of the file are not available, the file is split with line-based heuristics.
+func (s *Service) SplitIntoEmbeddableChunks(ctx context.Context, repo api.RepoName, revision api.CommitID, text string, fileName string, splitOptions SplitOptions) (_ []EmbeddableChunk, err error) {
+	ctx, trace, endObservation := s.operations.splitIntoEmbeddableChunks.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repo", string(repo)),
+		attribute.String("revision", string(revision)),
+		attribute.String("fileName", fileName),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	// Files that are embedded in full do not need to be split at all
+	if embeddings.EstimateTokens(text) < splitOptions.NoSplitTokensThreshold {
+		return SplitIntoEmbeddableChunks(text, fileName, splitOptions), nil
+	}
+
+	symbols, err := s.getSymbolBoundaries(ctx, repo, revision, fileName)
+	if err != nil {
+		// The symbols service may be unavailable or unable to parse the file, which
+		// should not fail the entire embeddings job.
+		trace.AddEvent("failed to get symbols, falling back to line-based splitting", attribute.String("error", err.Error()))
+		return SplitIntoEmbeddableChunks(text, fileName, splitOptions), nil
 	}
+	trace.AddEvent("symbols", attribute.Int("numSymbols", len(symbols)))
+
+	return SplitIntoEmbeddableChunksAtSymbols(text, fileName, symbols, splitOptions), nil
+}
+
+// ignoredSymbolKinds are kinds of symbols that never start a new chunk.
+var ignoredSymbolKinds = map[string]struct{}{
+	"import":    {},
+	"label":     {},
+	"local":     {},
+	"package":   {},
+	"parameter": {},
 }
 
-func (s *Service) SplitIntoEmbeddableChunks(ctx context.Context, text string, fileName string, splitOptions SplitOptions) ([]EmbeddableChunk, error) {
-	return SplitIntoEmbeddableChunks(text, fileName, splitOptions), nil
+func (s *Service) getSymbolBoundaries(ctx context.Context, repo api.RepoName, revision api.CommitID, fileName string) ([]SymbolBoundary, error) {
+	symbols, err := s.symbolsClient.Search(ctx, search.SymbolsParameters{
+		Repo:            repo,
+		CommitID:        revision,
+		IsRegExp:        true,
+		IsCaseSensitive: true,
+		IncludePatterns: []string{"^" + regexp.QuoteMeta(fileName) + "$"},
+		First:           maxSymbolsPerFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	boundaries := make([]SymbolBoundary, 0, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := ignoredSymbolKinds[symbol.Kind]; ok {
+			continue
+		}
+
+		boundaries = append(boundaries, SymbolBoundary{
+			Name:   symbol.Name,
+			Parent: symbol.Parent,
+			Line:   symbol.Line,
+		})
+	}
+
+	return boundaries, nil
 }
@@ -1,3 +1,70 @@
 package context
 
-// TODO
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSplitIntoEmbeddableChunksWithSymbols(t *testing.T) {
+	text := `import os
+
+def first():
+    return 1
+
+def second():
+    return 2
+`
+	splitOptions := SplitOptions{ChunkTokensThreshold: 8, ChunkEarlySplitTokensThreshold: 8}
+
+	symbolsClient := NewMockSymbolsClient()
+	symbolsClient.SearchFunc.SetDefaultReturn(result.Symbols{
+		{Name: "os", Kind: "import", Line: 0},
+		{Name: "first", Kind: "function", Line: 2},
+		{Name: "second", Kind: "function", Line: 5},
+	}, nil)
+
+	svc := newService(&observation.TestContext, nil, symbolsClient)
+	chunks, err := svc.SplitIntoEmbeddableChunks(context.Background(), "github.com/test/test", "deadbeef", text, "a/b.py", splitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error splitting file: %s", err)
+	}
+
+	expectedChunks := []EmbeddableChunk{
+		{FileName: "a/b.py", StartLine: 0, EndLine: 2, Content: "import os\n"},
+		{FileName: "a/b.py", StartLine: 2, EndLine: 5, Content: "def first():\n    return 1\n", Symbol: "first"},
+		{FileName: "a/b.py", StartLine: 5, EndLine: 8, Content: "def second():\n    return 2\n", Symbol: "second"},
+	}
+	if diff := cmp.Diff(expectedChunks, chunks); diff != "" {
+		t.Errorf("unexpected chunks (-want +got):\n%s", diff)
+	}
+
+	if calls := symbolsClient.SearchFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of symbols requests. want=%d have=%d", 1, len(calls))
+	} else if args := calls[0].Arg1; args.Repo != "github.com/test/test" || args.CommitID != "deadbeef" || args.IncludePatterns[0] != `^a/b\.py$` {
+		t.Errorf("unexpected symbols request: %+v", args)
+	}
+}
+
+func TestSplitIntoEmbeddableChunksSymbolsUnavailable(t *testing.T) {
+	text := "Line\nLine\nLine\nLine\n\nLine\nLine\n\nLine\nLine\n"
+	splitOptions := SplitOptions{ChunkTokensThreshold: 4, ChunkEarlySplitTokensThreshold: 1}
+
+	symbolsClient := NewMockSymbolsClient()
+	symbolsClient.SearchFunc.SetDefaultReturn(nil, errors.New("symbols unavailable"))
+
+	svc := newService(&observation.TestContext, nil, symbolsClient)
+	chunks, err := svc.SplitIntoEmbeddableChunks(context.Background(), "github.com/test/test", "deadbeef", text, "a.txt", splitOptions)
+	if err != nil {
+		t.Fatalf("unexpected error splitting file: %s", err)
+	}
+
+	if diff := cmp.Diff(SplitIntoEmbeddableChunks(text, "a.txt", splitOptions), chunks); diff != "" {
+		t.Errorf("unexpected chunks (-want +got):\n%s", diff)
+	}
+}
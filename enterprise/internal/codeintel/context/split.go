@@ -1,6 +1,7 @@
 package context
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
@@ -43,6 +44,8 @@ type EmbeddableChunk struct {
 	StartLine int
 	EndLine   int
 	Content   string
+	// Symbol is the name of the symbol enclosing the chunk, if known.
+	Symbol string
 }
 
 // SplitIntoEmbeddableChunks splits the given text into embeddable chunks.
@@ -56,19 +59,25 @@ func SplitIntoEmbeddableChunks(text string, fileName string, splitOptions SplitO
 		return []EmbeddableChunk{{FileName: fileName, StartLine: 0, EndLine: strings.Count(text, "\n") + 1, Content: text}}
 	}
 
-	chunks := []EmbeddableChunk{}
-	startLine, tokensSum := 0, 0
 	lines := strings.Split(text, "\n")
+	return splitLines(lines, fileName, 0, len(lines), "", splitOptions)
+}
+
+// splitLines splits the lines in the range [start, end) into chunks using the line-based heuristics
+// described on SplitIntoEmbeddableChunks.
+func splitLines(lines []string, fileName string, start, end int, symbol string, splitOptions SplitOptions) []EmbeddableChunk {
+	chunks := []EmbeddableChunk{}
+	startLine, tokensSum := start, 0
 
 	addChunk := func(endLine int) {
 		content := strings.Join(lines[startLine:endLine], "\n")
 		if len(content) > 0 {
-			chunks = append(chunks, EmbeddableChunk{FileName: fileName, StartLine: startLine, EndLine: endLine, Content: content})
+			chunks = append(chunks, EmbeddableChunk{FileName: fileName, StartLine: startLine, EndLine: endLine, Content: content, Symbol: symbol})
 		}
 		startLine, tokensSum = endLine, 0
 	}
 
-	for i := 0; i < len(lines); i++ {
+	for i := start; i < end; i++ {
 		if tokensSum > splitOptions.ChunkTokensThreshold || (tokensSum > splitOptions.ChunkEarlySplitTokensThreshold && isSplittableLine(lines[i])) {
 			addChunk(i)
 		}
@@ -76,8 +85,192 @@ func SplitIntoEmbeddableChunks(text string, fileName string, splitOptions SplitO
 	}
 
 	if tokensSum > 0 {
-		addChunk(len(lines))
+		addChunk(end)
 	}
 
 	return chunks
 }
+
+// SymbolBoundary is a symbol definition at which a file can be split into chunks.
+type SymbolBoundary struct {
+	Name string
+	// Parent is the name of the enclosing symbol, if any.
+	Parent string
+	// Line is the zero-based line of the symbol definition.
+	Line int
+}
+
+// qualifiedName returns the name of the symbol prefixed with the name of its parent.
+func (s SymbolBoundary) qualifiedName() string {
+	if s.Parent == "" {
+		return s.Name
+	}
+	return s.Parent + "." + s.Name
+}
+
+// Lines directly preceding a symbol definition that start with one of these prefixes (comments
+// and annotations) are considered part of the symbol.
+var symbolPreambleLinePrefixes = []string{
+	"//",
+	"#",
+	"/*",
+	"*",
+	"--",
+	"@",
+}
+
+func isSymbolPreambleLine(line string) bool {
+	trimmedLine := strings.TrimSpace(line)
+	for _, prefix := range symbolPreambleLinePrefixes {
+		if strings.HasPrefix(trimmedLine, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// symbolSection is a range of lines [start, end) belonging to a single symbol definition. Lines
+// before the first symbol definition form a section without a symbol.
+type symbolSection struct {
+	start, end int
+	symbol     *SymbolBoundary
+	tokens     int
+}
+
+// SplitIntoEmbeddableChunksAtSymbols splits the given text into embeddable chunks along the
+// definitions of the given symbols.
+//
+// The text is divided into sections that start at each symbol definition (including its leading
+// comments) and end at the next one. Consecutive sections are grouped into chunks as long as they
+// fit into the chunk token threshold, and sections that do not fit into a single chunk are split
+// with the line-based heuristics of SplitIntoEmbeddableChunks. Each chunk records the name of the
+// symbol enclosing it: the symbol itself if the chunk covers a single symbol, or the common parent
+// of the symbols it covers.
+func SplitIntoEmbeddableChunksAtSymbols(text string, fileName string, symbols []SymbolBoundary, splitOptions SplitOptions) []EmbeddableChunk {
+	if len(symbols) == 0 || embeddings.EstimateTokens(text) < splitOptions.NoSplitTokensThreshold {
+		return SplitIntoEmbeddableChunks(text, fileName, splitOptions)
+	}
+
+	lines := strings.Split(text, "\n")
+	sections := splitIntoSymbolSections(lines, symbols)
+
+	chunks := []EmbeddableChunk{}
+	var group []symbolSection
+	groupTokens := 0
+
+	flush := func() {
+		if len(group) == 0 {
+			return
+		}
+
+		start, end := group[0].start, group[len(group)-1].end
+		content := strings.Join(lines[start:end], "\n")
+		if len(strings.TrimSpace(content)) > 0 {
+			chunks = append(chunks, EmbeddableChunk{FileName: fileName, StartLine: start, EndLine: end, Content: content, Symbol: enclosingSymbol(group)})
+		}
+		group, groupTokens = group[:0], 0
+	}
+
+	for _, section := range sections {
+		if section.tokens > splitOptions.ChunkTokensThreshold {
+			flush()
+
+			symbol := ""
+			if section.symbol != nil {
+				symbol = section.symbol.qualifiedName()
+			}
+			chunks = append(chunks, splitLines(lines, fileName, section.start, section.end, symbol, splitOptions)...)
+			continue
+		}
+
+		if groupTokens+section.tokens > splitOptions.ChunkTokensThreshold {
+			flush()
+		}
+		group = append(group, section)
+		groupTokens += section.tokens
+	}
+	flush()
+
+	return chunks
+}
+
+func splitIntoSymbolSections(lines []string, symbols []SymbolBoundary) []symbolSection {
+	symbols = append([]SymbolBoundary(nil), symbols...)
+	sort.SliceStable(symbols, func(i, j int) bool { return symbols[i].Line < symbols[j].Line })
+
+	var sections []symbolSection
+	for i := range symbols {
+		symbol := &symbols[i]
+		if symbol.Line < 0 || symbol.Line >= len(lines) {
+			continue
+		}
+
+		previousStart := 0
+		if len(sections) > 0 {
+			previousStart = sections[len(sections)-1].start
+		}
+
+		// Include the comments and annotations directly preceding the definition.
+		start := symbol.Line
+		for start > previousStart && isSymbolPreambleLine(lines[start-1]) {
+			start--
+		}
+		if len(sections) > 0 && start <= previousStart {
+			// Multiple symbols defined on the same line (or the preamble of a symbol), keep the outermost.
+			continue
+		}
+
+		if len(sections) == 0 && start > 0 {
+			sections = append(sections, symbolSection{start: 0})
+		}
+		sections = append(sections, symbolSection{start: start, symbol: symbol})
+	}
+
+	if len(sections) == 0 {
+		return []symbolSection{{start: 0, end: len(lines), tokens: estimateLinesTokens(lines)}}
+	}
+
+	for i := range sections {
+		if i+1 < len(sections) {
+			sections[i].end = sections[i+1].start
+		} else {
+			sections[i].end = len(lines)
+		}
+		sections[i].tokens = estimateLinesTokens(lines[sections[i].start:sections[i].end])
+	}
+
+	return sections
+}
+
+// enclosingSymbol returns the qualified name of the symbol enclosing all the given sections, or an
+// empty string if there is no such symbol.
+func enclosingSymbol(sections []symbolSection) string {
+	first := sections[0].symbol
+	if first == nil {
+		return ""
+	}
+
+	encloses := func(name string) bool {
+		for _, section := range sections {
+			if section.symbol == nil || (section.symbol.qualifiedName() != name && section.symbol.Parent != name) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, name := range []string{first.qualifiedName(), first.Parent} {
+		if name != "" && encloses(name) {
+			return name
+		}
+	}
+	return ""
+}
+
+func estimateLinesTokens(lines []string) int {
+	tokens := 0
+	for _, line := range lines {
+		tokens += embeddings.EstimateTokens(line)
+	}
+	return tokens
+}
@@ -3,6 +3,7 @@ package context
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hexops/autogold/v2"
 )
 
@@ -21,3 +22,63 @@ Line
 	chunks := SplitIntoEmbeddableChunks(content, "", SplitOptions{ChunkTokensThreshold: 4, ChunkEarlySplitTokensThreshold: 1})
 	autogold.ExpectFile(t, chunks)
 }
+
+func TestSplitIntoEmbeddableChunksAtSymbols(t *testing.T) {
+	content := `package server
+
+import "fmt"
+
+// Server serves requests.
+type Server struct {
+	name string
+}
+
+// Start starts the server
+// and logs its name.
+func (s *Server) Start() {
+	fmt.Println("starting", s.name)
+	fmt.Println("started", s.name)
+}
+
+func (s *Server) Stop() {
+	fmt.Println("stopping", s.name)
+}
+
+func main() {
+	s := &Server{name: "test"}
+	s.Start()
+	s.Stop()
+	fmt.Println("done")
+	fmt.Println("done")
+	fmt.Println("done")
+}
+`
+	symbols := []SymbolBoundary{
+		{Name: "Server", Line: 5},
+		{Name: "name", Parent: "Server", Line: 6},
+		{Name: "Start", Parent: "Server", Line: 11},
+		{Name: "Stop", Parent: "Server", Line: 16},
+		{Name: "main", Line: 20},
+	}
+
+	chunks := SplitIntoEmbeddableChunksAtSymbols(content, "server.go", symbols, SplitOptions{ChunkTokensThreshold: 40, ChunkEarlySplitTokensThreshold: 30})
+	autogold.ExpectFile(t, chunks)
+}
+
+func TestSplitIntoEmbeddableChunksAtSymbolsWithoutSymbols(t *testing.T) {
+	content := `Line
+Line
+Line
+Line
+
+Line
+Line
+
+Line
+Line
+`
+	splitOptions := SplitOptions{ChunkTokensThreshold: 4, ChunkEarlySplitTokensThreshold: 1}
+	if diff := cmp.Diff(SplitIntoEmbeddableChunks(content, "", splitOptions), SplitIntoEmbeddableChunksAtSymbols(content, "", nil, splitOptions)); diff != "" {
+		t.Errorf("unexpected chunks (-want +got):\n%s", diff)
+	}
+}
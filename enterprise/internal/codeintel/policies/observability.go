@@ -8,10 +8,11 @@ import (
 )
 
 type operations struct {
-	updateConfigurationPolicy  *observation.Operation
-	getRetentionPolicyOverview *observation.Operation
-	getPreviewRepositoryFilter *observation.Operation
-	getPreviewGitObjectFilter  *observation.Operation
+	updateConfigurationPolicy   *observation.Operation
+	getRetentionPolicyOverview  *observation.Operation
+	getPreviewRepositoryFilter  *observation.Operation
+	getPreviewGitObjectFilter   *observation.Operation
+	simulateConfigurationPolicy *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -35,9 +36,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		updateConfigurationPolicy:  op("UpdateConfigurationPolicy"),
-		getRetentionPolicyOverview: op("GetRetentionPolicyOverview"),
-		getPreviewRepositoryFilter: op("GetPreviewRepositoryFilter"),
-		getPreviewGitObjectFilter:  op("GetPreviewGitObjectFilter"),
+		updateConfigurationPolicy:   op("UpdateConfigurationPolicy"),
+		getRetentionPolicyOverview:  op("GetRetentionPolicyOverview"),
+		getPreviewRepositoryFilter:  op("GetPreviewRepositoryFilter"),
+		getPreviewGitObjectFilter:   op("GetPreviewGitObjectFilter"),
+		simulateConfigurationPolicy: op("SimulateConfigurationPolicy"),
 	}
 }
@@ -5,6 +5,10 @@ import (
 	"sort"
 	"time"
 
+	"github.com/gobwas/glob"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/internal/store"
 	policiesshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
@@ -280,6 +284,276 @@ func (s *Service) GetPreviewGitObjectFilter(
 	return gitObjects, totalCount, totalCountYoungerThanThreshold, nil
 }
 
+const (
+	simulationPolicyBatchSize = 100
+	simulationUploadBatchSize = 100
+)
+
+// SimulateConfigurationPolicy evaluates a proposed configuration policy without saving it. For each repository
+// to which the policy applies, this returns the commits that the policy would schedule for auto-indexing, and
+// whether each completed upload of the repository is retained by the current and the proposed set of data
+// retention policies. The upload expirer uses the same rules, so an upload that would be newly expired here
+// would be marked as expired shortly after the proposed policy is saved.
+func (s *Service) SimulateConfigurationPolicy(ctx context.Context, opts policiesshared.SimulateConfigurationPolicyOptions, now time.Time) (_ policiesshared.PolicySimulation, err error) {
+	ctx, _, endObservation := s.operations.simulateConfigurationPolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("policyID", opts.Policy.ID),
+		attribute.Int("numRepositoryIDs", len(opts.RepositoryIDs)),
+		attribute.Int("repositoryLimit", opts.RepositoryLimit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	policy := opts.Policy
+	if policy.ID != 0 {
+		existingPolicy, ok, err := s.store.GetConfigurationPolicyByID(ctx, policy.ID)
+		if err != nil {
+			return policiesshared.PolicySimulation{}, err
+		}
+		if !ok {
+			return policiesshared.PolicySimulation{}, errors.Newf("unknown configuration policy %d", policy.ID)
+		}
+
+		// The repository of an existing policy cannot be changed
+		policy.RepositoryID = existingPolicy.RepositoryID
+	}
+
+	repositoryIDs, totalCount, err := s.getSimulationRepositoryIDs(ctx, policy, opts.RepositoryIDs, opts.RepositoryLimit)
+	if err != nil {
+		return policiesshared.PolicySimulation{}, err
+	}
+
+	simulation := policiesshared.PolicySimulation{
+		Repositories:         make([]policiesshared.RepositoryPolicySimulation, 0, len(repositoryIDs)),
+		TotalRepositoryCount: totalCount,
+	}
+	for _, repositoryID := range repositoryIDs {
+		repositorySimulation, err := s.simulateConfigurationPolicyForRepository(ctx, repositoryID, policy, now)
+		if err != nil {
+			return policiesshared.PolicySimulation{}, err
+		}
+
+		simulation.Repositories = append(simulation.Repositories, repositorySimulation)
+		simulation.Summary.Merge(repositorySimulation.Summary)
+	}
+
+	return simulation, nil
+}
+
+// getSimulationRepositoryIDs returns the (possibly truncated) set of repositories over which a policy simulation
+// is run along with the total number of candidate repositories. Explicitly supplied repositories take precedence
+// over the repositories to which the policy applies.
+func (s *Service) getSimulationRepositoryIDs(ctx context.Context, policy policiesshared.ConfigurationPolicy, repositoryIDs []int, limit int) ([]int, int, error) {
+	if len(repositoryIDs) > 0 {
+		if len(repositoryIDs) > limit {
+			return repositoryIDs[:limit], len(repositoryIDs), nil
+		}
+
+		return repositoryIDs, len(repositoryIDs), nil
+	}
+
+	if policy.RepositoryID != nil {
+		return []int{*policy.RepositoryID}, 1, nil
+	}
+
+	patterns := []string{"*"}
+	if policy.RepositoryPatterns != nil && len(*policy.RepositoryPatterns) > 0 {
+		patterns = *policy.RepositoryPatterns
+	}
+
+	return s.store.GetRepoIDsByGlobPatterns(ctx, patterns, limit, 0)
+}
+
+func (s *Service) simulateConfigurationPolicyForRepository(
+	ctx context.Context,
+	repositoryID int,
+	policy policiesshared.ConfigurationPolicy,
+	now time.Time,
+) (policiesshared.RepositoryPolicySimulation, error) {
+	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return policiesshared.RepositoryPolicySimulation{}, err
+	}
+
+	applies, err := policyAppliesToRepository(policy, repositoryID, repo.Name)
+	if err != nil {
+		return policiesshared.RepositoryPolicySimulation{}, err
+	}
+
+	simulation := policiesshared.RepositoryPolicySimulation{RepositoryID: repositoryID}
+
+	if applies && policy.IndexingEnabled {
+		indexingMatcher := s.getPolicyMatcherFromFactory(IndexingExtractor, false, true)
+		commitMap, err := indexingMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repo.Name, []policiesshared.ConfigurationPolicy{policy}, now)
+		if err != nil {
+			return policiesshared.RepositoryPolicySimulation{}, err
+		}
+
+		simulation.IndexedCommits = makeSimulatedCommits(commitMap)
+	}
+
+	currentPolicies, err := s.getRetentionPoliciesForRepository(ctx, repositoryID)
+	if err != nil {
+		return policiesshared.RepositoryPolicySimulation{}, err
+	}
+
+	// The proposed set of policies replaces the current version of the simulated policy (if any)
+	proposedPolicies := make([]policiesshared.ConfigurationPolicy, 0, len(currentPolicies)+1)
+	for _, currentPolicy := range currentPolicies {
+		if policy.ID == 0 || currentPolicy.ID != policy.ID {
+			proposedPolicies = append(proposedPolicies, currentPolicy)
+		}
+	}
+	if applies && policy.RetentionEnabled {
+		proposedPolicies = append(proposedPolicies, policy)
+	}
+
+	retentionMatcher := s.getPolicyMatcherFromFactory(RetentionExtractor, true, false)
+	currentCommitMap, err := retentionMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repo.Name, currentPolicies, now)
+	if err != nil {
+		return policiesshared.RepositoryPolicySimulation{}, err
+	}
+	proposedCommitMap, err := retentionMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repo.Name, proposedPolicies, now)
+	if err != nil {
+		return policiesshared.RepositoryPolicySimulation{}, err
+	}
+
+	for offset := 0; ; {
+		// Consider the same uploads as the upload expirer. Uploads that have not yet been
+		// installed into the commit graph are visible to no commit and would be mistaken as
+		// unreachable.
+		uploads, totalCount, err := s.uploadSvc.GetUploads(ctx, shared.GetUploadsOptions{
+			RepositoryID:  repositoryID,
+			State:         "completed",
+			OldestFirst:   true,
+			InCommitGraph: true,
+			Limit:         simulationUploadBatchSize,
+			Offset:        offset,
+		})
+		if err != nil {
+			return policiesshared.RepositoryPolicySimulation{}, errors.Wrap(err, "uploadSvc.GetUploads")
+		}
+
+		for _, upload := range uploads {
+			visibleCommits, err := s.getCommitsVisibleToUpload(ctx, upload)
+			if err != nil {
+				return policiesshared.RepositoryPolicySimulation{}, err
+			}
+
+			simulatedUpload := policiesshared.SimulatedUpload{
+				ID:             upload.ID,
+				Commit:         upload.Commit,
+				Root:           upload.Root,
+				Indexer:        upload.Indexer,
+				UploadedAt:     upload.UploadedAt,
+				UploadSize:     upload.UploadSize,
+				RetainedBefore: isUploadRetained(currentCommitMap, upload, visibleCommits, now),
+				RetainedAfter:  isUploadRetained(proposedCommitMap, upload, visibleCommits, now),
+			}
+			simulation.Uploads = append(simulation.Uploads, simulatedUpload)
+			simulation.Summary.Add(simulatedUpload)
+		}
+
+		offset += len(uploads)
+		if len(uploads) == 0 || offset >= totalCount {
+			break
+		}
+	}
+
+	return simulation, nil
+}
+
+func (s *Service) getRetentionPoliciesForRepository(ctx context.Context, repositoryID int) (policies []policiesshared.ConfigurationPolicy, _ error) {
+	t := true
+	for offset := 0; ; {
+		policyBatch, totalCount, err := s.store.GetConfigurationPolicies(ctx, policiesshared.GetConfigurationPoliciesOptions{
+			RepositoryID:     repositoryID,
+			ForDataRetention: &t,
+			Limit:            simulationPolicyBatchSize,
+			Offset:           offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		offset += len(policyBatch)
+		policies = append(policies, policyBatch...)
+
+		if len(policyBatch) == 0 || offset >= totalCount {
+			return policies, nil
+		}
+	}
+}
+
+// policyAppliesToRepository returns true if the given policy applies to the given repository, either directly
+// or via one of its repository patterns. Policies without a repository or patterns apply to all repositories.
+func policyAppliesToRepository(policy policiesshared.ConfigurationPolicy, repositoryID int, repoName api.RepoName) (bool, error) {
+	if policy.RepositoryID != nil {
+		return *policy.RepositoryID == repositoryID, nil
+	}
+	if policy.RepositoryPatterns == nil || len(*policy.RepositoryPatterns) == 0 {
+		return true, nil
+	}
+
+	for _, pattern := range *policy.RepositoryPatterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to compile repository pattern `%s`", pattern)
+		}
+		if g.Match(string(repoName)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isUploadRetained returns true if any of the given commits visible to the given upload are described by a
+// policy whose retention duration covers the age of the upload.
+func isUploadRetained(commitMap map[string][]PolicyMatch, upload shared.Upload, visibleCommits []string, now time.Time) bool {
+	for _, commit := range visibleCommits {
+		for _, policyMatch := range commitMap[commit] {
+			if policyMatch.PolicyDuration == nil || now.Sub(upload.UploadedAt) < *policyMatch.PolicyDuration {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// makeSimulatedCommits converts the given policy matches into a list of commits ordered by commit date
+// (newest first).
+func makeSimulatedCommits(commitMap map[string][]PolicyMatch) []policiesshared.SimulatedCommit {
+	commits := make([]policiesshared.SimulatedCommit, 0, len(commitMap))
+	for commit, policyMatches := range commitMap {
+		simulatedCommit := policiesshared.SimulatedCommit{Commit: commit}
+		for _, policyMatch := range policyMatches {
+			if policyMatch.Name != "" && !slices.Contains(simulatedCommit.Names, policyMatch.Name) {
+				simulatedCommit.Names = append(simulatedCommit.Names, policyMatch.Name)
+			}
+			if simulatedCommit.CommittedAt == nil {
+				simulatedCommit.CommittedAt = policyMatch.CommittedAt
+			}
+		}
+		sort.Strings(simulatedCommit.Names)
+
+		commits = append(commits, simulatedCommit)
+	}
+
+	sort.Slice(commits, func(i, j int) bool {
+		ti, tj := commits[i].CommittedAt, commits[j].CommittedAt
+		if ti != nil && tj != nil && !ti.Equal(*tj) {
+			return ti.After(*tj)
+		}
+		if (ti == nil) != (tj == nil) {
+			return ti != nil
+		}
+
+		return commits[i].Commit < commits[j].Commit
+	})
+
+	return commits
+}
+
 func (s *Service) getCommitsVisibleToUpload(ctx context.Context, upload shared.Upload) (commits []string, err error) {
 	var token *string
 	for first := true; first || token != nil; first = false {
@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	internaltypes "github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
@@ -330,6 +331,115 @@ func TestRetentionPolicyOverview_ByVisibility(t *testing.T) {
 	}
 }
 
+func TestSimulateConfigurationPolicy(t *testing.T) {
+	mockStore := NewMockStore()
+	mockRepoStore := defaultMockRepoStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	svc := newService(&observation.TestContext, mockStore, mockRepoStore, mockUploadSvc, mockGitserverClient)
+
+	now := timeutil.Now()
+	taggedAt := now.Add(-time.Hour * 48)
+
+	currentPolicy := policiesshared.ConfigurationPolicy{
+		ID:                50,
+		RepositoryID:      pointers.Ptr(42),
+		Type:              policiesshared.GitObjectTypeTag,
+		Pattern:           "v*",
+		RetentionEnabled:  true,
+		RetentionDuration: pointers.Ptr(time.Hour * 24),
+	}
+	mockStore.GetConfigurationPolicyByIDFunc.SetDefaultReturn(currentPolicy, true, nil)
+	mockStore.GetConfigurationPoliciesFunc.SetDefaultReturn([]policiesshared.ConfigurationPolicy{currentPolicy}, 1, nil)
+
+	// deadbeef0 is tagged, deadbeef1 is the tip of the default branch, and deadbeef2 is unreachable
+	mockGitserverClient.RefDescriptionsFunc.SetDefaultReturn(map[string][]gitdomain.RefDescription{
+		"deadbeef0": {{Name: "v1.2.3", Type: gitdomain.RefTypeTag, CreatedDate: &taggedAt}},
+		"deadbeef1": {{Name: "main", Type: gitdomain.RefTypeBranch, IsDefaultBranch: true}},
+	}, nil)
+
+	uploads := []shared.Upload{
+		{ID: 1, Commit: "deadbeef0", Root: "lib/", Indexer: "scip-go", UploadedAt: now.Add(-time.Hour * 10), UploadSize: pointers.Ptr(int64(100))},
+		{ID: 2, Commit: "deadbeef1", Indexer: "scip-go", UploadedAt: now.Add(-time.Hour * 10), UploadSize: pointers.Ptr(int64(200))},
+		{ID: 3, Commit: "deadbeef2", Indexer: "scip-go", UploadedAt: now.Add(-time.Hour * 10), UploadSize: pointers.Ptr(int64(400))},
+	}
+	mockUploadSvc.GetUploadsFunc.SetDefaultReturn(uploads, len(uploads), nil)
+	mockUploadSvc.GetCommitsVisibleToUploadFunc.SetDefaultHook(func(ctx context.Context, uploadID, limit int, token *string) ([]string, *string, error) {
+		return []string{fmt.Sprintf("deadbeef%d", uploadID-1)}, nil, nil
+	})
+
+	// Shorten the retention duration and enable indexing of tags
+	proposedPolicy := currentPolicy
+	proposedPolicy.RepositoryID = nil
+	proposedPolicy.RetentionDuration = pointers.Ptr(time.Hour)
+	proposedPolicy.IndexingEnabled = true
+
+	simulation, err := svc.SimulateConfigurationPolicy(context.Background(), policiesshared.SimulateConfigurationPolicyOptions{
+		Policy:          proposedPolicy,
+		RepositoryLimit: 10,
+	}, now)
+	if err != nil {
+		t.Fatalf("unexpected error simulating policy: %s", err)
+	}
+
+	expectedSummary := policiesshared.RetentionSimulationSummary{
+		RetainedCount:     1,
+		ExpiredCount:      2,
+		NewlyExpiredCount: 1,
+		NewlyExpiredBytes: 100,
+	}
+	expected := policiesshared.PolicySimulation{
+		Repositories: []policiesshared.RepositoryPolicySimulation{
+			{
+				RepositoryID: 42,
+				IndexedCommits: []policiesshared.SimulatedCommit{
+					{Commit: "deadbeef0", Names: []string{"v1.2.3"}, CommittedAt: &taggedAt},
+				},
+				Uploads: []policiesshared.SimulatedUpload{
+					{ID: 1, Commit: "deadbeef0", Root: "lib/", Indexer: "scip-go", UploadedAt: uploads[0].UploadedAt, UploadSize: uploads[0].UploadSize, RetainedBefore: true, RetainedAfter: false},
+					{ID: 2, Commit: "deadbeef1", Indexer: "scip-go", UploadedAt: uploads[1].UploadedAt, UploadSize: uploads[1].UploadSize, RetainedBefore: true, RetainedAfter: true},
+					{ID: 3, Commit: "deadbeef2", Indexer: "scip-go", UploadedAt: uploads[2].UploadedAt, UploadSize: uploads[2].UploadSize, RetainedBefore: false, RetainedAfter: false},
+				},
+				Summary: expectedSummary,
+			},
+		},
+		TotalRepositoryCount: 1,
+		Summary:              expectedSummary,
+	}
+	if diff := cmp.Diff(expected, simulation); diff != "" {
+		t.Errorf("unexpected simulation (-want +got):\n%s", diff)
+	}
+
+	if len(mockStore.GetRepoIDsByGlobPatternsFunc.History()) != 0 {
+		t.Errorf("expected the repository of the existing policy to be simulated")
+	}
+}
+
+func TestPolicyAppliesToRepository(t *testing.T) {
+	testCases := []struct {
+		policy   policiesshared.ConfigurationPolicy
+		expected bool
+	}{
+		{policy: policiesshared.ConfigurationPolicy{}, expected: true},
+		{policy: policiesshared.ConfigurationPolicy{RepositoryID: pointers.Ptr(42)}, expected: true},
+		{policy: policiesshared.ConfigurationPolicy{RepositoryID: pointers.Ptr(43)}, expected: false},
+		{policy: policiesshared.ConfigurationPolicy{RepositoryPatterns: &[]string{"github.com/sourcegraph/*"}}, expected: true},
+		{policy: policiesshared.ConfigurationPolicy{RepositoryPatterns: &[]string{"gitlab.com/*", "github.com/*/sourcegraph"}}, expected: true},
+		{policy: policiesshared.ConfigurationPolicy{RepositoryPatterns: &[]string{"gitlab.com/*"}}, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		applies, err := policyAppliesToRepository(testCase.policy, 42, "github.com/sourcegraph/sourcegraph")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if applies != testCase.expected {
+			t.Errorf("unexpected result for policy %+v. want=%v have=%v", testCase.policy, testCase.expected, applies)
+		}
+	}
+}
+
 func mockConfigurationPolicies(policies []policiesshared.RetentionPolicyMatchCandidate) (mockedCandidates []policiesshared.RetentionPolicyMatchCandidate, mockedPolicies []policiesshared.ConfigurationPolicy) {
 	for i, policy := range policies {
 		if policy.ConfigurationPolicy != nil {
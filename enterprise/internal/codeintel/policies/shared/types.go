@@ -63,3 +63,106 @@ type GetConfigurationPoliciesOptions struct {
 	// Offset indicates the number of results to skip in the result set.
 	Offset int
 }
+
+type SimulateConfigurationPolicyOptions struct {
+	// Policy is the proposed configuration policy. If the identifier of the policy is
+	// non-zero, the proposed policy replaces the existing policy with that identifier.
+	// Otherwise, the proposed policy is evaluated alongside the existing policies.
+	Policy ConfigurationPolicy
+
+	// RepositoryIDs restricts the simulation to the given repositories. If empty, the
+	// simulation is run over the repositories to which the proposed policy applies.
+	RepositoryIDs []int
+
+	// RepositoryLimit indicates the maximum number of repositories to simulate.
+	RepositoryLimit int
+}
+
+// PolicySimulation describes the effects that a proposed configuration policy would
+// have on auto-indexing and data retention if it were saved.
+type PolicySimulation struct {
+	Repositories []RepositoryPolicySimulation
+
+	// TotalRepositoryCount is the number of repositories to which the proposed policy
+	// applies. This may be larger than the number of simulated repositories.
+	TotalRepositoryCount int
+
+	// Summary aggregates the upload retention changes over all simulated repositories.
+	Summary RetentionSimulationSummary
+}
+
+type RepositoryPolicySimulation struct {
+	RepositoryID int
+
+	// IndexedCommits is the set of commits the proposed policy would schedule for
+	// auto-indexing. This is empty if the policy does not enable indexing.
+	IndexedCommits []SimulatedCommit
+
+	// Uploads contains the completed uploads of the repository along with whether
+	// they are retained by the current and the proposed set of policies.
+	Uploads []SimulatedUpload
+
+	Summary RetentionSimulationSummary
+}
+
+type SimulatedCommit struct {
+	Commit      string
+	Names       []string
+	CommittedAt *time.Time
+}
+
+type SimulatedUpload struct {
+	ID             int
+	Commit         string
+	Root           string
+	Indexer        string
+	UploadedAt     time.Time
+	UploadSize     *int64
+	RetainedBefore bool
+	RetainedAfter  bool
+}
+
+// RetentionSimulationSummary counts the uploads retained and expired by the proposed
+// set of policies, and the uploads whose retention changes compared to the current set
+// of policies. Storage impact is measured in bytes of uploaded index data.
+type RetentionSimulationSummary struct {
+	RetainedCount      int
+	ExpiredCount       int
+	NewlyRetainedCount int
+	NewlyExpiredCount  int
+	NewlyRetainedBytes int64
+	NewlyExpiredBytes  int64
+}
+
+// Add records the given upload in the summary.
+func (s *RetentionSimulationSummary) Add(upload SimulatedUpload) {
+	var size int64
+	if upload.UploadSize != nil {
+		size = *upload.UploadSize
+	}
+
+	if upload.RetainedAfter {
+		s.RetainedCount++
+	} else {
+		s.ExpiredCount++
+	}
+
+	if upload.RetainedAfter && !upload.RetainedBefore {
+		s.NewlyRetainedCount++
+		s.NewlyRetainedBytes += size
+	}
+	if upload.RetainedBefore && !upload.RetainedAfter {
+		s.NewlyExpiredCount++
+		s.NewlyExpiredBytes += size
+	}
+}
+
+// Merge adds the counts of the given summary to this summary.
+func (s *RetentionSimulationSummary) Merge(other RetentionSimulationSummary) {
+	s.RetainedCount += other.RetainedCount
+	s.ExpiredCount += other.ExpiredCount
+	s.NewlyRetainedCount += other.NewlyRetainedCount
+	s.NewlyExpiredCount += other.NewlyExpiredCount
+	s.NewlyRetainedBytes += other.NewlyRetainedBytes
+	s.NewlyExpiredBytes += other.NewlyExpiredBytes
+}
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
@@ -21,4 +22,7 @@ type PoliciesService interface {
 	// Filter previews
 	GetPreviewRepositoryFilter(ctx context.Context, patterns []string, limit int) (_ []int, totalCount int, matchesAll bool, repositoryMatchLimit *int, _ error)
 	GetPreviewGitObjectFilter(ctx context.Context, repositoryID int, gitObjectType shared.GitObjectType, pattern string, limit int, countObjectsYoungerThanHours *int32) (_ []policies.GitObject, totalCount int, totalCountYoungerThanThreshold *int, _ error)
+
+	// Policy simulation
+	SimulateConfigurationPolicy(ctx context.Context, opts shared.SimulateConfigurationPolicyOptions, now time.Time) (shared.PolicySimulation, error)
 }
@@ -8,13 +8,14 @@ import (
 )
 
 type operations struct {
-	configurationPolicies     *observation.Operation
-	configurationPolicyByID   *observation.Operation
-	createConfigurationPolicy *observation.Operation
-	deleteConfigurationPolicy *observation.Operation
-	previewGitObjectFilter    *observation.Operation
-	previewRepoFilter         *observation.Operation
-	updateConfigurationPolicy *observation.Operation
+	configurationPolicies       *observation.Operation
+	configurationPolicyByID     *observation.Operation
+	createConfigurationPolicy   *observation.Operation
+	deleteConfigurationPolicy   *observation.Operation
+	previewGitObjectFilter      *observation.Operation
+	previewRepoFilter           *observation.Operation
+	simulateConfigurationPolicy *observation.Operation
+	updateConfigurationPolicy   *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -34,12 +35,13 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		configurationPolicies:     op("ConfigurationPolicies"),
-		configurationPolicyByID:   op("ConfigurationPolicyByID"),
-		createConfigurationPolicy: op("CreateConfigurationPolicy"),
-		deleteConfigurationPolicy: op("DeleteConfigurationPolicy"),
-		previewGitObjectFilter:    op("PreviewGitObjectFilter"),
-		previewRepoFilter:         op("PreviewRepoFilter"),
-		updateConfigurationPolicy: op("UpdateConfigurationPolicy"),
+		configurationPolicies:       op("ConfigurationPolicies"),
+		configurationPolicyByID:     op("ConfigurationPolicyByID"),
+		createConfigurationPolicy:   op("CreateConfigurationPolicy"),
+		deleteConfigurationPolicy:   op("DeleteConfigurationPolicy"),
+		previewGitObjectFilter:      op("PreviewGitObjectFilter"),
+		previewRepoFilter:           op("PreviewRepoFilter"),
+		simulateConfigurationPolicy: op("SimulateConfigurationPolicy"),
+		updateConfigurationPolicy:   op("UpdateConfigurationPolicy"),
 	}
 }
@@ -0,0 +1,248 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers/gitresolvers"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+const (
+	DefaultPolicySimulationRepositoryLimit = 10
+
+	// MaxPolicySimulationRepositoryLimit bounds the number of repositories simulated within a
+	// single request, as each repository is matched against gitserver twice.
+	MaxPolicySimulationRepositoryLimit = 100
+)
+
+// 🚨 SECURITY: Only site admins may simulate code intelligence configuration policies
+func (r *rootResolver) SimulateCodeIntelligenceConfigurationPolicy(ctx context.Context, args *resolverstubs.SimulateCodeIntelligenceConfigurationPolicyArgs) (_ resolverstubs.CodeIntelligenceConfigurationPolicySimulationResolver, err error) {
+	ctx, _, endObservation := r.operations.simulateConfigurationPolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("policyID", string(pointers.Deref(args.ID, ""))),
+		attribute.String("repository", string(pointers.Deref(args.Repository, ""))),
+		attribute.Int("first", int(pointers.Deref(args.First, 0))),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Simulated policies are never saved, so a name is not required
+	policy := args.CodeIntelConfigurationPolicy
+	if policy.Name == "" {
+		policy.Name = "simulation"
+	}
+	if err := validateConfigurationPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	var id int
+	if args.ID != nil {
+		if id, err = resolverstubs.UnmarshalID[int](*args.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	var repositoryID *int
+	if args.Repository != nil {
+		id64, err := resolverstubs.UnmarshalID[int64](*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+
+		v := int(id64)
+		repositoryID = &v
+	}
+
+	var repositoryIDs []int
+	if args.Repositories != nil {
+		for _, repository := range *args.Repositories {
+			id64, err := resolverstubs.UnmarshalID[int64](repository)
+			if err != nil {
+				return nil, err
+			}
+
+			repositoryIDs = append(repositoryIDs, int(id64))
+		}
+	}
+
+	repositoryLimit := int(pointers.Deref(args.First, DefaultPolicySimulationRepositoryLimit))
+	if repositoryLimit > MaxPolicySimulationRepositoryLimit {
+		repositoryLimit = MaxPolicySimulationRepositoryLimit
+	}
+
+	simulation, err := r.policySvc.SimulateConfigurationPolicy(ctx, shared.SimulateConfigurationPolicyOptions{
+		Policy: shared.ConfigurationPolicy{
+			ID:                        id,
+			RepositoryID:              repositoryID,
+			RepositoryPatterns:        args.RepositoryPatterns,
+			Type:                      shared.GitObjectType(args.Type),
+			Pattern:                   args.Pattern,
+			RetentionEnabled:          args.RetentionEnabled,
+			RetentionDuration:         toDuration(args.RetentionDurationHours),
+			RetainIntermediateCommits: args.RetainIntermediateCommits,
+			IndexingEnabled:           args.IndexingEnabled,
+			IndexCommitMaxAge:         toDuration(args.IndexCommitMaxAgeHours),
+			IndexIntermediateCommits:  args.IndexIntermediateCommits,
+		},
+		RepositoryIDs:   repositoryIDs,
+		RepositoryLimit: repositoryLimit,
+	}, timeutil.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryResolvers := make([]resolverstubs.CodeIntelligenceRepositoryPolicySimulationResolver, 0, len(simulation.Repositories))
+	for _, repositorySimulation := range simulation.Repositories {
+		repositoryResolver, err := gitresolvers.NewRepositoryFromID(ctx, r.repoStore, repositorySimulation.RepositoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		repositoryResolvers = append(repositoryResolvers, newRepositoryPolicySimulationResolver(repositoryResolver, repositorySimulation))
+	}
+
+	return &policySimulationResolver{
+		repositoryResolvers: repositoryResolvers,
+		simulation:          simulation,
+	}, nil
+}
+
+//
+//
+
+type policySimulationResolver struct {
+	repositoryResolvers []resolverstubs.CodeIntelligenceRepositoryPolicySimulationResolver
+	simulation          shared.PolicySimulation
+}
+
+func (r *policySimulationResolver) Repositories() []resolverstubs.CodeIntelligenceRepositoryPolicySimulationResolver {
+	return r.repositoryResolvers
+}
+
+func (r *policySimulationResolver) TotalRepositoryCount() int32 {
+	return int32(r.simulation.TotalRepositoryCount)
+}
+
+func (r *policySimulationResolver) Summary() resolverstubs.CodeIntelligenceRetentionSimulationSummaryResolver {
+	return &retentionSimulationSummaryResolver{summary: r.simulation.Summary}
+}
+
+//
+//
+
+type repositoryPolicySimulationResolver struct {
+	repositoryResolver resolverstubs.RepositoryResolver
+	simulation         shared.RepositoryPolicySimulation
+}
+
+func newRepositoryPolicySimulationResolver(repositoryResolver resolverstubs.RepositoryResolver, simulation shared.RepositoryPolicySimulation) resolverstubs.CodeIntelligenceRepositoryPolicySimulationResolver {
+	return &repositoryPolicySimulationResolver{
+		repositoryResolver: repositoryResolver,
+		simulation:         simulation,
+	}
+}
+
+func (r *repositoryPolicySimulationResolver) Repository() resolverstubs.RepositoryResolver {
+	return r.repositoryResolver
+}
+
+func (r *repositoryPolicySimulationResolver) IndexedCommits() []resolverstubs.CodeIntelGitObjectResolver {
+	resolvers := make([]resolverstubs.CodeIntelGitObjectResolver, 0, len(r.simulation.IndexedCommits))
+	for _, commit := range r.simulation.IndexedCommits {
+		var committedAt time.Time
+		if commit.CommittedAt != nil {
+			committedAt = *commit.CommittedAt
+		}
+
+		names := commit.Names
+		if len(names) == 0 {
+			names = []string{commit.Commit}
+		}
+		for _, name := range names {
+			resolvers = append(resolvers, newGitObjectResolver(name, commit.Commit, committedAt))
+		}
+	}
+
+	return resolvers
+}
+
+func (r *repositoryPolicySimulationResolver) Uploads() []resolverstubs.CodeIntelligenceSimulatedUploadResolver {
+	resolvers := make([]resolverstubs.CodeIntelligenceSimulatedUploadResolver, 0, len(r.simulation.Uploads))
+	for _, upload := range r.simulation.Uploads {
+		resolvers = append(resolvers, &simulatedUploadResolver{upload: upload})
+	}
+
+	return resolvers
+}
+
+func (r *repositoryPolicySimulationResolver) Summary() resolverstubs.CodeIntelligenceRetentionSimulationSummaryResolver {
+	return &retentionSimulationSummaryResolver{summary: r.simulation.Summary}
+}
+
+//
+//
+
+type simulatedUploadResolver struct {
+	upload shared.SimulatedUpload
+}
+
+func (r *simulatedUploadResolver) UploadID() int32      { return int32(r.upload.ID) }
+func (r *simulatedUploadResolver) Commit() string       { return r.upload.Commit }
+func (r *simulatedUploadResolver) Root() string         { return r.upload.Root }
+func (r *simulatedUploadResolver) Indexer() string      { return r.upload.Indexer }
+func (r *simulatedUploadResolver) RetainedBefore() bool { return r.upload.RetainedBefore }
+func (r *simulatedUploadResolver) RetainedAfter() bool  { return r.upload.RetainedAfter }
+
+func (r *simulatedUploadResolver) UploadedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.upload.UploadedAt}
+}
+
+func (r *simulatedUploadResolver) UploadSizeBytes() *float64 {
+	if r.upload.UploadSize == nil {
+		return nil
+	}
+
+	v := float64(*r.upload.UploadSize)
+	return &v
+}
+
+//
+//
+
+type retentionSimulationSummaryResolver struct {
+	summary shared.RetentionSimulationSummary
+}
+
+func (r *retentionSimulationSummaryResolver) RetainedCount() int32 {
+	return int32(r.summary.RetainedCount)
+}
+
+func (r *retentionSimulationSummaryResolver) ExpiredCount() int32 {
+	return int32(r.summary.ExpiredCount)
+}
+
+func (r *retentionSimulationSummaryResolver) NewlyRetainedCount() int32 {
+	return int32(r.summary.NewlyRetainedCount)
+}
+
+func (r *retentionSimulationSummaryResolver) NewlyExpiredCount() int32 {
+	return int32(r.summary.NewlyExpiredCount)
+}
+
+func (r *retentionSimulationSummaryResolver) NewlyRetainedBytes() float64 {
+	return float64(r.summary.NewlyRetainedBytes)
+}
+
+func (r *retentionSimulationSummaryResolver) NewlyExpiredBytes() float64 {
+	return float64(r.summary.NewlyExpiredBytes)
+}
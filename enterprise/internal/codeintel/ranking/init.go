@@ -8,10 +8,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/background/mapper"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/background/reducer"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/signals"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/store"
 	codeintelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -20,12 +22,16 @@ func NewService(
 	observationCtx *observation.Context,
 	db database.DB,
 	codeIntelDB codeintelshared.CodeIntelDB,
+	gitserverClient gitserver.Client,
 ) *Service {
+	rankingStore := store.New(scopedContext("store", observationCtx), db)
+
 	return newService(
 		scopedContext("service", observationCtx),
-		store.New(scopedContext("store", observationCtx), db),
+		rankingStore,
 		lsifstore.New(scopedContext("lsifstore", observationCtx), codeIntelDB),
 		conf.DefaultClient(),
+		signals.NewSignals(rankingStore, gitserverClient),
 	)
 }
 
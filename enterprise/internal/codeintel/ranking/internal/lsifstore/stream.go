@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
@@ -26,7 +27,7 @@ func (s *store) InsertDefinitionsAndReferencesForDocument(
 	}})
 	defer endObservation(1, observation.Args{})
 
-	rows, err := s.db.Query(ctx, sqlf.Sprintf(getDocumentsByUploadIDQuery, upload.UploadID))
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(getDocumentsByUploadIDQuery, pq.Array([]int{upload.UploadID})))
 	if err != nil {
 		return err
 	}
@@ -58,11 +59,14 @@ func (s *store) InsertDefinitionsAndReferencesForDocument(
 }
 
 const getDocumentsByUploadIDQuery = `
+WITH RECURSIVE
+` + shared.SCIPLayersCTE + `
 SELECT
 	sid.document_path,
 	sd.raw_scip_payload
-FROM codeintel_scip_document_lookup sid
+FROM layers l
+JOIN codeintel_scip_document_lookup sid ON sid.upload_id = l.layer_id
 JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
-WHERE sid.upload_id = %s
+WHERE` + shared.SCIPVisibleDocumentCondition + `
 ORDER BY sid.document_path
 `
@@ -0,0 +1,262 @@
+package signals
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+const (
+	ReferenceCount     = "referenceCount"
+	RecentActivity     = "recentActivity"
+	RecentViews        = "recentViews"
+	RecentContributors = "recentContributors"
+	FileSize           = "fileSize"
+)
+
+// DefaultWeights are the weights used for signals absent from the site configuration. Only
+// the reference count signal is enabled by default, which preserves the historic ranks.
+var DefaultWeights = map[string]float64{
+	ReferenceCount:     1,
+	RecentActivity:     0,
+	RecentViews:        0,
+	RecentContributors: 0,
+	FileSize:           0,
+}
+
+// recentActivityWindow is the lookback period for counting commits touching a path. The start
+// of the window is truncated to the day so that scores can be cached for the day.
+const recentActivityWindow = 90 * 24 * time.Hour
+
+// commitScoreCacheSize is the number of repository commits for which each git-backed signal
+// retains path scores.
+const commitScoreCacheSize = 100
+
+// repoScoreCacheSize is the number of repositories for which each own signal retains path scores.
+const repoScoreCacheSize = 100
+
+// repoScoreTTL is the duration for which the path scores of an own signal are retained. The own
+// signal aggregates are recomputed periodically in the background, so there is no commit to key
+// the scores by.
+const repoScoreTTL = time.Hour
+
+type OwnSignalStore interface {
+	GetRecentViewCounts(ctx context.Context, repoName api.RepoName) (map[string]float64, error)
+	GetRecentContributorCounts(ctx context.Context, repoName api.RepoName) (map[string]float64, error)
+}
+
+// NewSignals returns all ranking signals beyond reference counts, which are provided
+// directly by the ranking store.
+func NewSignals(store OwnSignalStore, gitserverClient gitserver.Client) []shared.RankingSignal {
+	return []shared.RankingSignal{
+		NewRecentActivitySignal(gitserverClient, time.Now),
+		NewRecentViewsSignal(store, time.Now),
+		NewRecentContributorsSignal(store, time.Now),
+		NewFileSizeSignal(gitserverClient),
+	}
+}
+
+//
+//
+
+type recentActivitySignal struct {
+	cache *commitScoreCache
+	clock func() time.Time
+}
+
+// NewRecentActivitySignal scores paths by the number of commits that touched them recently.
+func NewRecentActivitySignal(gitserverClient gitserver.Client, clock func() time.Time) shared.RankingSignal {
+	return &recentActivitySignal{cache: newCommitScoreCache(gitserverClient), clock: clock}
+}
+
+func (s *recentActivitySignal) Name() string { return RecentActivity }
+
+func (s *recentActivitySignal) PathScores(ctx context.Context, repoName api.RepoName) (map[string]float64, error) {
+	after := s.clock().Add(-recentActivityWindow).Truncate(24 * time.Hour)
+
+	return s.cache.get(ctx, repoName, after, func(_ api.CommitID) (map[string]float64, error) {
+		commits, err := s.cache.gitserverClient.CommitLog(ctx, repoName, after)
+		if err != nil {
+			return nil, err
+		}
+
+		scores := map[string]float64{}
+		for _, commit := range commits {
+			for _, path := range commit.ChangedFiles {
+				scores[path]++
+			}
+		}
+
+		return scores, nil
+	})
+}
+
+//
+//
+
+type recentViewsSignal struct {
+	store OwnSignalStore
+	cache *repoScoreCache
+}
+
+// NewRecentViewsSignal scores paths by the number of recent views recorded by the own
+// aggregate signal.
+func NewRecentViewsSignal(store OwnSignalStore, clock func() time.Time) shared.RankingSignal {
+	return &recentViewsSignal{store: store, cache: newRepoScoreCache(clock)}
+}
+
+func (s *recentViewsSignal) Name() string { return RecentViews }
+
+func (s *recentViewsSignal) PathScores(ctx context.Context, repoName api.RepoName) (map[string]float64, error) {
+	return s.cache.get(repoName, func() (map[string]float64, error) {
+		return s.store.GetRecentViewCounts(ctx, repoName)
+	})
+}
+
+//
+//
+
+type recentContributorsSignal struct {
+	store OwnSignalStore
+	cache *repoScoreCache
+}
+
+// NewRecentContributorsSignal scores paths by the number of distinct recent contributors
+// recorded by the own aggregate signal.
+func NewRecentContributorsSignal(store OwnSignalStore, clock func() time.Time) shared.RankingSignal {
+	return &recentContributorsSignal{store: store, cache: newRepoScoreCache(clock)}
+}
+
+func (s *recentContributorsSignal) Name() string { return RecentContributors }
+
+func (s *recentContributorsSignal) PathScores(ctx context.Context, repoName api.RepoName) (map[string]float64, error) {
+	return s.cache.get(repoName, func() (map[string]float64, error) {
+		return s.store.GetRecentContributorCounts(ctx, repoName)
+	})
+}
+
+//
+//
+
+type fileSizeSignal struct {
+	cache *commitScoreCache
+}
+
+// NewFileSizeSignal scores paths by their size in KiB on the default branch. This signal is
+// generally given a negative weight so that large (often generated) files rank lower.
+func NewFileSizeSignal(gitserverClient gitserver.Client) shared.RankingSignal {
+	return &fileSizeSignal{cache: newCommitScoreCache(gitserverClient)}
+}
+
+func (s *fileSizeSignal) Name() string { return FileSize }
+
+func (s *fileSizeSignal) PathScores(ctx context.Context, repoName api.RepoName) (map[string]float64, error) {
+	return s.cache.get(ctx, repoName, time.Time{}, func(commit api.CommitID) (map[string]float64, error) {
+		fileInfos, err := s.cache.gitserverClient.ReadDir(ctx, nil, repoName, commit, "", true)
+		if err != nil {
+			return nil, err
+		}
+
+		scores := make(map[string]float64, len(fileInfos))
+		for _, fileInfo := range fileInfos {
+			if !fileInfo.IsDir() {
+				scores[fileInfo.Name()] = float64(fileInfo.Size()) / 1024
+			}
+		}
+
+		return scores, nil
+	})
+}
+
+//
+//
+
+// commitScoreCache retains the path scores that a signal computed from the git history or
+// tree of a repository, keyed by the head of the default branch. Document ranks are requested
+// on every search and context query, so scores are recomputed only once the default branch
+// moves rather than on each request.
+type commitScoreCache struct {
+	gitserverClient gitserver.Client
+	scores          *lru.Cache[commitScoreKey, map[string]float64]
+}
+
+type commitScoreKey struct {
+	repoName api.RepoName
+	commit   api.CommitID
+	after    time.Time
+}
+
+func newCommitScoreCache(gitserverClient gitserver.Client) *commitScoreCache {
+	scores, _ := lru.New[commitScoreKey, map[string]float64](commitScoreCacheSize)
+	return &commitScoreCache{gitserverClient: gitserverClient, scores: scores}
+}
+
+// get returns the cached scores for the head of the default branch of the given repository,
+// invoking compute on a cache miss. The after value distinguishes scores that also depend on
+// a point in time. The returned map is shared and must not be modified.
+func (c *commitScoreCache) get(
+	ctx context.Context,
+	repoName api.RepoName,
+	after time.Time,
+	compute func(commit api.CommitID) (map[string]float64, error),
+) (map[string]float64, error) {
+	_, commit, err := c.gitserverClient.GetDefaultBranch(ctx, repoName, true)
+	if err != nil || commit == "" {
+		return nil, err
+	}
+
+	key := commitScoreKey{repoName: repoName, commit: commit, after: after}
+	if scores, ok := c.scores.Get(key); ok {
+		return scores, nil
+	}
+
+	scores, err := compute(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	c.scores.Add(key, scores)
+	return scores, nil
+}
+
+//
+//
+
+// repoScoreCache retains the path scores that a signal computed from the own signal aggregates
+// of a repository for repoScoreTTL, so that the aggregates are not queried on each request.
+type repoScoreCache struct {
+	clock  func() time.Time
+	scores *lru.Cache[api.RepoName, repoScores]
+}
+
+type repoScores struct {
+	scores    map[string]float64
+	expiresAt time.Time
+}
+
+func newRepoScoreCache(clock func() time.Time) *repoScoreCache {
+	scores, _ := lru.New[api.RepoName, repoScores](repoScoreCacheSize)
+	return &repoScoreCache{clock: clock, scores: scores}
+}
+
+// get returns the unexpired cached scores of the given repository, invoking compute on a cache
+// miss. The returned map is shared and must not be modified.
+func (c *repoScoreCache) get(repoName api.RepoName, compute func() (map[string]float64, error)) (map[string]float64, error) {
+	now := c.clock()
+	if cached, ok := c.scores.Get(repoName); ok && now.Before(cached.expiresAt) {
+		return cached.scores, nil
+	}
+
+	scores, err := compute()
+	if err != nil {
+		return nil, err
+	}
+
+	c.scores.Add(repoName, repoScores{scores: scores, expiresAt: now.Add(repoScoreTTL)})
+	return scores, nil
+}
@@ -0,0 +1,140 @@
+package signals
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+func TestRecentActivitySignal(t *testing.T) {
+	now := time.Unix(1587396557, 0).UTC()
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.GetDefaultBranchFunc.SetDefaultReturn("main", api.CommitID("deadbeef"), nil)
+	gitserverClient.CommitLogFunc.SetDefaultReturn([]gitserver.CommitLog{
+		{SHA: "c1", ChangedFiles: []string{"a.go", "b.go"}},
+		{SHA: "c2", ChangedFiles: []string{"a.go"}},
+		{SHA: "c3", ChangedFiles: []string{"a.go", "c.go"}},
+	}, nil)
+
+	signal := NewRecentActivitySignal(gitserverClient, func() time.Time { return now })
+
+	// Scores of the second call are served from the cache
+	for i := 0; i < 2; i++ {
+		scores, err := signal.PathScores(context.Background(), api.RepoName("foo"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := map[string]float64{"a.go": 3, "b.go": 1, "c.go": 1}
+		if diff := cmp.Diff(expected, scores); diff != "" {
+			t.Errorf("unexpected scores (-want +got):\n%s", diff)
+		}
+	}
+
+	if calls := gitserverClient.CommitLogFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of CommitLog calls. want=%d have=%d", 1, len(calls))
+	} else if expected := now.Add(-recentActivityWindow).Truncate(24 * time.Hour); !calls[0].Arg2.Equal(expected) {
+		t.Errorf("unexpected CommitLog after argument. want=%s have=%s", expected, calls[0].Arg2)
+	}
+
+	// Scores are recomputed once the default branch moves
+	gitserverClient.GetDefaultBranchFunc.SetDefaultReturn("main", api.CommitID("cafebabe"), nil)
+	if _, err := signal.PathScores(context.Background(), api.RepoName("foo")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls := gitserverClient.CommitLogFunc.History(); len(calls) != 2 {
+		t.Fatalf("unexpected number of CommitLog calls. want=%d have=%d", 2, len(calls))
+	}
+}
+
+func TestFileSizeSignal(t *testing.T) {
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.GetDefaultBranchFunc.SetDefaultReturn("main", api.CommitID("deadbeef"), nil)
+	gitserverClient.ReadDirFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, commit api.CommitID, _ string, _ bool) ([]fs.FileInfo, error) {
+		if commit != "deadbeef" {
+			t.Errorf("unexpected commit. want=%q have=%q", "deadbeef", commit)
+		}
+
+		return []fs.FileInfo{
+			&fileutil.FileInfo{Name_: "src", Mode_: fs.ModeDir},
+			&fileutil.FileInfo{Name_: "src/a.go", Size_: 2048},
+			&fileutil.FileInfo{Name_: "src/b.go", Size_: 512},
+		}, nil
+	})
+
+	signal := NewFileSizeSignal(gitserverClient)
+
+	// Scores of the second call are served from the cache
+	for i := 0; i < 2; i++ {
+		scores, err := signal.PathScores(context.Background(), api.RepoName("foo"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := map[string]float64{"src/a.go": 2, "src/b.go": 0.5}
+		if diff := cmp.Diff(expected, scores); diff != "" {
+			t.Errorf("unexpected scores (-want +got):\n%s", diff)
+		}
+	}
+
+	if calls := gitserverClient.ReadDirFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of ReadDir calls. want=%d have=%d", 1, len(calls))
+	}
+}
+
+func TestRecentViewsSignal(t *testing.T) {
+	now := time.Unix(1587396557, 0).UTC()
+	store := &ownSignalStore{viewCounts: map[string]float64{"a.go": 4, "b.go": 1}}
+	signal := NewRecentViewsSignal(store, func() time.Time { return now })
+
+	// Scores of the second call are served from the cache
+	for i := 0; i < 2; i++ {
+		scores, err := signal.PathScores(context.Background(), api.RepoName("foo"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := map[string]float64{"a.go": 4, "b.go": 1}
+		if diff := cmp.Diff(expected, scores); diff != "" {
+			t.Errorf("unexpected scores (-want +got):\n%s", diff)
+		}
+	}
+
+	if store.numCalls != 1 {
+		t.Fatalf("unexpected number of GetRecentViewCounts calls. want=%d have=%d", 1, store.numCalls)
+	}
+
+	// Scores are recomputed once they expire
+	now = now.Add(repoScoreTTL)
+	if _, err := signal.PathScores(context.Background(), api.RepoName("foo")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if store.numCalls != 2 {
+		t.Fatalf("unexpected number of GetRecentViewCounts calls. want=%d have=%d", 2, store.numCalls)
+	}
+}
+
+type ownSignalStore struct {
+	viewCounts        map[string]float64
+	contributorCounts map[string]float64
+	numCalls          int
+}
+
+func (s *ownSignalStore) GetRecentViewCounts(_ context.Context, _ api.RepoName) (map[string]float64, error) {
+	s.numCalls++
+	return s.viewCounts, nil
+}
+
+func (s *ownSignalStore) GetRecentContributorCounts(_ context.Context, _ api.RepoName) (map[string]float64, error) {
+	s.numCalls++
+	return s.contributorCounts, nil
+}
@@ -14,6 +14,8 @@ type operations struct {
 	getReferenceCountStatistics    *observation.Operation
 	coverageCounts                 *observation.Operation
 	lastUpdatedAt                  *observation.Operation
+	getRecentViewCounts            *observation.Operation
+	getRecentContributorCounts     *observation.Operation
 	getUploadsForRanking           *observation.Operation
 	vacuumAbandonedExportedUploads *observation.Operation
 	softDeleteStaleExportedUploads *observation.Operation
@@ -61,6 +63,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getReferenceCountStatistics:    op("GetReferenceCountStatistics"),
 		coverageCounts:                 op("CoverageCounts"),
 		lastUpdatedAt:                  op("LastUpdatedAt"),
+		getRecentViewCounts:            op("GetRecentViewCounts"),
+		getRecentContributorCounts:     op("GetRecentContributorCounts"),
 		getUploadsForRanking:           op("GetUploadsForRanking"),
 		vacuumAbandonedExportedUploads: op("VacuumAbandonedExportedUploads"),
 		softDeleteStaleExportedUploads: op("SoftDeleteStaleExportedUploads"),
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetRecentViewCounts(ctx context.Context, repoName api.RepoName) (_ map[string]float64, err error) {
+	ctx, _, endObservation := s.operations.getRecentViewCounts.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repoName", string(repoName)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanPathCounts(s.db.Query(ctx, sqlf.Sprintf(getRecentViewCountsQuery, repoName)))
+}
+
+// Only files (paths without children) are considered, as the own signal aggregates
+// may also contain entries for ancestor directories.
+const getRecentViewCountsQuery = `
+SELECT
+	p.absolute_path,
+	SUM(v.views_count)
+FROM own_aggregate_recent_view v
+JOIN repo_paths p ON p.id = v.viewed_file_path_id
+JOIN repo r ON r.id = p.repo_id
+WHERE
+	r.name = %s AND
+	r.deleted_at IS NULL AND
+	r.blocked IS NULL AND
+	NOT EXISTS (SELECT 1 FROM repo_paths c WHERE c.parent_id = p.id)
+GROUP BY p.absolute_path
+`
+
+func (s *store) GetRecentContributorCounts(ctx context.Context, repoName api.RepoName) (_ map[string]float64, err error) {
+	ctx, _, endObservation := s.operations.getRecentContributorCounts.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repoName", string(repoName)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanPathCounts(s.db.Query(ctx, sqlf.Sprintf(getRecentContributorCountsQuery, repoName)))
+}
+
+// Only files (paths without children) are considered, as the own signal aggregates
+// also contain entries for ancestor directories.
+const getRecentContributorCountsQuery = `
+SELECT
+	p.absolute_path,
+	COUNT(DISTINCT c.commit_author_id)
+FROM own_aggregate_recent_contribution c
+JOIN repo_paths p ON p.id = c.changed_file_path_id
+JOIN repo r ON r.id = p.repo_id
+WHERE
+	r.name = %s AND
+	r.deleted_at IS NULL AND
+	r.blocked IS NULL AND
+	c.contributions_count > 0 AND
+	NOT EXISTS (SELECT 1 FROM repo_paths ch WHERE ch.parent_id = p.id)
+GROUP BY p.absolute_path
+`
+
+var scanPathCounts = basestore.NewMapScanner(func(s dbutil.Scanner) (path string, count float64, _ error) {
+	err := s.Scan(&path, &count)
+	return path, count, err
+})
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetRecentViewCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	insertSignalPaths(t, db)
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO users (id, username) VALUES (1, 'u1'), (2, 'u2');
+		INSERT INTO own_aggregate_recent_view (viewer_id, viewed_file_path_id, views_count)
+		VALUES
+			(1, 2, 100), -- directory (ignored)
+			(1, 3, 5),
+			(2, 3, 7),
+			(2, 4, 1),
+			(1, 6, 50)   -- other repository
+	`); err != nil {
+		t.Fatalf("failed to insert views: %s", err)
+	}
+
+	counts, err := store.GetRecentViewCounts(ctx, api.RepoName("foo"))
+	if err != nil {
+		t.Fatalf("unexpected error getting recent view counts: %s", err)
+	}
+
+	expected := map[string]float64{
+		"src/a.go": 12,
+		"src/b.go": 1,
+	}
+	if diff := cmp.Diff(expected, counts); diff != "" {
+		t.Errorf("unexpected view counts (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetRecentContributorCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	insertSignalPaths(t, db)
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO commit_authors (id, email, name) VALUES (1, 'a@example.com', 'a'), (2, 'b@example.com', 'b');
+		INSERT INTO own_aggregate_recent_contribution (commit_author_id, changed_file_path_id, contributions_count)
+		VALUES
+			(1, 2, 10), -- directory (ignored)
+			(1, 3, 4),
+			(2, 3, 2),
+			(2, 4, 8),
+			(1, 4, 0),  -- no contributions (ignored)
+			(1, 6, 3)   -- other repository
+	`); err != nil {
+		t.Fatalf("failed to insert contributions: %s", err)
+	}
+
+	counts, err := store.GetRecentContributorCounts(ctx, api.RepoName("foo"))
+	if err != nil {
+		t.Fatalf("unexpected error getting recent contributor counts: %s", err)
+	}
+
+	expected := map[string]float64{
+		"src/a.go": 2,
+		"src/b.go": 1,
+	}
+	if diff := cmp.Diff(expected, counts); diff != "" {
+		t.Errorf("unexpected contributor counts (-want +got):\n%s", diff)
+	}
+}
+
+func insertSignalPaths(t *testing.T, db database.DB) {
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO repo (id, name) VALUES (1, 'foo'), (2, 'bar');
+		INSERT INTO repo_paths (id, repo_id, absolute_path, parent_id)
+		VALUES
+			(1, 1, '', NULL),
+			(2, 1, 'src', 1),
+			(3, 1, 'src/a.go', 2),
+			(4, 1, 'src/b.go', 2),
+			(5, 2, '', NULL),
+			(6, 2, 'src/a.go', 5)
+	`); err != nil {
+		t.Fatalf("failed to insert repository paths: %s", err)
+	}
+}
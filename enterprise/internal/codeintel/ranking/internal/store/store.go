@@ -27,6 +27,10 @@ type Store interface {
 	CoverageCounts(ctx context.Context, graphKey string) (_ shared.CoverageCounts, err error)
 	LastUpdatedAt(ctx context.Context, repoIDs []api.RepoID) (map[api.RepoID]time.Time, error)
 
+	// Ranking signals
+	GetRecentViewCounts(ctx context.Context, repoName api.RepoName) (map[string]float64, error)
+	GetRecentContributorCounts(ctx context.Context, repoName api.RepoName) (map[string]float64, error)
+
 	// Export uploads (metadata tracking) + cleanup
 	GetUploadsForRanking(ctx context.Context, graphKey, objectPrefix string, batchSize int) ([]uploadsshared.ExportedUpload, error)
 	VacuumAbandonedExportedUploads(ctx context.Context, graphKey string, batchSize int) (int, error)
@@ -42,6 +42,13 @@ type MockStore struct {
 	// GetDocumentRanksFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDocumentRanks.
 	GetDocumentRanksFunc *StoreGetDocumentRanksFunc
+	// GetRecentContributorCountsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRecentContributorCounts.
+	GetRecentContributorCountsFunc *StoreGetRecentContributorCountsFunc
+	// GetRecentViewCountsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRecentViewCounts.
+	GetRecentViewCountsFunc *StoreGetRecentViewCountsFunc
 	// GetReferenceCountStatisticsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// GetReferenceCountStatistics.
@@ -143,6 +150,16 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetRecentContributorCountsFunc: &StoreGetRecentContributorCountsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 map[string]float64, r1 error) {
+				return
+			},
+		},
+		GetRecentViewCountsFunc: &StoreGetRecentViewCountsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 map[string]float64, r1 error) {
+				return
+			},
+		},
 		GetReferenceCountStatisticsFunc: &StoreGetReferenceCountStatisticsFunc{
 			defaultHook: func(context.Context) (r0 float64, r1 error) {
 				return
@@ -275,6 +292,16 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetDocumentRanks")
 			},
 		},
+		GetRecentContributorCountsFunc: &StoreGetRecentContributorCountsFunc{
+			defaultHook: func(context.Context, api.RepoName) (map[string]float64, error) {
+				panic("unexpected invocation of MockStore.GetRecentContributorCounts")
+			},
+		},
+		GetRecentViewCountsFunc: &StoreGetRecentViewCountsFunc{
+			defaultHook: func(context.Context, api.RepoName) (map[string]float64, error) {
+				panic("unexpected invocation of MockStore.GetRecentViewCounts")
+			},
+		},
 		GetReferenceCountStatisticsFunc: &StoreGetReferenceCountStatisticsFunc{
 			defaultHook: func(context.Context) (float64, error) {
 				panic("unexpected invocation of MockStore.GetReferenceCountStatistics")
@@ -395,6 +422,12 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetDocumentRanksFunc: &StoreGetDocumentRanksFunc{
 			defaultHook: i.GetDocumentRanks,
 		},
+		GetRecentContributorCountsFunc: &StoreGetRecentContributorCountsFunc{
+			defaultHook: i.GetRecentContributorCounts,
+		},
+		GetRecentViewCountsFunc: &StoreGetRecentViewCountsFunc{
+			defaultHook: i.GetRecentViewCounts,
+		},
 		GetReferenceCountStatisticsFunc: &StoreGetReferenceCountStatisticsFunc{
 			defaultHook: i.GetReferenceCountStatistics,
 		},
@@ -1097,6 +1130,225 @@ func (c StoreGetDocumentRanksFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreGetRecentContributorCountsFunc describes the behavior when the
+// GetRecentContributorCounts method of the parent MockStore instance is
+// invoked.
+type StoreGetRecentContributorCountsFunc struct {
+	defaultHook func(context.Context, api.RepoName) (map[string]float64, error)
+	hooks       []func(context.Context, api.RepoName) (map[string]float64, error)
+	history     []StoreGetRecentContributorCountsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRecentContributorCounts delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRecentContributorCounts(v0 context.Context, v1 api.RepoName) (map[string]float64, error) {
+	r0, r1 := m.GetRecentContributorCountsFunc.nextHook()(v0, v1)
+	m.GetRecentContributorCountsFunc.appendCall(StoreGetRecentContributorCountsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRecentContributorCounts method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRecentContributorCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (map[string]float64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRecentContributorCounts method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRecentContributorCountsFunc) PushHook(hook func(context.Context, api.RepoName) (map[string]float64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRecentContributorCountsFunc) SetDefaultReturn(r0 map[string]float64, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (map[string]float64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRecentContributorCountsFunc) PushReturn(r0 map[string]float64, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (map[string]float64, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRecentContributorCountsFunc) nextHook() func(context.Context, api.RepoName) (map[string]float64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRecentContributorCountsFunc) appendCall(r0 StoreGetRecentContributorCountsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRecentContributorCountsFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRecentContributorCountsFunc) History() []StoreGetRecentContributorCountsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRecentContributorCountsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRecentContributorCountsFuncCall is an object that describes an
+// invocation of method GetRecentContributorCounts on an instance of
+// MockStore.
+type StoreGetRecentContributorCountsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]float64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRecentContributorCountsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRecentContributorCountsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetRecentViewCountsFunc describes the behavior when the
+// GetRecentViewCounts method of the parent MockStore instance is invoked.
+type StoreGetRecentViewCountsFunc struct {
+	defaultHook func(context.Context, api.RepoName) (map[string]float64, error)
+	hooks       []func(context.Context, api.RepoName) (map[string]float64, error)
+	history     []StoreGetRecentViewCountsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRecentViewCounts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetRecentViewCounts(v0 context.Context, v1 api.RepoName) (map[string]float64, error) {
+	r0, r1 := m.GetRecentViewCountsFunc.nextHook()(v0, v1)
+	m.GetRecentViewCountsFunc.appendCall(StoreGetRecentViewCountsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRecentViewCounts
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetRecentViewCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (map[string]float64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRecentViewCounts method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetRecentViewCountsFunc) PushHook(hook func(context.Context, api.RepoName) (map[string]float64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRecentViewCountsFunc) SetDefaultReturn(r0 map[string]float64, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (map[string]float64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRecentViewCountsFunc) PushReturn(r0 map[string]float64, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (map[string]float64, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRecentViewCountsFunc) nextHook() func(context.Context, api.RepoName) (map[string]float64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRecentViewCountsFunc) appendCall(r0 StoreGetRecentViewCountsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRecentViewCountsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetRecentViewCountsFunc) History() []StoreGetRecentViewCountsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRecentViewCountsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRecentViewCountsFuncCall is an object that describes an
+// invocation of method GetRecentViewCounts on an instance of MockStore.
+type StoreGetRecentViewCountsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]float64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRecentViewCountsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRecentViewCountsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetReferenceCountStatisticsFunc describes the behavior when the
 // GetReferenceCountStatistics method of the parent MockStore instance is
 // invoked.
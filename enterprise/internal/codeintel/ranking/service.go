@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/lsifstore"
 	internalshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/shared"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/signals"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/shared"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -23,6 +24,7 @@ type Service struct {
 	store      store.Store
 	lsifstore  lsifstore.Store
 	getConf    conftypes.SiteConfigQuerier
+	signals    []shared.RankingSignal
 	operations *operations
 	logger     log.Logger
 }
@@ -32,11 +34,13 @@ func newService(
 	store store.Store,
 	lsifStore lsifstore.Store,
 	getConf conftypes.SiteConfigQuerier,
+	rankingSignals []shared.RankingSignal,
 ) *Service {
 	return &Service{
 		store:      store,
 		lsifstore:  lsifStore,
 		getConf:    getConf,
+		signals:    rankingSignals,
 		operations: newOperations(observationCtx),
 		logger:     observationCtx.Logger,
 	}
@@ -88,7 +92,9 @@ func squashRange(j float64) float64 {
 	return j / (1 + j)
 }
 
-// GetDocumentRank returns a map from paths within the given repo to their reference count.
+// GetDocumentRanks returns a map from paths within the given repo to their rank. The rank of a
+// path is the (log-scaled) reference count of the path blended with any additional ranking
+// signals that have a non-zero weight in the site configuration.
 func (s *Service) GetDocumentRanks(ctx context.Context, repoName api.RepoName) (_ types.RepoPathRanks, err error) {
 	_, _, endObservation := s.operations.getDocumentRanks.With(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
@@ -106,21 +112,68 @@ func (s *Service) GetDocumentRanks(ctx context.Context, repoName api.RepoName) (
 		return types.RepoPathRanks{}, err
 	}
 
+	weights := s.signalWeights()
+	referenceCountWeight := weights[signals.ReferenceCount]
+
 	paths := map[string]float64{}
 	for path, rank := range documentRanks {
 		if rank == 0 {
 			paths[path] = 0
 		} else {
-			paths[path] = math.Log2(rank)
+			paths[path] = referenceCountWeight * math.Log2(rank)
+		}
+	}
+
+	meanRank := referenceCountWeight * logmean
+
+	for _, signal := range s.signals {
+		weight := weights[signal.Name()]
+		if weight == 0 {
+			continue
+		}
+
+		scores, err := signal.PathScores(ctx, repoName)
+		if err != nil {
+			// A single misbehaving signal should not take down document ranks entirely
+			s.logger.Warn("failed to compute ranking signal",
+				log.String("signal", signal.Name()),
+				log.String("repo", string(repoName)),
+				log.Error(err),
+			)
+			continue
+		}
+
+		for path, score := range scores {
+			if _, ok := paths[path]; !ok {
+				// Paths without reference counts start at the mean so that they
+				// are comparable with the paths that do have reference counts
+				paths[path] = meanRank
+			}
+
+			paths[path] += weight * math.Log2(1+math.Max(score, 0))
 		}
 	}
 
 	return types.RepoPathRanks{
-		MeanRank: logmean,
+		MeanRank: meanRank,
 		Paths:    paths,
 	}, nil
 }
 
+// signalWeights returns the configured weight of each ranking signal, falling back to the
+// default weight of signals that are not configured explicitly.
+func (s *Service) signalWeights() map[string]float64 {
+	weights := make(map[string]float64, len(signals.DefaultWeights))
+	for name, weight := range signals.DefaultWeights {
+		weights[name] = weight
+	}
+	for name, weight := range s.getConf.SiteConfig().CodeIntelRankingSignalWeights {
+		weights[name] = weight
+	}
+
+	return weights
+}
+
 func (s *Service) Summaries(ctx context.Context) ([]shared.Summary, error) {
 	return s.store.Summaries(ctx)
 }
@@ -5,15 +5,19 @@ import (
 	"math"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/internal/signals"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestGetRepoRank(t *testing.T) {
 	ctx := context.Background()
 	mockStore := NewMockStore()
-	svc := newService(&observation.TestContext, mockStore, nil, conf.DefaultClient())
+	svc := newService(&observation.TestContext, mockStore, nil, conf.DefaultClient(), nil)
 
 	mockStore.GetStarRankFunc.SetDefaultReturn(0.6, nil)
 
@@ -34,7 +38,7 @@ func TestGetRepoRankWithUserBoostedScores(t *testing.T) {
 	ctx := context.Background()
 	mockStore := NewMockStore()
 	mockConfigQuerier := NewMockSiteConfigQuerier()
-	svc := newService(&observation.TestContext, mockStore, nil, mockConfigQuerier)
+	svc := newService(&observation.TestContext, mockStore, nil, mockConfigQuerier, nil)
 
 	mockStore.GetStarRankFunc.SetDefaultReturn(0.6, nil)
 	mockConfigQuerier.SiteConfigFunc.SetDefaultReturn(schema.SiteConfiguration{
@@ -62,6 +66,73 @@ func TestGetRepoRankWithUserBoostedScores(t *testing.T) {
 	}
 }
 
+func TestGetDocumentRanksWithSignals(t *testing.T) {
+	ctx := context.Background()
+	mockStore := NewMockStore()
+	mockConfigQuerier := NewMockSiteConfigQuerier()
+
+	viewsSignal := &testSignal{name: signals.RecentViews, scores: map[string]float64{"a.go": 100}}
+	rankingSignals := []shared.RankingSignal{
+		&testSignal{name: signals.RecentActivity, scores: map[string]float64{"a.go": 3, "c.go": 1}},
+		&testSignal{name: signals.FileSize, scores: map[string]float64{"a.go": 1, "b.go": 7}},
+		&testSignal{name: signals.RecentContributors, err: errors.New("oops")},
+		viewsSignal,
+	}
+	svc := newService(&observation.TestContext, mockStore, nil, mockConfigQuerier, rankingSignals)
+
+	mockStore.GetDocumentRanksFunc.SetDefaultReturn(map[string]float64{"a.go": 8, "b.go": 0}, true, nil)
+	mockStore.GetReferenceCountStatisticsFunc.SetDefaultReturn(2, nil)
+	mockConfigQuerier.SiteConfigFunc.SetDefaultReturn(schema.SiteConfiguration{
+		CodeIntelRankingSignalWeights: map[string]float64{
+			signals.ReferenceCount:     2,
+			signals.RecentActivity:     1,
+			signals.RecentContributors: 1,
+			signals.FileSize:           -1,
+		},
+	})
+
+	ranks, err := svc.GetDocumentRanks(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error getting document ranks: %s", err)
+	}
+
+	if expected := 4.0; !cmpFloat(ranks.MeanRank, expected) {
+		t.Errorf("unexpected mean rank. want=%.5f have=%.5f", expected, ranks.MeanRank)
+	}
+
+	expectedPaths := map[string]float64{
+		"a.go": 2*3 + 2 - 1, // 2*log2(8) + log2(1+3) - log2(1+1)
+		"b.go": 0 - 3,       // unreferenced; -log2(1+7)
+		"c.go": 4 + 1,       // mean rank + log2(1+1)
+	}
+	if len(ranks.Paths) != len(expectedPaths) {
+		t.Fatalf("unexpected paths. want=%v have=%v", expectedPaths, ranks.Paths)
+	}
+	for path, expected := range expectedPaths {
+		if !cmpFloat(ranks.Paths[path], expected) {
+			t.Errorf("unexpected rank for %q. want=%.5f have=%.5f", path, expected, ranks.Paths[path])
+		}
+	}
+
+	if viewsSignal.calls != 0 {
+		t.Errorf("expected signal with zero weight to be skipped")
+	}
+}
+
+type testSignal struct {
+	name   string
+	scores map[string]float64
+	err    error
+	calls  int
+}
+
+func (s *testSignal) Name() string { return s.name }
+
+func (s *testSignal) PathScores(_ context.Context, _ api.RepoName) (map[string]float64, error) {
+	s.calls++
+	return s.scores, s.err
+}
+
 const epsilon = 0.00000001
 
 func cmpFloat(x, y float64) bool {
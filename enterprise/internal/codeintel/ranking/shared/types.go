@@ -1,6 +1,11 @@
 package shared
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
 
 type Summary struct {
 	GraphKey                string
@@ -35,3 +40,11 @@ type RankingReferences struct {
 	ExportedUploadID int
 	SymbolChecksums  [][16]byte
 }
+
+// RankingSignal produces a per-path score for a repository that can be blended with the
+// document reference counts. Scores are raw, non-negative magnitudes (e.g., a number of
+// commits or views); normalization and weighting is done by the ranking service.
+type RankingSignal interface {
+	Name() string
+	PathScores(ctx context.Context, repoName api.RepoName) (map[string]float64, error)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/reachability"
 	sentinelstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -13,24 +14,31 @@ import (
 func NewService(
 	observationCtx *observation.Context,
 	db database.DB,
+	uploadSvc UploadService,
+	codenavSvc CodeNavService,
 ) *Service {
 	return newService(
 		scopedContext("service", observationCtx),
 		sentinelstore.New(scopedContext("store", observationCtx), db),
+		uploadSvc,
+		codenavSvc,
 	)
 }
 
 var (
-	DownloaderConfigInst = &downloader.Config{}
-	MatcherConfigInst    = &matcher.Config{}
+	DownloaderConfigInst   = &downloader.Config{}
+	MatcherConfigInst      = &matcher.Config{}
+	ReachabilityConfigInst = &reachability.Config{}
 )
 
 func CVEScannerJob(observationCtx *observation.Context, service *Service) []goroutine.BackgroundRoutine {
 	return background.CVEScannerJob(
 		scopedContext("cvescanner", observationCtx),
 		service.store,
+		service.codenavSvc,
 		DownloaderConfigInst,
 		MatcherConfigInst,
+		ReachabilityConfigInst,
 	)
 }
 
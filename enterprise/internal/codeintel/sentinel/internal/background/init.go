@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/reachability"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -13,8 +14,10 @@ import (
 func CVEScannerJob(
 	observationCtx *observation.Context,
 	store store.Store,
+	codenavSvc reachability.CodeNavService,
 	downloaderConfig *downloader.Config,
 	matcherConfig *matcher.Config,
+	reachabilityConfig *reachability.Config,
 ) []goroutine.BackgroundRoutine {
 	if os.Getenv("RUN_EXPERIMENTAL_SENTINEL_JOBS") != "true" {
 		return nil
@@ -23,5 +26,6 @@ func CVEScannerJob(
 	return []goroutine.BackgroundRoutine{
 		downloader.NewCVEDownloader(store, observationCtx, downloaderConfig),
 		matcher.NewCVEMatcher(store, observationCtx, matcherConfig),
+		reachability.NewReachabilityAnalyzer(store, codenavSvc, observationCtx, reachabilityConfig),
 	}
 }
@@ -0,0 +1,21 @@
+package reachability
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type Config struct {
+	env.BaseConfig
+
+	Interval     time.Duration
+	BatchSize    int
+	MaxCallSites int
+}
+
+func (c *Config) Load() {
+	c.Interval = c.GetInterval("CODEINTEL_SENTINEL_REACHABILITY_INTERVAL", "10s", "How frequently to analyze the reachability of vulnerability matches.")
+	c.BatchSize = c.GetInt("CODEINTEL_SENTINEL_REACHABILITY_BATCH_SIZE", "100", "How many vulnerability matches to analyze at once.")
+	c.MaxCallSites = c.GetInt("CODEINTEL_SENTINEL_REACHABILITY_MAX_CALL_SITES", "10", "The maximum number of example call sites to record for a reachable vulnerability match.")
+}
@@ -0,0 +1,12 @@
+package reachability
+
+import (
+	"context"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+)
+
+type CodeNavService interface {
+	GetSymbolReferences(ctx context.Context, uploadID int, symbolNames []string, limit int) (_ []codenavshared.Location, _ int, err error)
+	HasSymbolData(ctx context.Context, uploadID int) (bool, error)
+}
@@ -0,0 +1,111 @@
+package reachability
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func NewReachabilityAnalyzer(store store.Store, codenavSvc CodeNavService, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+	a := &analyzer{
+		store:      store,
+		codenavSvc: codenavSvc,
+		metrics:    newMetrics(observationCtx),
+		config:     config,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(a.handle),
+		goroutine.WithName("codeintel.sentinel-reachability-analyzer"),
+		goroutine.WithDescription("Determines whether vulnerability matches reference the affected symbols."),
+		goroutine.WithInterval(config.Interval),
+	)
+}
+
+type analyzer struct {
+	store      store.Store
+	codenavSvc CodeNavService
+	metrics    *metrics
+	config     *Config
+}
+
+func (a *analyzer) handle(ctx context.Context) error {
+	candidates, err := a.store.GetUnanalyzedVulnerabilityMatches(ctx, a.config.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		reachability, callSites, err := a.analyze(ctx, candidate)
+		if err != nil {
+			return err
+		}
+
+		if err := a.store.UpdateVulnerabilityMatchReachability(ctx, candidate.MatchID, reachability, callSites); err != nil {
+			return err
+		}
+
+		a.metrics.numMatchesAnalyzed.Inc()
+		switch reachability {
+		case shared.ReachabilityReachable:
+			a.metrics.numReachableMatches.Inc()
+		case shared.ReachabilityUnreachable:
+			a.metrics.numUnreachableMatches.Inc()
+		}
+	}
+
+	return nil
+}
+
+// analyze searches the index that caused the given match for references to the symbols named
+// by the advisory. Matches for which the advisory does not name any symbols, or for which we
+// cannot construct symbol names (e.g., unsupported ecosystems), are marked as unknown.
+//
+// Only direct references from the index are considered: an affected symbol invoked through
+// another dependency is not detected. A match is therefore only marked as unreachable when the
+// index has symbol data and every affected package belongs to a module that the index references
+// directly. Otherwise, the absence of references is inconclusive and the match is marked as unknown.
+func (a *analyzer) analyze(ctx context.Context, candidate shared.ReachabilityCandidate) (shared.Reachability, []shared.CallSite, error) {
+	symbolNames := makeSymbolNames(candidate)
+	if len(symbolNames) == 0 {
+		return shared.ReachabilityUnknown, nil, nil
+	}
+
+	locations, _, err := a.codenavSvc.GetSymbolReferences(ctx, candidate.UploadID, symbolNames, a.config.MaxCallSites)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(locations) == 0 {
+		if !referencesAffectedModules(candidate) {
+			return shared.ReachabilityUnknown, nil, nil
+		}
+
+		hasSymbolData, err := a.codenavSvc.HasSymbolData(ctx, candidate.UploadID)
+		if err != nil {
+			return "", nil, err
+		}
+		if !hasSymbolData {
+			return shared.ReachabilityUnknown, nil, nil
+		}
+
+		return shared.ReachabilityUnreachable, nil, nil
+	}
+
+	callSites := make([]shared.CallSite, 0, len(locations))
+	for _, location := range locations {
+		callSites = append(callSites, shared.CallSite{
+			Path:           location.Path,
+			StartLine:      location.Range.Start.Line,
+			StartCharacter: location.Range.Start.Character,
+			EndLine:        location.Range.End.Line,
+			EndCharacter:   location.Range.End.Character,
+		})
+	}
+
+	return shared.ReachabilityReachable, callSites, nil
+}
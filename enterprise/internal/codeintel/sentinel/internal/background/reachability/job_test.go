@@ -0,0 +1,82 @@
+package reachability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestAnalyzer(t *testing.T) {
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "github.com/go-nacelle/config", Symbols: []string{"Load"}},
+	}
+	references := []shared.PackageReference{
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+	}
+
+	mockStore := NewMockStore()
+	mockStore.GetUnanalyzedVulnerabilityMatchesFunc.SetDefaultReturn([]shared.ReachabilityCandidate{
+		{MatchID: 1, UploadID: 50, Language: "go", PackageReferences: references, AffectedSymbols: affectedSymbols},
+		{MatchID: 2, UploadID: 51, Language: "go", PackageReferences: references, AffectedSymbols: affectedSymbols},
+		{MatchID: 3, UploadID: 52, Language: "go", PackageReferences: references},
+		{MatchID: 4, UploadID: 53, Language: "go", PackageReferences: references, AffectedSymbols: affectedSymbols},
+		{MatchID: 5, UploadID: 51, Language: "go", PackageReferences: references, AffectedSymbols: []shared.AffectedSymbol{
+			{Path: "github.com/go-nacelle/log", Symbols: []string{"Init"}},
+		}},
+	}, nil)
+
+	mockCodeNavService := NewMockCodeNavService()
+	mockCodeNavService.GetSymbolReferencesFunc.SetDefaultHook(func(_ context.Context, uploadID int, _ []string, _ int) ([]codenavshared.Location, int, error) {
+		if uploadID != 50 {
+			return nil, 0, nil
+		}
+
+		return []codenavshared.Location{
+			{DumpID: 50, Path: "main.go", Range: codenavshared.Range{Start: codenavshared.Position{Line: 10, Character: 5}, End: codenavshared.Position{Line: 10, Character: 9}}},
+		}, 1, nil
+	})
+	mockCodeNavService.HasSymbolDataFunc.SetDefaultHook(func(_ context.Context, uploadID int) (bool, error) {
+		// Upload 53 has no symbol data
+		return uploadID != 53, nil
+	})
+
+	a := &analyzer{
+		store:      mockStore,
+		codenavSvc: mockCodeNavService,
+		metrics:    newMetrics(&observation.TestContext),
+		config:     &Config{BatchSize: 10, MaxCallSites: 5},
+	}
+	if err := a.handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type update struct {
+		ID           int
+		Reachability shared.Reachability
+		CallSites    []shared.CallSite
+	}
+	var updates []update
+	for _, call := range mockStore.UpdateVulnerabilityMatchReachabilityFunc.History() {
+		updates = append(updates, update{call.Arg1, call.Arg2, call.Arg3})
+	}
+
+	expectedUpdates := []update{
+		{1, shared.ReachabilityReachable, []shared.CallSite{{Path: "main.go", StartLine: 10, StartCharacter: 5, EndLine: 10, EndCharacter: 9}}},
+		{2, shared.ReachabilityUnreachable, nil},
+		{3, shared.ReachabilityUnknown, nil},
+		{4, shared.ReachabilityUnknown, nil},
+		{5, shared.ReachabilityUnknown, nil},
+	}
+	if diff := cmp.Diff(expectedUpdates, updates); diff != "" {
+		t.Errorf("unexpected updates (-want +got):\n%s", diff)
+	}
+
+	if n := len(mockCodeNavService.GetSymbolReferencesFunc.History()); n != 4 {
+		t.Errorf("unexpected number of reference queries. want=%d have=%d", 4, n)
+	}
+}
@@ -0,0 +1,44 @@
+package reachability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type metrics struct {
+	numMatchesAnalyzed    prometheus.Counter
+	numReachableMatches   prometheus.Counter
+	numUnreachableMatches prometheus.Counter
+}
+
+func newMetrics(observationCtx *observation.Context) *metrics {
+	counter := func(name, help string) prometheus.Counter {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: name,
+			Help: help,
+		})
+
+		observationCtx.Registerer.MustRegister(counter)
+		return counter
+	}
+
+	numMatchesAnalyzed := counter(
+		"src_codeintel_sentinel_num_matches_analyzed_total",
+		"The total number of vulnerability matches analyzed for reachability.",
+	)
+	numReachableMatches := counter(
+		"src_codeintel_sentinel_num_reachable_matches_total",
+		"The total number of vulnerability matches found to reference an affected symbol.",
+	)
+	numUnreachableMatches := counter(
+		"src_codeintel_sentinel_num_unreachable_matches_total",
+		"The total number of vulnerability matches found not to reference any affected symbol.",
+	)
+
+	return &metrics{
+		numMatchesAnalyzed:    numMatchesAnalyzed,
+		numReachableMatches:   numReachableMatches,
+		numUnreachableMatches: numUnreachableMatches,
+	}
+}
@@ -0,0 +1,118 @@
+package reachability
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// makeSymbolNames returns the SCIP symbol names that may refer to the symbols affected by the
+// given match, as they would be emitted by an indexer referencing the matched packages. Only Go
+// packages are currently supported, as the govulndb is the only source that names affected symbols.
+func makeSymbolNames(candidate shared.ReachabilityCandidate) []string {
+	if len(candidate.AffectedSymbols) == 0 {
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	for _, ref := range candidate.PackageReferences {
+		if ref.Scheme != "gomod" && ref.Manager != "gomod" {
+			continue
+		}
+
+		prefix := strings.Join([]string{
+			escapeSymbolSpace(ref.Scheme),
+			escapeSymbolSpace(ref.Manager),
+			escapeSymbolSpace(ref.Name),
+			escapeSymbolSpace(ref.Version),
+		}, " ")
+
+		for _, affectedSymbol := range candidate.AffectedSymbols {
+			namespace := escapeDescriptorName(affectedSymbol.Path) + "/"
+
+			for _, symbol := range affectedSymbol.Symbols {
+				for _, descriptors := range goDescriptors(symbol) {
+					seen[prefix+" "+namespace+descriptors] = struct{}{}
+				}
+			}
+		}
+	}
+
+	symbolNames := make([]string, 0, len(seen))
+	for symbolName := range seen {
+		symbolNames = append(symbolNames, symbolName)
+	}
+	sort.Strings(symbolNames)
+
+	return symbolNames
+}
+
+// referencesAffectedModules returns true if each package named by the advisory belongs to a Go
+// module referenced by the index. The symbol names of a package are prefixed by its module, so
+// the names constructed for packages outside of the referenced modules cannot match.
+func referencesAffectedModules(candidate shared.ReachabilityCandidate) bool {
+	for _, affectedSymbol := range candidate.AffectedSymbols {
+		found := false
+		for _, ref := range candidate.PackageReferences {
+			if ref.Scheme != "gomod" && ref.Manager != "gomod" {
+				continue
+			}
+
+			if affectedSymbol.Path == ref.Name || strings.HasPrefix(affectedSymbol.Path, ref.Name+"/") {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// goDescriptors returns the descriptor suffixes for a symbol named in a Go vulnerability report.
+// Reports name either a package-level identifier (`Func`) or a method (`Type.Method`). As we do
+// not know whether a package-level identifier is a function, a type, or a variable, we return
+// the descriptors for each interpretation.
+func goDescriptors(symbol string) []string {
+	if typeName, methodName, ok := strings.Cut(symbol, "."); ok {
+		return []string{
+			escapeDescriptorName(typeName) + "#" + escapeDescriptorName(methodName) + "().",
+		}
+	}
+
+	name := escapeDescriptorName(symbol)
+	return []string{
+		name + "().",
+		name + ".",
+		name + "#",
+	}
+}
+
+// escapeSymbolSpace encodes a package field of a SCIP symbol.
+func escapeSymbolSpace(s string) string {
+	if s == "" {
+		return "."
+	}
+
+	return strings.ReplaceAll(s, " ", "  ")
+}
+
+// escapeDescriptorName encodes the name of a SCIP descriptor, wrapping names that are not
+// simple identifiers in backticks.
+func escapeDescriptorName(s string) string {
+	for _, r := range s {
+		if !isSimpleIdentifierCharacter(r) {
+			return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+		}
+	}
+
+	return s
+}
+
+func isSimpleIdentifierCharacter(r rune) bool {
+	return r == '_' || r == '+' || r == '-' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
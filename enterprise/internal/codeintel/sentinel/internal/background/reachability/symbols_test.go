@@ -0,0 +1,66 @@
+package reachability
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+func TestMakeSymbolNames(t *testing.T) {
+	candidate := shared.ReachabilityCandidate{
+		Language: "go",
+		PackageReferences: []shared.PackageReference{
+			{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+			{Scheme: "npm", Manager: "", Name: "config", Version: "1.2.3"},
+		},
+		AffectedSymbols: []shared.AffectedSymbol{
+			{Path: "github.com/go-nacelle/config", Symbols: []string{"Load", "Config.Init"}},
+		},
+	}
+
+	expected := []string{
+		"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Config#Init().",
+		"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load#",
+		"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load().",
+		"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load.",
+	}
+	if diff := cmp.Diff(expected, makeSymbolNames(candidate)); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+}
+
+func TestMakeSymbolNamesUnsupported(t *testing.T) {
+	testCases := map[string]shared.ReachabilityCandidate{
+		"no affected symbols": {
+			PackageReferences: []shared.PackageReference{{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}},
+		},
+		"unsupported ecosystem": {
+			PackageReferences: []shared.PackageReference{{Scheme: "npm", Name: "config", Version: "1.2.3"}},
+			AffectedSymbols:   []shared.AffectedSymbol{{Path: "config", Symbols: []string{"load"}}},
+		},
+	}
+
+	for name, candidate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if symbolNames := makeSymbolNames(candidate); len(symbolNames) != 0 {
+				t.Errorf("unexpected symbol names: %v", symbolNames)
+			}
+		})
+	}
+}
+
+func TestEscapeDescriptorName(t *testing.T) {
+	testCases := map[string]string{
+		"Load":              "Load",
+		"golang.org/x/text": "`golang.org/x/text`",
+		"a`b":               "`a``b`",
+	}
+
+	for input, expected := range testCases {
+		if actual := escapeDescriptorName(input); actual != expected {
+			t.Errorf("unexpected name for %q. want=%q have=%q", input, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package sbom
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// The following types are a subset of the CycloneDX 1.4 JSON schema.
+// See https://cyclonedx.org/docs/1.4/json/.
+
+type cycloneDXDocument struct {
+	BOMFormat       string                   `json:"bomFormat"`
+	SpecVersion     string                   `json:"specVersion"`
+	SerialNumber    string                   `json:"serialNumber"`
+	Version         int                      `json:"version"`
+	Metadata        cycloneDXMetadata        `json:"metadata"`
+	Components      []cycloneDXComponent     `json:"components"`
+	Dependencies    []cycloneDXDependency    `json:"dependencies"`
+	Vulnerabilities []cycloneDXVulnerability `json:"vulnerabilities"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	BOMRef  string `json:"bom-ref"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+type cycloneDXVulnerability struct {
+	BOMRef      string                   `json:"bom-ref"`
+	ID          string                   `json:"id"`
+	Source      *cycloneDXSource         `json:"source,omitempty"`
+	References  []cycloneDXReference     `json:"references,omitempty"`
+	Ratings     []cycloneDXRating        `json:"ratings,omitempty"`
+	CWEs        []int                    `json:"cwes,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Detail      string                   `json:"detail,omitempty"`
+	Advisories  []cycloneDXAdvisory      `json:"advisories,omitempty"`
+	Published   string                   `json:"published,omitempty"`
+	Updated     string                   `json:"updated,omitempty"`
+	Analysis    cycloneDXAnalysis        `json:"analysis"`
+	Affects     []cycloneDXAffectedEntry `json:"affects"`
+}
+
+type cycloneDXSource struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXReference struct {
+	ID string `json:"id"`
+}
+
+type cycloneDXRating struct {
+	Score    *float64 `json:"score,omitempty"`
+	Severity string   `json:"severity"`
+	Method   string   `json:"method,omitempty"`
+	Vector   string   `json:"vector,omitempty"`
+}
+
+type cycloneDXAdvisory struct {
+	URL string `json:"url"`
+}
+
+type cycloneDXAnalysis struct {
+	State         string `json:"state"`
+	Justification string `json:"justification,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+}
+
+type cycloneDXAffectedEntry struct {
+	Ref string `json:"ref"`
+}
+
+func encodeCycloneDX(sbom shared.SBOM, metadata Metadata) ([]byte, error) {
+	root := cycloneDXComponent{
+		Type:    "application",
+		BOMRef:  "repository",
+		Name:    sbom.RepositoryName,
+		Version: sbom.Commit,
+	}
+
+	components := makeComponents(sbom)
+	cycloneDXComponents := make([]cycloneDXComponent, 0, len(components))
+	dependsOn := make([]string, 0, len(components))
+	for _, c := range components {
+		cycloneDXComponents = append(cycloneDXComponents, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  c.purl,
+			Name:    c.name,
+			Version: c.version,
+			PURL:    c.purl,
+		})
+		dependsOn = append(dependsOn, c.purl)
+	}
+
+	vulnerabilities := make([]cycloneDXVulnerability, 0, len(sbom.Vulnerabilities))
+	for _, v := range sbom.Vulnerabilities {
+		vulnerabilities = append(vulnerabilities, makeCycloneDXVulnerability(v))
+	}
+
+	return json.MarshalIndent(cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + metadata.ID,
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: metadata.Timestamp.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Sourcegraph", Name: "sourcegraph"}},
+			Component: root,
+		},
+		Components:      cycloneDXComponents,
+		Dependencies:    []cycloneDXDependency{{Ref: root.BOMRef, DependsOn: dependsOn}},
+		Vulnerabilities: vulnerabilities,
+	}, "", "  ")
+}
+
+func makeCycloneDXVulnerability(v shared.SBOMVulnerability) cycloneDXVulnerability {
+	vulnerability := cycloneDXVulnerability{
+		BOMRef:      "vulnerability-" + strconv.Itoa(v.Vulnerability.ID),
+		ID:          v.Vulnerability.SourceID,
+		Description: v.Vulnerability.Summary,
+		Detail:      v.Vulnerability.Details,
+		Analysis:    makeCycloneDXAnalysis(v.Reachability),
+	}
+
+	if v.Vulnerability.DataSource != "" {
+		vulnerability.Source = &cycloneDXSource{Name: v.Vulnerability.DataSource}
+	}
+	for _, alias := range v.Vulnerability.Aliases {
+		vulnerability.References = append(vulnerability.References, cycloneDXReference{ID: alias})
+	}
+	if v.Vulnerability.Severity != "" || v.Vulnerability.CVSSVector != "" {
+		vulnerability.Ratings = []cycloneDXRating{makeCycloneDXRating(v.Vulnerability)}
+	}
+	for _, cwe := range v.Vulnerability.CWEs {
+		if id, err := strconv.Atoi(strings.TrimPrefix(cwe, "CWE-")); err == nil {
+			vulnerability.CWEs = append(vulnerability.CWEs, id)
+		}
+	}
+	for _, url := range v.Vulnerability.URLs {
+		vulnerability.Advisories = append(vulnerability.Advisories, cycloneDXAdvisory{URL: url})
+	}
+	if !v.Vulnerability.PublishedAt.IsZero() {
+		vulnerability.Published = v.Vulnerability.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if v.Vulnerability.ModifiedAt != nil {
+		vulnerability.Updated = v.Vulnerability.ModifiedAt.UTC().Format(time.RFC3339)
+	}
+
+	purls := affectedPackageURLs(v)
+	vulnerability.Affects = make([]cycloneDXAffectedEntry, 0, len(purls))
+	for _, purl := range purls {
+		vulnerability.Affects = append(vulnerability.Affects, cycloneDXAffectedEntry{Ref: purl})
+	}
+
+	return vulnerability
+}
+
+func makeCycloneDXRating(v shared.Vulnerability) cycloneDXRating {
+	rating := cycloneDXRating{
+		Severity: strings.ToLower(v.Severity),
+		Vector:   v.CVSSVector,
+	}
+	if rating.Severity == "" {
+		rating.Severity = "unknown"
+	}
+	if score, err := strconv.ParseFloat(v.CVSSScore, 64); err == nil {
+		rating.Score = &score
+	}
+
+	switch {
+	case strings.HasPrefix(v.CVSSVector, "CVSS:3.1/"):
+		rating.Method = "CVSSv31"
+	case strings.HasPrefix(v.CVSSVector, "CVSS:3.0/"):
+		rating.Method = "CVSSv3"
+	case v.CVSSVector != "":
+		rating.Method = "other"
+	}
+
+	return rating
+}
+
+// makeCycloneDXAnalysis converts the reachability of a vulnerability into a VEX statement. As only
+// direct references are searched, unreachable vulnerabilities remain in triage rather than being
+// stated as not affected, which consumers of the document would use to suppress alerts.
+func makeCycloneDXAnalysis(reachability shared.Reachability) cycloneDXAnalysis {
+	switch reachability {
+	case shared.ReachabilityReachable:
+		return cycloneDXAnalysis{
+			State:  "exploitable",
+			Detail: "Precise code intelligence found references to symbols affected by this vulnerability.",
+		}
+
+	case shared.ReachabilityUnreachable:
+		return cycloneDXAnalysis{
+			State:  "in_triage",
+			Detail: "Precise code intelligence found no direct references to symbols affected by this vulnerability.",
+		}
+	}
+
+	return cycloneDXAnalysis{State: "in_triage"}
+}
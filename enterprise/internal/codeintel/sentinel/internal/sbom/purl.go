@@ -0,0 +1,94 @@
+package sbom
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// packageURLTypes maps the package manager (or scheme) of a package reference to the
+// type of the equivalent package URL.
+var packageURLTypes = map[string]string{
+	"cargo":    "cargo",
+	"composer": "composer",
+	"gem":      "gem",
+	"gomod":    "golang",
+	"maven":    "maven",
+	"npm":      "npm",
+	"nuget":    "nuget",
+	"pip":      "pypi",
+	"pub":      "pub",
+	"python":   "pypi",
+}
+
+// packageURL returns the package URL (https://github.com/package-url/purl-spec) of the
+// given package reference. References from unknown package managers are described as
+// generic packages.
+func packageURL(ref shared.PackageReference) string {
+	purlType, ok := packageURLTypes[ref.Manager]
+	if !ok {
+		if purlType, ok = packageURLTypes[ref.Scheme]; !ok {
+			purlType = "generic"
+		}
+	}
+
+	var namespace []string
+	name := ref.Name
+
+	switch purlType {
+	case "golang", "npm":
+		// Go modules and scoped npm packages are namespaced by their path prefix
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			namespace = strings.Split(name[:i], "/")
+			name = name[i+1:]
+		}
+
+	case "maven":
+		// Maven packages are named by their group and artifact identifiers
+		if groupID, artifactID, ok := strings.Cut(name, ":"); ok {
+			namespace = []string{groupID}
+			name = artifactID
+		}
+
+	case "pypi":
+		// Python package names are case insensitive and normalize underscores
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(purlType)
+	sb.WriteString("/")
+	for _, segment := range namespace {
+		sb.WriteString(escapePackageURLComponent(segment))
+		sb.WriteString("/")
+	}
+	sb.WriteString(escapePackageURLComponent(name))
+	if ref.Version != "" {
+		sb.WriteString("@")
+		sb.WriteString(escapePackageURLComponent(ref.Version))
+	}
+
+	return sb.String()
+}
+
+// escapePackageURLComponent percent-encodes all characters of the given string except for
+// unreserved characters.
+func escapePackageURLComponent(s string) string {
+	const hex = "0123456789ABCDEF"
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			sb.WriteByte(c)
+		} else {
+			sb.WriteByte('%')
+			sb.WriteByte(hex[c>>4])
+			sb.WriteByte(hex[c&15])
+		}
+	}
+
+	return sb.String()
+}
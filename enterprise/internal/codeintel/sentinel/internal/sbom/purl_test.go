@@ -0,0 +1,29 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+func TestPackageURL(t *testing.T) {
+	testCases := []struct {
+		ref      shared.PackageReference
+		expected string
+	}{
+		{shared.PackageReference{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}, "pkg:golang/github.com/go-nacelle/config@v1.2.3"},
+		{shared.PackageReference{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/text", Version: "v0.3.0+incompatible"}, "pkg:golang/golang.org/x/text@v0.3.0%2Bincompatible"},
+		{shared.PackageReference{Scheme: "scip-typescript", Manager: "npm", Name: "@types/node", Version: "18.0.0"}, "pkg:npm/%40types/node@18.0.0"},
+		{shared.PackageReference{Scheme: "npm", Name: "lodash", Version: "4.17.21"}, "pkg:npm/lodash@4.17.21"},
+		{shared.PackageReference{Scheme: "semanticdb", Manager: "maven", Name: "com.google.guava:guava", Version: "31.1-jre"}, "pkg:maven/com.google.guava/guava@31.1-jre"},
+		{shared.PackageReference{Scheme: "scip-python", Manager: "python", Name: "Typing_Extensions", Version: "4.5.0"}, "pkg:pypi/typing-extensions@4.5.0"},
+		{shared.PackageReference{Scheme: "rust-analyzer", Manager: "cargo", Name: "serde", Version: "1.0.0"}, "pkg:cargo/serde@1.0.0"},
+		{shared.PackageReference{Scheme: "scip-ruby", Manager: "unknown", Name: "my lib"}, "pkg:generic/my%20lib"},
+	}
+
+	for _, testCase := range testCases {
+		if purl := packageURL(testCase.ref); purl != testCase.expected {
+			t.Errorf("unexpected package URL for %v. want=%q have=%q", testCase.ref, testCase.expected, purl)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package sbom
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Format string
+
+const (
+	FormatCycloneDX Format = "cyclonedx"
+	FormatSPDX      Format = "spdx"
+)
+
+// ParseFormat returns the format with the given (case insensitive) name. The empty string
+// denotes the default CycloneDX format.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case "", FormatCycloneDX:
+		return FormatCycloneDX, nil
+	case FormatSPDX:
+		return FormatSPDX, nil
+	}
+
+	return "", errors.Newf("unsupported SBOM format %q", name)
+}
+
+// ContentType returns the media type of documents encoded in this format.
+func (f Format) ContentType() string {
+	if f == FormatSPDX {
+		return "application/spdx+json"
+	}
+
+	return "application/vnd.cyclonedx+json"
+}
+
+// Metadata describes a generated SBOM document.
+type Metadata struct {
+	// ID uniquely identifies the document. This should be a UUID.
+	ID string
+
+	// NamespaceURL is the base URL under which SPDX document namespaces are created.
+	NamespaceURL string
+
+	// Timestamp is the time at which the document was generated.
+	Timestamp time.Time
+}
+
+// Encode serializes the given SBOM as a JSON document in the given format.
+func Encode(format Format, sbom shared.SBOM, metadata Metadata) ([]byte, error) {
+	switch format {
+	case FormatCycloneDX:
+		return encodeCycloneDX(sbom, metadata)
+	case FormatSPDX:
+		return encodeSPDX(sbom, metadata)
+	}
+
+	return nil, errors.Newf("unsupported SBOM format %q", format)
+}
+
+type component struct {
+	purl    string
+	name    string
+	version string
+}
+
+// makeComponents returns the distinct packages of the given SBOM, identified by their package
+// URL. The same package may be referenced by indexers using different schemes.
+func makeComponents(sbom shared.SBOM) []component {
+	seen := map[string]struct{}{}
+	components := make([]component, 0, len(sbom.Packages))
+	for _, ref := range sbom.Packages {
+		purl := packageURL(ref)
+		if _, ok := seen[purl]; ok {
+			continue
+		}
+		seen[purl] = struct{}{}
+
+		components = append(components, component{purl: purl, name: ref.Name, version: ref.Version})
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i].purl < components[j].purl })
+
+	return components
+}
+
+// affectedPackageURLs returns the distinct package URLs of the packages affected by the
+// given vulnerability.
+func affectedPackageURLs(vulnerability shared.SBOMVulnerability) []string {
+	seen := map[string]struct{}{}
+	purls := make([]string, 0, len(vulnerability.AffectedPackages))
+	for _, ref := range vulnerability.AffectedPackages {
+		purl := packageURL(ref)
+		if _, ok := seen[purl]; ok {
+			continue
+		}
+		seen[purl] = struct{}{}
+
+		purls = append(purls, purl)
+	}
+	sort.Strings(purls)
+
+	return purls
+}
+
+func documentName(sbom shared.SBOM) string {
+	return sbom.RepositoryName + "@" + sbom.Commit
+}
@@ -0,0 +1,190 @@
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+var testSBOM = shared.SBOM{
+	RepositoryName: "github.com/sourcegraph/sourcegraph",
+	Commit:         "deadbeef",
+	Packages: []shared.PackageReference{
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+		{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/log", Version: "v1.0.0"},
+	},
+	Vulnerabilities: []shared.SBOMVulnerability{
+		{
+			Vulnerability: shared.Vulnerability{
+				ID:         1,
+				SourceID:   "GHSA-abcd",
+				Summary:    "Bad config",
+				Aliases:    []string{"CVE-2023-0001"},
+				CWEs:       []string{"CWE-79"},
+				DataSource: "GitHub",
+				URLs:       []string{"https://github.com/advisories/GHSA-abcd"},
+				Severity:   "HIGH",
+				CVSSVector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+				CVSSScore:  "9.8",
+			},
+			Reachability: shared.ReachabilityReachable,
+			AffectedPackages: []shared.PackageReference{
+				{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+				{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+			},
+		},
+		{
+			Vulnerability: shared.Vulnerability{ID: 2, SourceID: "GHSA-efgh"},
+			Reachability:  shared.ReachabilityUnreachable,
+			AffectedPackages: []shared.PackageReference{
+				{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+			},
+		},
+		{
+			Vulnerability: shared.Vulnerability{ID: 3, SourceID: "GHSA-ijkl", URLs: []string{"https://github.com/advisories/GHSA-ijkl"}},
+			AffectedPackages: []shared.PackageReference{
+				{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+			},
+		},
+	},
+}
+
+var testMetadata = Metadata{
+	ID:           "7b3f1e2a-0000-4000-8000-000000000000",
+	NamespaceURL: "https://sourcegraph.test/.api/codeintel/sbom/",
+	Timestamp:    time.Date(2023, time.June, 30, 12, 0, 0, 0, time.UTC),
+}
+
+func TestEncodeCycloneDX(t *testing.T) {
+	content, err := Encode(FormatCycloneDX, testSBOM, testMetadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var document cycloneDXDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		t.Fatalf("unexpected error decoding document: %s", err)
+	}
+
+	if document.SerialNumber != "urn:uuid:"+testMetadata.ID {
+		t.Errorf("unexpected serial number %q", document.SerialNumber)
+	}
+	if document.Metadata.Component.Name != testSBOM.RepositoryName || document.Metadata.Component.Version != testSBOM.Commit {
+		t.Errorf("unexpected root component %v", document.Metadata.Component)
+	}
+
+	expectedComponents := []cycloneDXComponent{
+		{Type: "library", BOMRef: "pkg:golang/github.com/go-mockgen/xtools@v1.3.2", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2", PURL: "pkg:golang/github.com/go-mockgen/xtools@v1.3.2"},
+		{Type: "library", BOMRef: "pkg:golang/github.com/go-nacelle/config@v1.2.3", Name: "github.com/go-nacelle/config", Version: "v1.2.3", PURL: "pkg:golang/github.com/go-nacelle/config@v1.2.3"},
+		{Type: "library", BOMRef: "pkg:golang/github.com/go-nacelle/log@v1.0.0", Name: "github.com/go-nacelle/log", Version: "v1.0.0", PURL: "pkg:golang/github.com/go-nacelle/log@v1.0.0"},
+	}
+	if diff := cmp.Diff(expectedComponents, document.Components); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+
+	score := 9.8
+	expectedVulnerabilities := []cycloneDXVulnerability{
+		{
+			BOMRef:      "vulnerability-1",
+			ID:          "GHSA-abcd",
+			Source:      &cycloneDXSource{Name: "GitHub"},
+			References:  []cycloneDXReference{{ID: "CVE-2023-0001"}},
+			Ratings:     []cycloneDXRating{{Score: &score, Severity: "high", Method: "CVSSv31", Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}},
+			CWEs:        []int{79},
+			Description: "Bad config",
+			Advisories:  []cycloneDXAdvisory{{URL: "https://github.com/advisories/GHSA-abcd"}},
+			Analysis:    cycloneDXAnalysis{State: "exploitable", Detail: "Precise code intelligence found references to symbols affected by this vulnerability."},
+			Affects:     []cycloneDXAffectedEntry{{Ref: "pkg:golang/github.com/go-nacelle/config@v1.2.3"}},
+		},
+		{
+			BOMRef:   "vulnerability-2",
+			ID:       "GHSA-efgh",
+			Analysis: cycloneDXAnalysis{State: "in_triage", Detail: "Precise code intelligence found no direct references to symbols affected by this vulnerability."},
+			Affects:  []cycloneDXAffectedEntry{{Ref: "pkg:golang/github.com/go-mockgen/xtools@v1.3.2"}},
+		},
+		{
+			BOMRef:     "vulnerability-3",
+			ID:         "GHSA-ijkl",
+			Advisories: []cycloneDXAdvisory{{URL: "https://github.com/advisories/GHSA-ijkl"}},
+			Analysis:   cycloneDXAnalysis{State: "in_triage"},
+			Affects:    []cycloneDXAffectedEntry{{Ref: "pkg:golang/github.com/go-mockgen/xtools@v1.3.2"}},
+		},
+	}
+	if diff := cmp.Diff(expectedVulnerabilities, document.Vulnerabilities); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
+
+func TestEncodeSPDX(t *testing.T) {
+	content, err := Encode(FormatSPDX, testSBOM, testMetadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var document spdxDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		t.Fatalf("unexpected error decoding document: %s", err)
+	}
+
+	if expected := "https://sourcegraph.test/.api/codeintel/sbom/github.com/sourcegraph/sourcegraph@deadbeef-" + testMetadata.ID; document.DocumentNamespace != expected {
+		t.Errorf("unexpected document namespace. want=%q have=%q", expected, document.DocumentNamespace)
+	}
+
+	expectedPackages := []spdxPackage{
+		{SPDXID: "SPDXRef-Repository", Name: "github.com/sourcegraph/sourcegraph", VersionInfo: "deadbeef", DownloadLocation: "NOASSERTION"},
+		{
+			SPDXID:           "SPDXRef-Package-1",
+			Name:             "github.com/go-mockgen/xtools",
+			VersionInfo:      "v1.3.2",
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:golang/github.com/go-mockgen/xtools@v1.3.2"},
+				{ReferenceCategory: "SECURITY", ReferenceType: "advisory", ReferenceLocator: "https://github.com/advisories/GHSA-ijkl", Comment: "GHSA-ijkl (unknown)"},
+			},
+		},
+		{
+			SPDXID:           "SPDXRef-Package-2",
+			Name:             "github.com/go-nacelle/config",
+			VersionInfo:      "v1.2.3",
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:golang/github.com/go-nacelle/config@v1.2.3"},
+				{ReferenceCategory: "SECURITY", ReferenceType: "advisory", ReferenceLocator: "https://github.com/advisories/GHSA-abcd", Comment: "GHSA-abcd (reachable)"},
+			},
+		},
+		{
+			SPDXID:           "SPDXRef-Package-3",
+			Name:             "github.com/go-nacelle/log",
+			VersionInfo:      "v1.0.0",
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:golang/github.com/go-nacelle/log@v1.0.0"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedPackages, document.Packages); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+
+	if n := len(document.Relationships); n != 4 {
+		t.Errorf("unexpected number of relationships. want=%d have=%d", 4, n)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, expected := range map[string]Format{"": FormatCycloneDX, "CycloneDX": FormatCycloneDX, "spdx": FormatSPDX} {
+		if format, err := ParseFormat(name); err != nil || format != expected {
+			t.Errorf("unexpected format for %q. want=%q have=%q (err=%v)", name, expected, format, err)
+		}
+	}
+
+	if _, err := ParseFormat("swid"); err == nil {
+		t.Errorf("expected error for unsupported format")
+	}
+}
@@ -0,0 +1,146 @@
+package sbom
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// The following types are a subset of the SPDX 2.3 JSON schema.
+// See https://spdx.github.io/spdx-spec/v2.3/.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+	Comment           string `json:"comment,omitempty"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const spdxRepositoryID = "SPDXRef-Repository"
+
+// encodeSPDX serializes the given SBOM as an SPDX document. SPDX has no equivalent of VEX
+// statements, so vulnerabilities are attached to the affected packages as security references.
+func encodeSPDX(sbom shared.SBOM, metadata Metadata) ([]byte, error) {
+	name := documentName(sbom)
+
+	advisoriesByPURL := map[string][]spdxExternalRef{}
+	for _, v := range sbom.Vulnerabilities {
+		ref, ok := makeSPDXAdvisoryRef(v)
+		if !ok {
+			continue
+		}
+
+		for _, purl := range affectedPackageURLs(v) {
+			advisoriesByPURL[purl] = append(advisoriesByPURL[purl], ref)
+		}
+	}
+
+	components := makeComponents(sbom)
+	packages := make([]spdxPackage, 0, len(components)+1)
+	relationships := make([]spdxRelationship, 0, len(components)+1)
+
+	packages = append(packages, spdxPackage{
+		SPDXID:           spdxRepositoryID,
+		Name:             sbom.RepositoryName,
+		VersionInfo:      sbom.Commit,
+		DownloadLocation: "NOASSERTION",
+	})
+	relationships = append(relationships, spdxRelationship{
+		SPDXElementID:      "SPDXRef-DOCUMENT",
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: spdxRepositoryID,
+	})
+
+	for i, c := range components {
+		id := "SPDXRef-Package-" + strconv.Itoa(i+1)
+
+		externalRefs := []spdxExternalRef{{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  c.purl,
+		}}
+		externalRefs = append(externalRefs, advisoriesByPURL[c.purl]...)
+
+		packages = append(packages, spdxPackage{
+			SPDXID:           id,
+			Name:             c.name,
+			VersionInfo:      c.version,
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs:     externalRefs,
+		})
+		relationships = append(relationships, spdxRelationship{
+			SPDXElementID:      spdxRepositoryID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	return json.MarshalIndent(spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: strings.TrimSuffix(metadata.NamespaceURL, "/") + "/" + name + "-" + metadata.ID,
+		CreationInfo: spdxCreationInfo{
+			Created:  metadata.Timestamp.UTC().Format(time.RFC3339),
+			Creators: []string{"Organization: Sourcegraph", "Tool: sourcegraph"},
+		},
+		Packages:      packages,
+		Relationships: relationships,
+	}, "", "  ")
+}
+
+// makeSPDXAdvisoryRef returns a security reference to the advisory of the given vulnerability.
+// The reachability of the vulnerability is described by the reference's comment. Vulnerabilities
+// without an advisory URL cannot be referenced.
+func makeSPDXAdvisoryRef(v shared.SBOMVulnerability) (spdxExternalRef, bool) {
+	if len(v.Vulnerability.URLs) == 0 {
+		return spdxExternalRef{}, false
+	}
+
+	reachability := v.Reachability
+	if reachability == "" {
+		reachability = shared.ReachabilityUnknown
+	}
+
+	return spdxExternalRef{
+		ReferenceCategory: "SECURITY",
+		ReferenceType:     "advisory",
+		ReferenceLocator:  v.Vulnerability.URLs[0],
+		Comment:           v.Vulnerability.SourceID + " (" + string(reachability) + ")",
+	}, true
+}
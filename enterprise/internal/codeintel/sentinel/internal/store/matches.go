@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"sort"
 	"strings"
 
@@ -35,6 +36,8 @@ const vulnerabilityMatchByIDQuery = `
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachability,
+	m.reachability_call_sites,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -60,6 +63,7 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 		attribute.String("severity", args.Severity),
 		attribute.String("language", args.Language),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.String("reachability", string(args.Reachability)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -73,6 +77,9 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name = %s", args.RepositoryName))
 	}
+	if args.Reachability != "" {
+		conds = append(conds, sqlf.Sprintf("m.reachability = %s", string(args.Reachability)))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -85,13 +92,17 @@ WITH limited_matches AS (
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachability,
+		m.reachability_call_sites
 	FROM vulnerability_matches m
 	ORDER BY id
 )
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachability,
+	m.reachability_call_sites,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -355,18 +366,151 @@ SELECT COUNT(*) FROM ins
 //
 //
 
+func (s *store) GetUnanalyzedVulnerabilityMatches(ctx context.Context, limit int) (_ []shared.ReachabilityCandidate, err error) {
+	ctx, _, endObservation := s.operations.getUnanalyzedVulnerabilityMatches.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(
+		getUnanalyzedVulnerabilityMatchesQuery,
+		limit,
+		sqlf.Join(makeSchemeTtoVulnerabilityLanguageMappingConditions(), " OR "),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var candidates []shared.ReachabilityCandidate
+	seenReferences := map[int]map[shared.PackageReference]struct{}{}
+	seenSymbols := map[int]map[string]struct{}{}
+
+	for rows.Next() {
+		var (
+			matchID  int
+			uploadID int
+			language string
+			ref      shared.PackageReference
+			path     string
+			symbols  []string
+		)
+		if err := rows.Scan(
+			&matchID,
+			&uploadID,
+			&language,
+			&dbutil.NullString{S: &ref.Scheme},
+			&dbutil.NullString{S: &ref.Manager},
+			&dbutil.NullString{S: &ref.Name},
+			&dbutil.NullString{S: &ref.Version},
+			&dbutil.NullString{S: &path},
+			pq.Array(&symbols),
+		); err != nil {
+			return nil, err
+		}
+
+		if len(candidates) == 0 || candidates[len(candidates)-1].MatchID != matchID {
+			candidates = append(candidates, shared.ReachabilityCandidate{
+				MatchID:  matchID,
+				UploadID: uploadID,
+				Language: language,
+			})
+			seenReferences[matchID] = map[shared.PackageReference]struct{}{}
+			seenSymbols[matchID] = map[string]struct{}{}
+		}
+		candidate := &candidates[len(candidates)-1]
+
+		if ref.Name != "" {
+			if _, ok := seenReferences[matchID][ref]; !ok {
+				seenReferences[matchID][ref] = struct{}{}
+				candidate.PackageReferences = append(candidate.PackageReferences, ref)
+			}
+		}
+		if path != "" {
+			if _, ok := seenSymbols[matchID][path]; !ok {
+				seenSymbols[matchID][path] = struct{}{}
+				candidate.AffectedSymbols = append(candidate.AffectedSymbols, shared.AffectedSymbol{Path: path, Symbols: symbols})
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+const getUnanalyzedVulnerabilityMatchesQuery = `
+WITH candidates AS (
+	SELECT m.id, m.upload_id, m.vulnerability_affected_package_id
+	FROM vulnerability_matches m
+	WHERE m.reachability IS NULL
+	ORDER BY m.id
+	LIMIT %s
+)
+SELECT
+	m.id,
+	m.upload_id,
+	vap.language,
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version,
+	vas.path,
+	vas.symbols
+FROM candidates m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+LEFT JOIN lsif_references r ON
+	r.dump_id = m.upload_id AND
+	-- NOTE: This mirrors the condition used by ScanMatches to match
+	-- package references to affected packages.
+	r.name LIKE '%%' || vap.package_name || '%%' AND
+	(%s)
+LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
+ORDER BY m.id, r.name, r.version, vas.id
+`
+
+func (s *store) UpdateVulnerabilityMatchReachability(ctx context.Context, id int, reachability shared.Reachability, callSites []shared.CallSite) (err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityMatchReachability.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("id", id),
+		attribute.String("reachability", string(reachability)),
+		attribute.Int("numCallSites", len(callSites)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if callSites == nil {
+		callSites = []shared.CallSite{}
+	}
+	serializedCallSites, err := json.Marshal(callSites)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Exec(ctx, sqlf.Sprintf(updateVulnerabilityMatchReachabilityQuery, string(reachability), serializedCallSites, id))
+}
+
+const updateVulnerabilityMatchReachabilityQuery = `
+UPDATE vulnerability_matches
+SET reachability = %s, reachability_call_sites = %s
+WHERE id = %s
+`
+
+//
+//
+
 var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error) ([]shared.VulnerabilityMatch, int, error) {
 	matches, totalCount, err := basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (match shared.VulnerabilityMatch, count int, _ error) {
 		var (
-			vap     shared.AffectedPackage
-			vas     shared.AffectedSymbol
-			vul     shared.Vulnerability
-			fixedIn string
+			vap          shared.AffectedPackage
+			vas          shared.AffectedSymbol
+			vul          shared.Vulnerability
+			fixedIn      string
+			reachability string
+			callSites    []byte
 		)
 
 		if err := s.Scan(
 			&match.ID,
 			&match.UploadID,
+			&dbutil.NullString{S: &reachability},
+			&callSites,
 			&match.VulnerabilityID,
 			// RHS(s) of left join (may be null)
 			&dbutil.NullString{S: &vap.PackageName},
@@ -383,6 +527,14 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 			return shared.VulnerabilityMatch{}, 0, err
 		}
 
+		match.Reachability = shared.Reachability(reachability)
+		if err := json.Unmarshal(callSites, &match.CallSites); err != nil {
+			return shared.VulnerabilityMatch{}, 0, err
+		}
+		if len(match.CallSites) == 0 {
+			match.CallSites = nil
+		}
+
 		if fixedIn != "" {
 			vap.FixedIn = &fixedIn
 		}
@@ -389,6 +389,92 @@ func TestGetVulnerabilityMatchesSummaryCount(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityMatchReachability(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	affectedPackage := shared.AffectedPackage{
+		Language:          "go",
+		PackageName:       "go-nacelle/config",
+		VersionConstraint: []string{"<= v1.2.5"},
+		AffectedSymbols: []shared.AffectedSymbol{
+			{Path: "github.com/go-nacelle/config", Symbols: []string{"Load", "Config.Init"}},
+		},
+	}
+	vulnerabilities := []shared.Vulnerability{
+		{ID: 1, SourceID: "CVE-ABC", Severity: "HIGH", AffectedPackages: []shared.AffectedPackage{affectedPackage}},
+	}
+
+	if _, err := store.InsertVulnerabilities(ctx, vulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	candidates, err := store.GetUnanalyzedVulnerabilityMatches(ctx, 10)
+	if err != nil {
+		t.Fatalf("unexpected error getting unanalyzed matches: %s", err)
+	}
+
+	var expectedCandidates []shared.ReachabilityCandidate
+	for i, version := range []string{"v1.2.3", "v1.2.4", "v1.2.5"} {
+		expectedCandidates = append(expectedCandidates, shared.ReachabilityCandidate{
+			MatchID:  i + 1,
+			UploadID: 50 + i,
+			Language: "go",
+			PackageReferences: []shared.PackageReference{
+				{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: version},
+			},
+			AffectedSymbols: affectedPackage.AffectedSymbols,
+		})
+	}
+	if diff := cmp.Diff(expectedCandidates, candidates); diff != "" {
+		t.Fatalf("unexpected candidates (-want +got):\n%s", diff)
+	}
+
+	callSites := []shared.CallSite{
+		{Path: "main.go", StartLine: 10, StartCharacter: 5, EndLine: 10, EndCharacter: 9},
+	}
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, 1, shared.ReachabilityReachable, callSites); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, 2, shared.ReachabilityUnreachable, nil); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+
+	match, _, err := store.VulnerabilityMatchByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability match: %s", err)
+	}
+	if match.Reachability != shared.ReachabilityReachable {
+		t.Errorf("unexpected reachability. want=%q have=%q", shared.ReachabilityReachable, match.Reachability)
+	}
+	if diff := cmp.Diff(callSites, match.CallSites); diff != "" {
+		t.Errorf("unexpected call sites (-want +got):\n%s", diff)
+	}
+
+	candidates, err = store.GetUnanalyzedVulnerabilityMatches(ctx, 10)
+	if err != nil {
+		t.Fatalf("unexpected error getting unanalyzed matches: %s", err)
+	}
+	if len(candidates) != 1 || candidates[0].MatchID != 3 {
+		t.Errorf("unexpected candidates after update: %v", candidates)
+	}
+
+	matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, Reachability: shared.ReachabilityUnreachable})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	}
+	if len(matches) != 1 || matches[0].ID != 2 {
+		t.Errorf("unexpected unreachable matches: %v", matches)
+	}
+}
+
 func setupReferences(t *testing.T, db database.DB) {
 	store := basestore.NewWithHandle(db.Handle())
 
@@ -8,15 +8,19 @@ import (
 )
 
 type operations struct {
-	vulnerabilityByID                        *observation.Operation
-	getVulnerabilitiesByIDs                  *observation.Operation
-	getVulnerabilities                       *observation.Operation
-	insertVulnerabilities                    *observation.Operation
-	vulnerabilityMatchByID                   *observation.Operation
-	getVulnerabilityMatches                  *observation.Operation
-	getVulnerabilityMatchesSummaryCount      *observation.Operation
-	getVulnerabilityMatchesCountByRepository *observation.Operation
-	scanMatches                              *observation.Operation
+	vulnerabilityByID                          *observation.Operation
+	getVulnerabilitiesByIDs                    *observation.Operation
+	getVulnerabilities                         *observation.Operation
+	insertVulnerabilities                      *observation.Operation
+	vulnerabilityMatchByID                     *observation.Operation
+	getVulnerabilityMatches                    *observation.Operation
+	getVulnerabilityMatchesSummaryCount        *observation.Operation
+	getVulnerabilityMatchesCountByRepository   *observation.Operation
+	scanMatches                                *observation.Operation
+	getUnanalyzedVulnerabilityMatches          *observation.Operation
+	updateVulnerabilityMatchReachability       *observation.Operation
+	getPackageReferencesByUploadIDs            *observation.Operation
+	getVulnerabilityMatchReferencesByUploadIDs *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -40,14 +44,18 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		vulnerabilityByID:                        op("VulnerabilityByID"),
-		getVulnerabilitiesByIDs:                  op("GetVulnerabilitiesByIDs"),
-		getVulnerabilities:                       op("GetVulnerabilities"),
-		insertVulnerabilities:                    op("InsertVulnerabilities"),
-		vulnerabilityMatchByID:                   op("VulnerabilityMatchByID"),
-		getVulnerabilityMatches:                  op("GetVulnerabilityMatches"),
-		getVulnerabilityMatchesSummaryCount:      op("GetVulnerabilityMatchesSummaryCount"),
-		getVulnerabilityMatchesCountByRepository: op("GetVulnerabilityMatchesCountByRepository"),
-		scanMatches:                              op("ScanMatches"),
+		vulnerabilityByID:                          op("VulnerabilityByID"),
+		getVulnerabilitiesByIDs:                    op("GetVulnerabilitiesByIDs"),
+		getVulnerabilities:                         op("GetVulnerabilities"),
+		insertVulnerabilities:                      op("InsertVulnerabilities"),
+		vulnerabilityMatchByID:                     op("VulnerabilityMatchByID"),
+		getVulnerabilityMatches:                    op("GetVulnerabilityMatches"),
+		getVulnerabilityMatchesSummaryCount:        op("GetVulnerabilityMatchesSummaryCount"),
+		getVulnerabilityMatchesCountByRepository:   op("GetVulnerabilityMatchesCountByRepository"),
+		scanMatches:                                op("ScanMatches"),
+		getUnanalyzedVulnerabilityMatches:          op("GetUnanalyzedVulnerabilityMatches"),
+		updateVulnerabilityMatchReachability:       op("UpdateVulnerabilityMatchReachability"),
+		getPackageReferencesByUploadIDs:            op("GetPackageReferencesByUploadIDs"),
+		getVulnerabilityMatchReferencesByUploadIDs: op("GetVulnerabilityMatchReferencesByUploadIDs"),
 	}
 }
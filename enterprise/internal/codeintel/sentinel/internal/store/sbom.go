@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetPackageReferencesByUploadIDs(ctx context.Context, uploadIDs ...int) (_ []shared.PackageReference, err error) {
+	ctx, _, endObservation := s.operations.getPackageReferencesByUploadIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanPackageReferences(s.db.Query(ctx, sqlf.Sprintf(getPackageReferencesByUploadIDsQuery, pq.Array(uploadIDs))))
+}
+
+const getPackageReferencesByUploadIDsQuery = `
+SELECT DISTINCT
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version
+FROM lsif_references r
+WHERE r.dump_id = ANY(%s)
+ORDER BY r.scheme, r.manager, r.name, r.version
+`
+
+func (s *store) GetVulnerabilityMatchReferencesByUploadIDs(ctx context.Context, uploadIDs ...int) (_ []shared.VulnerabilityMatchReference, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityMatchReferencesByUploadIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanVulnerabilityMatchReferences(s.db.Query(ctx, sqlf.Sprintf(
+		getVulnerabilityMatchReferencesByUploadIDsQuery,
+		sqlf.Join(makeSchemeTtoVulnerabilityLanguageMappingConditions(), " OR "),
+		pq.Array(uploadIDs),
+	)))
+}
+
+const getVulnerabilityMatchReferencesByUploadIDsQuery = `
+SELECT DISTINCT
+	m.id,
+	vap.vulnerability_id,
+	m.reachability,
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version
+FROM vulnerability_matches m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+JOIN lsif_references r ON
+	r.dump_id = m.upload_id AND
+	-- NOTE: This mirrors the condition used by ScanMatches to match
+	-- package references to affected packages.
+	r.name LIKE '%%' || vap.package_name || '%%' AND
+	(%s)
+WHERE m.upload_id = ANY(%s)
+ORDER BY m.id, r.scheme, r.manager, r.name, r.version
+`
+
+//
+//
+
+var scanPackageReferences = basestore.NewSliceScanner(func(s dbutil.Scanner) (ref shared.PackageReference, _ error) {
+	err := s.Scan(
+		&ref.Scheme,
+		&ref.Manager,
+		&ref.Name,
+		&dbutil.NullString{S: &ref.Version},
+	)
+	return ref, err
+})
+
+var scanVulnerabilityMatchReferences = basestore.NewSliceScanner(func(s dbutil.Scanner) (ref shared.VulnerabilityMatchReference, _ error) {
+	var reachability string
+	err := s.Scan(
+		&ref.MatchID,
+		&ref.VulnerabilityID,
+		&dbutil.NullString{S: &reachability},
+		&ref.Reference.Scheme,
+		&ref.Reference.Manager,
+		&ref.Reference.Name,
+		&dbutil.NullString{S: &ref.Reference.Version},
+	)
+	ref.Reachability = shared.Reachability(reachability)
+	return ref, err
+})
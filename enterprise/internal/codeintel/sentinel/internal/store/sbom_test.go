@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetPackageReferencesByUploadIDs(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	refs, err := store.GetPackageReferencesByUploadIDs(ctx, 50, 54, 56)
+	if err != nil {
+		t.Fatalf("unexpected error getting package references: %s", err)
+	}
+
+	expected := []shared.PackageReference{
+		{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+		{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.6"},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+	}
+	if diff := cmp.Diff(expected, refs); diff != "" {
+		t.Errorf("unexpected package references (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetVulnerabilityMatchReferencesByUploadIDs(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	badXtools := shared.AffectedPackage{
+		Language:          "go",
+		PackageName:       "go-mockgen/xtools",
+		VersionConstraint: []string{"<= v1.3.5"},
+	}
+
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{
+		{ID: 1, SourceID: "CVE-ABC", AffectedPackages: []shared.AffectedPackage{badConfig}},
+		{ID: 2, SourceID: "CVE-DEF", AffectedPackages: []shared.AffectedPackage{badXtools}},
+	}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	// Mark the match for upload 50 as reachable
+	matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 100})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	}
+	for _, match := range matches {
+		if match.UploadID == 50 {
+			if err := store.UpdateVulnerabilityMatchReachability(ctx, match.ID, shared.ReachabilityReachable, nil); err != nil {
+				t.Fatalf("unexpected error updating reachability: %s", err)
+			}
+		}
+	}
+
+	refs, err := store.GetVulnerabilityMatchReferencesByUploadIDs(ctx, 50, 53, 54)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability match references: %s", err)
+	}
+
+	type result struct {
+		VulnerabilityID int
+		Reachability    shared.Reachability
+		Reference       shared.PackageReference
+	}
+	var results []result
+	for _, ref := range refs {
+		results = append(results, result{ref.VulnerabilityID, ref.Reachability, ref.Reference})
+	}
+
+	expected := []result{
+		{1, shared.ReachabilityReachable, shared.PackageReference{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}},
+		{2, "", shared.PackageReference{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"}},
+	}
+	if diff := cmp.Diff(expected, results); diff != "" {
+		t.Errorf("unexpected vulnerability match references (-want +got):\n%s", diff)
+	}
+}
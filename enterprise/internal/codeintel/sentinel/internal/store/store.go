@@ -24,6 +24,14 @@ type Store interface {
 	GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts shared.GetVulnerabilityMatchesSummaryCounts, err error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+
+	// Vulnerability match reachability
+	GetUnanalyzedVulnerabilityMatches(ctx context.Context, limit int) (_ []shared.ReachabilityCandidate, err error)
+	UpdateVulnerabilityMatchReachability(ctx context.Context, id int, reachability shared.Reachability, callSites []shared.CallSite) (err error)
+
+	// SBOM
+	GetPackageReferencesByUploadIDs(ctx context.Context, uploadIDs ...int) (_ []shared.PackageReference, err error)
+	GetVulnerabilityMatchReferencesByUploadIDs(ctx context.Context, uploadIDs ...int) (_ []shared.VulnerabilityMatchReference, err error)
 }
 
 type store struct {
@@ -6,19 +6,26 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type Service struct {
 	store      store.Store
+	uploadSvc  UploadService
+	codenavSvc CodeNavService
 	operations *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	uploadSvc UploadService,
+	codenavSvc CodeNavService,
 ) *Service {
 	return &Service{
 		store:      store,
+		uploadSvc:  uploadSvc,
+		codenavSvc: codenavSvc,
 		operations: newOperations(observationCtx),
 	}
 }
@@ -50,3 +57,93 @@ func (s *Service) GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (sha
 func (s *Service) GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) ([]shared.VulnerabilityMatchesByRepository, int, error) {
 	return s.store.GetVulnerabilityMatchesCountByRepository(ctx, args)
 }
+
+// GetSBOM returns the packages referenced by the precise indexes visible from the given commit,
+// along with the vulnerabilities matched against the indexes that reference those packages.
+func (s *Service) GetSBOM(ctx context.Context, repositoryID int, repositoryName, commit string) (shared.SBOM, error) {
+	sbom := shared.SBOM{
+		RepositoryName: repositoryName,
+		Commit:         commit,
+	}
+
+	dumps, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "uploadSvc.InferClosestUploads")
+	}
+	if len(dumps) == 0 {
+		return sbom, nil
+	}
+
+	uploadIDs := make([]int, 0, len(dumps))
+	for _, dump := range dumps {
+		uploadIDs = append(uploadIDs, dump.ID)
+	}
+
+	packages, err := s.store.GetPackageReferencesByUploadIDs(ctx, uploadIDs...)
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "store.GetPackageReferencesByUploadIDs")
+	}
+	sbom.Packages = packages
+
+	matchReferences, err := s.store.GetVulnerabilityMatchReferencesByUploadIDs(ctx, uploadIDs...)
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "store.GetVulnerabilityMatchReferencesByUploadIDs")
+	}
+	if len(matchReferences) == 0 {
+		return sbom, nil
+	}
+
+	vulnerabilityIDs := make([]int, 0, len(matchReferences))
+	matchReferencesByVulnerabilityID := map[int][]shared.VulnerabilityMatchReference{}
+	for _, ref := range matchReferences {
+		if _, ok := matchReferencesByVulnerabilityID[ref.VulnerabilityID]; !ok {
+			vulnerabilityIDs = append(vulnerabilityIDs, ref.VulnerabilityID)
+		}
+		matchReferencesByVulnerabilityID[ref.VulnerabilityID] = append(matchReferencesByVulnerabilityID[ref.VulnerabilityID], ref)
+	}
+
+	vulnerabilities, err := s.store.GetVulnerabilitiesByIDs(ctx, vulnerabilityIDs...)
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "store.GetVulnerabilitiesByIDs")
+	}
+
+	for _, vulnerability := range vulnerabilities {
+		refs := matchReferencesByVulnerabilityID[vulnerability.ID]
+
+		reachabilities := make([]shared.Reachability, 0, len(refs))
+		affectedPackages := make([]shared.PackageReference, 0, len(refs))
+		for _, ref := range refs {
+			reachabilities = append(reachabilities, ref.Reachability)
+			affectedPackages = append(affectedPackages, ref.Reference)
+		}
+
+		sbom.Vulnerabilities = append(sbom.Vulnerabilities, shared.SBOMVulnerability{
+			Vulnerability:    vulnerability,
+			Reachability:     combineReachability(reachabilities),
+			AffectedPackages: affectedPackages,
+		})
+	}
+
+	return sbom, nil
+}
+
+// combineReachability returns the reachability of a vulnerability matched by several indexes.
+// A vulnerability is reachable if it is reachable from any index, and is unreachable only if it
+// is unreachable from every index. Otherwise, the vulnerability is unknown or not yet analyzed.
+func combineReachability(reachabilities []shared.Reachability) shared.Reachability {
+	combined := shared.ReachabilityUnreachable
+	for _, reachability := range reachabilities {
+		switch reachability {
+		case shared.ReachabilityReachable:
+			return shared.ReachabilityReachable
+		case shared.ReachabilityUnknown:
+			if combined == shared.ReachabilityUnreachable {
+				combined = shared.ReachabilityUnknown
+			}
+		case "":
+			combined = ""
+		}
+	}
+
+	return combined
+}
@@ -56,6 +56,70 @@ type VulnerabilityMatch struct {
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+	Reachability    Reachability
+	CallSites       []CallSite
+}
+
+// Reachability describes whether or not an index references a symbol affected by
+// a vulnerability. The empty value indicates that the match has not been analyzed.
+type Reachability string
+
+const (
+	ReachabilityReachable   Reachability = "reachable"
+	ReachabilityUnreachable Reachability = "unreachable"
+	ReachabilityUnknown     Reachability = "unknown"
+)
+
+// CallSite is the location of a reference to a symbol affected by a vulnerability.
+type CallSite struct {
+	Path           string `json:"path"`
+	StartLine      int    `json:"startLine"`
+	StartCharacter int    `json:"startCharacter"`
+	EndLine        int    `json:"endLine"`
+	EndCharacter   int    `json:"endCharacter"`
+}
+
+// ReachabilityCandidate is a vulnerability match that has not yet been analyzed for
+// reachability, along with the package references of the index that caused the match.
+type ReachabilityCandidate struct {
+	MatchID           int
+	UploadID          int
+	Language          string
+	PackageReferences []PackageReference
+	AffectedSymbols   []AffectedSymbol
+}
+
+type PackageReference struct {
+	Scheme  string
+	Manager string
+	Name    string
+	Version string
+}
+
+// SBOM describes the packages referenced by the precise indexes visible from a commit of a
+// repository, along with the vulnerabilities matched against those packages.
+type SBOM struct {
+	RepositoryName  string
+	Commit          string
+	Packages        []PackageReference
+	Vulnerabilities []SBOMVulnerability
+}
+
+// SBOMVulnerability is a vulnerability that affects one or more packages of an SBOM. The
+// reachability is the most severe reachability of the underlying vulnerability matches.
+type SBOMVulnerability struct {
+	Vulnerability    Vulnerability
+	Reachability     Reachability
+	AffectedPackages []PackageReference
+}
+
+// VulnerabilityMatchReference is a package reference of an index that caused a
+// vulnerability match.
+type VulnerabilityMatchReference struct {
+	MatchID         int
+	VulnerabilityID int
+	Reachability    Reachability
+	Reference       PackageReference
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -69,6 +133,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       string
 	Language       string
 	RepositoryName string
+	Reachability   Reachability
 }
 
 type GetVulnerabilityMatchesSummaryCounts struct {
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
@@ -99,12 +100,18 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		repositoryName = *args.RepositoryName
 	}
 
+	reachability := ""
+	if args.Reachability != nil {
+		reachability = strings.ToLower(*args.Reachability)
+	}
+
 	matches, totalCount, err := r.sentinelSvc.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{
 		Limit:          int(limit),
 		Offset:         int(offset),
 		Language:       language,
 		Severity:       severity,
 		RepositoryName: repositoryName,
+		Reachability:   shared.Reachability(reachability),
 	})
 	if err != nil {
 		return nil, err
@@ -352,6 +359,52 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	return r.preciseIndexResolverFactory.Create(ctx, r.uploadLoader, r.indexLoader, r.locationResolver, r.errTracer, &upload, nil)
 }
 
+func (r *vulnerabilityMatchResolver) Reachability() *string {
+	if r.m.Reachability == "" {
+		return nil
+	}
+
+	return pointers.Ptr(strings.ToUpper(string(r.m.Reachability)))
+}
+
+func (r *vulnerabilityMatchResolver) CallSites() []resolverstubs.VulnerabilityCallSiteResolver {
+	resolvers := make([]resolverstubs.VulnerabilityCallSiteResolver, 0, len(r.m.CallSites))
+	for _, callSite := range r.m.CallSites {
+		resolvers = append(resolvers, &vulnerabilityCallSiteResolver{callSite})
+	}
+
+	return resolvers
+}
+
+type vulnerabilityCallSiteResolver struct {
+	c shared.CallSite
+}
+
+func (r *vulnerabilityCallSiteResolver) Path() string { return r.c.Path }
+
+func (r *vulnerabilityCallSiteResolver) Range() resolverstubs.RangeResolver {
+	return &rangeResolver{
+		start: positionResolver{line: r.c.StartLine, character: r.c.StartCharacter},
+		end:   positionResolver{line: r.c.EndLine, character: r.c.EndCharacter},
+	}
+}
+
+type rangeResolver struct {
+	start positionResolver
+	end   positionResolver
+}
+
+func (r *rangeResolver) Start() resolverstubs.PositionResolver { return r.start }
+func (r *rangeResolver) End() resolverstubs.PositionResolver   { return r.end }
+
+type positionResolver struct {
+	line      int
+	character int
+}
+
+func (r positionResolver) Line() int32      { return int32(r.line) }
+func (r positionResolver) Character() int32 { return int32(r.character) }
+
 //
 //
 
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type sbomHandler struct {
+	svc             SentinelService
+	repoStore       database.RepoStore
+	gitserverClient gitserver.Client
+	operations      *operations
+	logger          log.Logger
+}
+
+// newSBOMHandler creates a handler that serves an SBOM describing the packages referenced by the
+// precise indexes of a repository at a commit. The handler accepts the following query parameters:
+//
+//   - repository: the name of the repository (required)
+//   - commit: the revision of the repository (defaults to HEAD)
+//   - format: either cyclonedx (the default) or spdx
+func newSBOMHandler(
+	svc SentinelService,
+	repoStore database.RepoStore,
+	gitserverClient gitserver.Client,
+	operations *operations,
+	logger log.Logger,
+) http.Handler {
+	h := &sbomHandler{
+		svc:             svc,
+		repoStore:       repoStore,
+		gitserverClient: gitserverClient,
+		operations:      operations,
+		logger:          logger,
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, filename, format, statusCode, err := h.handle(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(statusCode)
+
+		if _, err := w.Write(payload); err != nil {
+			h.logger.Error("failed to write payload to client", log.Error(err))
+		}
+	})
+}
+
+func (h *sbomHandler) handle(r *http.Request) (_ []byte, filename string, format sbom.Format, statusCode int, err error) {
+	query := r.URL.Query()
+	repositoryName := query.Get("repository")
+	revision := query.Get("commit")
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	ctx, _, endObservation := h.operations.getSBOM.With(r.Context(), &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", repositoryName),
+		attribute.String("commit", revision),
+		attribute.String("format", query.Get("format")),
+	}})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	if repositoryName == "" {
+		return nil, "", "", http.StatusBadRequest, errors.New("no repository supplied")
+	}
+
+	format, err = sbom.ParseFormat(query.Get("format"))
+	if err != nil {
+		return nil, "", "", http.StatusBadRequest, err
+	}
+
+	// 🚨 SECURITY: The SBOM describes the contents of the repository, so only users with
+	// access to the repository may download it. GetByName filters out repositories the
+	// current user can't see.
+	repo, err := h.repoStore.GetByName(ctx, api.RepoName(repositoryName))
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, "", "", http.StatusNotFound, errors.Newf("repository %q not found", repositoryName)
+		}
+		return nil, "", "", http.StatusInternalServerError, errors.Wrap(err, "looking up repository")
+	}
+
+	commit, err := h.gitserverClient.ResolveRevision(ctx, repo.Name, revision, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, "", "", http.StatusNotFound, errors.Newf("revision %q not found", revision)
+		}
+		return nil, "", "", http.StatusInternalServerError, errors.Wrap(err, "resolving revision")
+	}
+
+	document, err := h.svc.GetSBOM(ctx, int(repo.ID), string(repo.Name), string(commit))
+	if err != nil {
+		return nil, "", "", http.StatusInternalServerError, errors.Wrap(err, "constructing SBOM")
+	}
+
+	payload, err := sbom.Encode(format, document, sbom.Metadata{
+		ID:           uuid.NewString(),
+		NamespaceURL: strings.TrimSuffix(conf.ExternalURL(), "/") + "/.api/codeintel/sbom",
+		Timestamp:    time.Now(),
+	})
+	if err != nil {
+		return nil, "", "", http.StatusInternalServerError, errors.Wrap(err, "encoding SBOM")
+	}
+
+	filename = fmt.Sprintf("%s-%s.%s.json", strings.ReplaceAll(string(repo.Name), "/", "-"), commit, format)
+
+	return payload, filename, format, http.StatusOK, nil
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const testCommit = "deadbeef01deadbeef02deadbeef03deadbeef04"
+
+func TestSBOMHandler(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{ExternalURL: "https://sourcegraph.test"}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	repoStore := database.NewMockRepoStore()
+	repoStore.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		if name != "github.com/test/test" {
+			return nil, &database.RepoNotFoundErr{Name: name}
+		}
+		return &types.Repo{ID: 42, Name: name}, nil
+	})
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if spec != "HEAD" && spec != testCommit {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: spec}
+		}
+		return testCommit, nil
+	})
+
+	svc := NewMockSentinelService()
+	svc.GetSBOMFunc.SetDefaultHook(func(_ context.Context, repositoryID int, repositoryName, commit string) (shared.SBOM, error) {
+		return shared.SBOM{
+			RepositoryName: repositoryName,
+			Commit:         commit,
+			Packages: []shared.PackageReference{
+				{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+			},
+		}, nil
+	})
+
+	handler := newSBOMHandler(svc, repoStore, gitserverClient, newOperations(&observation.TestContext), logtest.Scoped(t))
+
+	testCases := []struct {
+		name               string
+		query              url.Values
+		expectedStatusCode int
+		expectedType       string
+	}{
+		{
+			name:               "cyclonedx",
+			query:              url.Values{"repository": []string{"github.com/test/test"}},
+			expectedStatusCode: http.StatusOK,
+			expectedType:       "application/vnd.cyclonedx+json",
+		},
+		{
+			name:               "spdx",
+			query:              url.Values{"repository": []string{"github.com/test/test"}, "commit": []string{testCommit}, "format": []string{"spdx"}},
+			expectedStatusCode: http.StatusOK,
+			expectedType:       "application/spdx+json",
+		},
+		{
+			name:               "missing repository",
+			query:              url.Values{},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unsupported format",
+			query:              url.Values{"repository": []string{"github.com/test/test"}, "format": []string{"swid"}},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unknown repository",
+			query:              url.Values{"repository": []string{"github.com/test/missing"}},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "unknown commit",
+			query:              url.Values{"repository": []string{"github.com/test/test"}, "commit": []string{"missing"}},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r, err := http.NewRequest("GET", "http://test.com/.api/codeintel/sbom?"+testCase.query.Encode(), nil)
+			if err != nil {
+				t.Fatalf("unexpected error constructing request: %s", err)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != testCase.expectedStatusCode {
+				t.Fatalf("unexpected status code. want=%d have=%d (%s)", testCase.expectedStatusCode, w.Code, w.Body.String())
+			}
+			if testCase.expectedType != "" {
+				if contentType := w.Header().Get("Content-Type"); contentType != testCase.expectedType {
+					t.Errorf("unexpected content type. want=%q have=%q", testCase.expectedType, contentType)
+				}
+			}
+		})
+	}
+
+	if calls := svc.GetSBOMFunc.History(); len(calls) != 2 {
+		t.Fatalf("unexpected number of GetSBOM calls. want=%d have=%d", 2, len(calls))
+	} else if calls[0].Arg1 != 42 || calls[0].Arg3 != testCommit {
+		t.Errorf("unexpected GetSBOM arguments: %v", calls[0].Args())
+	}
+}
@@ -0,0 +1,11 @@
+package http
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+type SentinelService interface {
+	GetSBOM(ctx context.Context, repositoryID int, repositoryName, commit string) (shared.SBOM, error)
+}
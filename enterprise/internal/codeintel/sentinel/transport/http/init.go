@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+var (
+	handler     http.Handler
+	handlerOnce sync.Once
+)
+
+func GetSBOMHandler(svc SentinelService, db database.DB, gitserverClient gitserver.Client) http.Handler {
+	handlerOnce.Do(func() {
+		logger := log.Scoped(
+			"sentinel.handler",
+			"codeintel sentinel http handler",
+		)
+
+		observationCtx := observation.NewContext(logger)
+
+		handler = newSBOMHandler(svc, db.Repos(), gitserverClient, newOperations(observationCtx), logger)
+	})
+
+	return handler
+}
@@ -43,8 +43,8 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 	policiesSvc := policies.NewService(deps.ObservationCtx, db, uploadsSvc, gitserverClient)
 	autoIndexingSvc := autoindexing.NewService(deps.ObservationCtx, db, dependenciesSvc, policiesSvc, gitserverClient)
 	codenavSvc := codenav.NewService(deps.ObservationCtx, db, codeIntelDB, uploadsSvc, gitserverClient)
-	rankingSvc := ranking.NewService(deps.ObservationCtx, db, codeIntelDB)
-	sentinelService := sentinel.NewService(deps.ObservationCtx, db)
+	rankingSvc := ranking.NewService(deps.ObservationCtx, db, codeIntelDB, gitserverClient)
+	sentinelService := sentinel.NewService(deps.ObservationCtx, db, uploadsSvc, codenavSvc)
 	contextService := context.NewService(deps.ObservationCtx, db)
 
 	return Services{
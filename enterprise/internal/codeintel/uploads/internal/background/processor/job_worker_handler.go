@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/sourcegraph/log"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/diff"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/store"
 	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
@@ -240,6 +242,29 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 		return directoryChildren, nil
 	}
 
+	// If this is a partial index, resolve the upload it's layered on top of. Only the documents of
+	// the partial index are stored; the documents of the base upload that changed since its commit
+	// are hidden so that code navigation doesn't fall through to stale data.
+	var layer *uploadLayer
+	if upload.BaseUploadID != nil {
+		baseUpload, err := h.getBaseUpload(ctx, upload)
+		if err != nil {
+			return false, err
+		}
+		trace.AddEvent("TODO Domain Owner", attribute.Int("baseUploadID", baseUpload.ID))
+
+		changedPaths, err := h.getChangedPaths(ctx, repo.Name, baseUpload, upload)
+		if err != nil {
+			return false, err
+		}
+		trace.AddEvent("TODO Domain Owner", attribute.Int("numChangedPaths", len(changedPaths)))
+
+		layer = &uploadLayer{
+			baseUploadID: baseUpload.ID,
+			changedPaths: changedPaths,
+		}
+	}
+
 	return false, withUploadData(ctx, logger, uploadStore, upload.ID, trace, func(r io.Reader) (err error) {
 		const (
 			lsifContentType = "application/x-ndjson+lsif"
@@ -285,7 +310,7 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 
 		// Note: this is writing to a different database than the block below, so we need to use a
 		// different transaction context (managed by the writeData function).
-		if err := writeSCIPData(ctx, h.lsifStore, upload, correlatedSCIPData, trace); err != nil {
+		if err := writeSCIPData(ctx, h.lsifStore, upload, layer, correlatedSCIPData, trace); err != nil {
 			if isUniqueConstraintViolation(err) {
 				// If this is a unique constraint violation, then we've previously processed this same
 				// upload record up to this point, but failed to perform the transaction below. We can
@@ -324,6 +349,12 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 			if err := tx.UpdatePackageReferences(ctx, upload.ID, packageReferences); err != nil {
 				return errors.Wrap(err, "store.UpdatePackageReferences")
 			}
+			if layer != nil {
+				// The partial index only describes the packages of the documents it contains
+				if err := tx.InsertPackagesFromUpload(ctx, upload.ID, layer.baseUploadID); err != nil {
+					return errors.Wrap(err, "store.InsertPackagesFromUpload")
+				}
+			}
 
 			// Insert a companion record to this upload that will asynchronously trigger other workers to
 			// sync/create referenced dependency repositories and queue auto-index records for the monikers
@@ -347,6 +378,49 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 	})
 }
 
+// getBaseUpload returns the upload that the given partial upload is layered on top of. The base
+// upload must have completed processing and must cover the same repository, root, and indexer.
+func (h *handler) getBaseUpload(ctx context.Context, upload uploadsshared.Upload) (uploadsshared.Upload, error) {
+	baseUpload, ok, err := h.store.GetUploadByID(ctx, *upload.BaseUploadID)
+	if err != nil {
+		return uploadsshared.Upload{}, errors.Wrap(err, "store.GetUploadByID")
+	}
+	if !ok {
+		return uploadsshared.Upload{}, errors.Newf("base upload %d does not exist", *upload.BaseUploadID)
+	}
+	if baseUpload.State != "completed" {
+		return uploadsshared.Upload{}, errors.Newf("base upload %d has not completed processing (state is %q)", baseUpload.ID, baseUpload.State)
+	}
+	if baseUpload.RepositoryID != upload.RepositoryID || baseUpload.Root != upload.Root || baseUpload.Indexer != upload.Indexer {
+		return uploadsshared.Upload{}, errors.Newf("base upload %d does not share the same repository, root, and indexer", baseUpload.ID)
+	}
+
+	return baseUpload, nil
+}
+
+// getChangedPaths returns the paths, relative to the upload root, of the files under the root that
+// were deleted or modified between the commits of the given base upload and partial upload.
+func (h *handler) getChangedPaths(ctx context.Context, repoName api.RepoName, baseUpload, upload uploadsshared.Upload) ([]string, error) {
+	output, err := h.gitserverClient.DiffSymbols(ctx, repoName, api.CommitID(baseUpload.Commit), api.CommitID(upload.Commit))
+	if err != nil {
+		return nil, errors.Wrap(err, "gitserver.DiffSymbols")
+	}
+
+	changedPaths, _, err := diff.ParseGitDiffNameStatus(output)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(changedPaths))
+	for _, path := range changedPaths {
+		if strings.HasPrefix(path, upload.Root) {
+			paths = append(paths, strings.TrimPrefix(path, upload.Root))
+		}
+	}
+
+	return paths, nil
+}
+
 func inTransaction(ctx context.Context, dbStore store.Store, fn func(tx store.Store) error) (err error) {
 	return dbStore.WithTransaction(ctx, fn)
 }
@@ -324,6 +324,146 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+func TestHandleBaseUpload(t *testing.T) {
+	setupRepoMocks(t)
+
+	baseUploadID := 41
+	upload := shared.Upload{
+		ID:           42,
+		Root:         "",
+		Commit:       "deadbeef",
+		RepositoryID: 50,
+		Indexer:      "lsif-go",
+		ContentType:  "application/x-protobuf+scip",
+		BaseUploadID: &baseUploadID,
+	}
+
+	mockWorkerStore := NewMockWorkerStore[shared.Upload]()
+	mockDBStore := NewMockStore()
+	mockRepoStore := defaultMockRepoStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+	gitserverClient := gitserver.NewMockClient()
+
+	mockDBStore.WithTransactionFunc.SetDefaultHook(func(ctx context.Context, f func(s store.Store) error) error { return f(mockDBStore) })
+	mockLSIFStore.WithTransactionFunc.SetDefaultHook(func(ctx context.Context, f func(s lsifstore.Store) error) error { return f(mockLSIFStore) })
+	mockLSIFStore.NewSCIPWriterFunc.SetDefaultReturn(NewMockLSIFSCIPWriter(), nil)
+	mockUploadStore.GetFunc.SetDefaultHook(copyTestDumpScip)
+	gitserverClient.ListDirectoryChildrenFunc.SetDefaultReturn(scipDirectoryChildren, nil)
+	gitserverClient.CommitDateFunc.SetDefaultReturn("deadbeef", time.Unix(1587396557, 0).UTC(), true, nil)
+
+	mockDBStore.GetUploadByIDFunc.SetDefaultReturn(shared.Upload{
+		ID:           41,
+		Root:         "",
+		Commit:       "cafebabe",
+		RepositoryID: 50,
+		Indexer:      "lsif-go",
+		State:        "completed",
+	}, true, nil)
+	mockLSIFStore.InsertLayerFunc.SetDefaultReturn(1, nil)
+
+	// extension.ts is re-indexed by the partial index, the others are not
+	gitserverClient.DiffSymbolsFunc.SetDefaultReturn([]byte(strings.Join([]string{
+		"M", "template/src/extension.ts",
+		"D", "template/src/deleted.ts",
+		"M", "template/src/stale.ts",
+		"A", "template/src/added.ts",
+	}, "\x00")+"\x00"), nil)
+
+	svc := &handler{
+		store:           mockDBStore,
+		lsifStore:       mockLSIFStore,
+		gitserverClient: gitserverClient,
+		repoStore:       mockRepoStore,
+		workerStore:     mockWorkerStore,
+	}
+
+	requeued, err := svc.HandleRawUpload(context.Background(), logtest.Scoped(t), upload, mockUploadStore, observation.TestTraceLogger(logtest.Scoped(t)))
+	if err != nil {
+		t.Fatalf("unexpected error handling upload: %s", err)
+	} else if requeued {
+		t.Errorf("unexpected requeue")
+	}
+
+	if calls := gitserverClient.DiffSymbolsFunc.History(); len(calls) != 1 {
+		t.Errorf("unexpected number of DiffSymbols calls. want=%d have=%d", 1, len(calls))
+	} else if calls[0].Arg2 != "cafebabe" || calls[0].Arg3 != "deadbeef" {
+		t.Errorf("unexpected DiffSymbols commits. want=%s..%s have=%s..%s", "cafebabe", "deadbeef", calls[0].Arg2, calls[0].Arg3)
+	}
+
+	if calls := mockLSIFStore.InsertLayerFunc.History(); len(calls) != 1 {
+		t.Errorf("unexpected number of InsertLayer calls. want=%d have=%d", 1, len(calls))
+	} else {
+		if calls[0].Arg1 != 42 || calls[0].Arg2 != 41 {
+			t.Errorf("unexpected InsertLayer upload ids. want=%d,%d have=%d,%d", 42, 41, calls[0].Arg1, calls[0].Arg2)
+		}
+		if diff := cmp.Diff([]string{"template/src/deleted.ts", "template/src/stale.ts"}, calls[0].Arg3); diff != "" {
+			t.Errorf("unexpected tombstoned paths (-want +got):\n%s", diff)
+		}
+	}
+
+	if calls := mockDBStore.InsertPackagesFromUploadFunc.History(); len(calls) != 1 {
+		t.Errorf("unexpected number of InsertPackagesFromUpload calls. want=%d have=%d", 1, len(calls))
+	} else if calls[0].Arg1 != 42 || calls[0].Arg2 != 41 {
+		t.Errorf("unexpected InsertPackagesFromUpload upload ids. want=%d,%d have=%d,%d", 42, 41, calls[0].Arg1, calls[0].Arg2)
+	}
+}
+
+func TestHandleBaseUploadMismatch(t *testing.T) {
+	setupRepoMocks(t)
+
+	baseUploadID := 41
+	upload := shared.Upload{
+		ID:           42,
+		Root:         "root/",
+		Commit:       "deadbeef",
+		RepositoryID: 50,
+		Indexer:      "lsif-go",
+		ContentType:  "application/x-protobuf+scip",
+		BaseUploadID: &baseUploadID,
+	}
+
+	mockWorkerStore := NewMockWorkerStore[shared.Upload]()
+	mockDBStore := NewMockStore()
+	mockRepoStore := defaultMockRepoStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+	gitserverClient := gitserver.NewMockClient()
+
+	// Base upload was produced for a different root
+	mockDBStore.GetUploadByIDFunc.SetDefaultReturn(shared.Upload{
+		ID:           41,
+		Root:         "other/",
+		RepositoryID: 50,
+		Indexer:      "lsif-go",
+		State:        "completed",
+	}, true, nil)
+
+	svc := &handler{
+		store:           mockDBStore,
+		lsifStore:       mockLSIFStore,
+		gitserverClient: gitserverClient,
+		repoStore:       mockRepoStore,
+		workerStore:     mockWorkerStore,
+	}
+
+	requeued, err := svc.HandleRawUpload(context.Background(), logtest.Scoped(t), upload, mockUploadStore, observation.TestTraceLogger(logtest.Scoped(t)))
+	if err == nil {
+		t.Fatalf("unexpected nil error handling upload")
+	} else if !strings.Contains(err.Error(), "base upload 41") {
+		t.Fatalf("unexpected error: %s", err)
+	} else if requeued {
+		t.Errorf("unexpected requeue")
+	}
+
+	if len(gitserverClient.DiffSymbolsFunc.History()) != 0 {
+		t.Errorf("unexpected number of DiffSymbols calls. want=%d have=%d", 0, len(gitserverClient.DiffSymbolsFunc.History()))
+	}
+	if len(mockUploadStore.GetFunc.History()) != 0 {
+		t.Errorf("unexpected number of Get calls. want=%d have=%d", 0, len(mockUploadStore.GetFunc.History()))
+	}
+}
+
 func TestHandleCloneInProgress(t *testing.T) {
 	upload := shared.Upload{
 		ID:           42,
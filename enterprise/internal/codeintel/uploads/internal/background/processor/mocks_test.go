@@ -312,6 +312,9 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// InsertDependencySyncingJob.
 	InsertDependencySyncingJobFunc *StoreInsertDependencySyncingJobFunc
+	// InsertPackagesFromUploadFunc is an instance of a mock function object
+	// controlling the behavior of the method InsertPackagesFromUpload.
+	InsertPackagesFromUploadFunc *StoreInsertPackagesFromUploadFunc
 	// InsertUploadFunc is an instance of a mock function object controlling
 	// the behavior of the method InsertUpload.
 	InsertUploadFunc *StoreInsertUploadFunc
@@ -594,6 +597,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		InsertPackagesFromUploadFunc: &StoreInsertPackagesFromUploadFunc{
+			defaultHook: func(context.Context, int, int) (r0 error) {
+				return
+			},
+		},
 		InsertUploadFunc: &StoreInsertUploadFunc{
 			defaultHook: func(context.Context, shared.Upload) (r0 int, r1 error) {
 				return
@@ -921,6 +929,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.InsertDependencySyncingJob")
 			},
 		},
+		InsertPackagesFromUploadFunc: &StoreInsertPackagesFromUploadFunc{
+			defaultHook: func(context.Context, int, int) error {
+				panic("unexpected invocation of MockStore.InsertPackagesFromUpload")
+			},
+		},
 		InsertUploadFunc: &StoreInsertUploadFunc{
 			defaultHook: func(context.Context, shared.Upload) (int, error) {
 				panic("unexpected invocation of MockStore.InsertUpload")
@@ -1170,6 +1183,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		InsertDependencySyncingJobFunc: &StoreInsertDependencySyncingJobFunc{
 			defaultHook: i.InsertDependencySyncingJob,
 		},
+		InsertPackagesFromUploadFunc: &StoreInsertPackagesFromUploadFunc{
+			defaultHook: i.InsertPackagesFromUpload,
+		},
 		InsertUploadFunc: &StoreInsertUploadFunc{
 			defaultHook: i.InsertUpload,
 		},
@@ -5623,6 +5639,112 @@ func (c StoreInsertDependencySyncingJobFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreInsertPackagesFromUploadFunc describes the behavior when the
+// InsertPackagesFromUpload method of the parent MockStore instance is
+// invoked.
+type StoreInsertPackagesFromUploadFunc struct {
+	defaultHook func(context.Context, int, int) error
+	hooks       []func(context.Context, int, int) error
+	history     []StoreInsertPackagesFromUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertPackagesFromUpload delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) InsertPackagesFromUpload(v0 context.Context, v1 int, v2 int) error {
+	r0 := m.InsertPackagesFromUploadFunc.nextHook()(v0, v1, v2)
+	m.InsertPackagesFromUploadFunc.appendCall(StoreInsertPackagesFromUploadFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// InsertPackagesFromUpload method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreInsertPackagesFromUploadFunc) SetDefaultHook(hook func(context.Context, int, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertPackagesFromUpload method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreInsertPackagesFromUploadFunc) PushHook(hook func(context.Context, int, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreInsertPackagesFromUploadFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreInsertPackagesFromUploadFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, int) error {
+		return r0
+	})
+}
+
+func (f *StoreInsertPackagesFromUploadFunc) nextHook() func(context.Context, int, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreInsertPackagesFromUploadFunc) appendCall(r0 StoreInsertPackagesFromUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreInsertPackagesFromUploadFuncCall
+// objects describing the invocations of this function.
+func (f *StoreInsertPackagesFromUploadFunc) History() []StoreInsertPackagesFromUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreInsertPackagesFromUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreInsertPackagesFromUploadFuncCall is an object that describes an
+// invocation of method InsertPackagesFromUpload on an instance of MockStore.
+type StoreInsertPackagesFromUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreInsertPackagesFromUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreInsertPackagesFromUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreInsertUploadFunc describes the behavior when the InsertUpload method
 // of the parent MockStore instance is invoked.
 type StoreInsertUploadFunc struct {
@@ -8680,6 +8802,9 @@ type MockLSIFStore struct {
 	// mock function object controlling the behavior of the method
 	// InsertDefinitionsAndReferencesForDocument.
 	InsertDefinitionsAndReferencesForDocumentFunc *LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc
+	// InsertLayerFunc is an instance of a mock function object controlling the
+	// behavior of the method InsertLayer.
+	InsertLayerFunc *LSIFStoreInsertLayerFunc
 	// InsertMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method InsertMetadata.
 	InsertMetadataFunc *LSIFStoreInsertMetadataFunc
@@ -8727,6 +8852,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		InsertLayerFunc: &LSIFStoreInsertLayerFunc{
+			defaultHook: func(context.Context, int, int, []string) (r0 int, r1 error) {
+				return
+			},
+		},
 		InsertMetadataFunc: &LSIFStoreInsertMetadataFunc{
 			defaultHook: func(context.Context, int, lsifstore.ProcessedMetadata) (r0 error) {
 				return
@@ -8784,6 +8914,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.InsertDefinitionsAndReferencesForDocument")
 			},
 		},
+		InsertLayerFunc: &LSIFStoreInsertLayerFunc{
+			defaultHook: func(context.Context, int, int, []string) (int, error) {
+				panic("unexpected invocation of MockLSIFStore.InsertLayer")
+			},
+		},
 		InsertMetadataFunc: &LSIFStoreInsertMetadataFunc{
 			defaultHook: func(context.Context, int, lsifstore.ProcessedMetadata) error {
 				panic("unexpected invocation of MockLSIFStore.InsertMetadata")
@@ -8831,6 +8966,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		InsertDefinitionsAndReferencesForDocumentFunc: &LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc{
 			defaultHook: i.InsertDefinitionsAndReferencesForDocument,
 		},
+		InsertLayerFunc: &LSIFStoreInsertLayerFunc{
+			defaultHook: i.InsertLayer,
+		},
 		InsertMetadataFunc: &LSIFStoreInsertMetadataFunc{
 			defaultHook: i.InsertMetadata,
 		},
@@ -9423,6 +9561,115 @@ func (c LSIFStoreInsertDefinitionsAndReferencesForDocumentFuncCall) Results() []
 	return []interface{}{c.Result0}
 }
 
+// LSIFStoreInsertLayerFunc describes the behavior when the InsertLayer
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreInsertLayerFunc struct {
+	defaultHook func(context.Context, int, int, []string) (int, error)
+	hooks       []func(context.Context, int, int, []string) (int, error)
+	history     []LSIFStoreInsertLayerFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertLayer delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) InsertLayer(v0 context.Context, v1 int, v2 int, v3 []string) (int, error) {
+	r0, r1 := m.InsertLayerFunc.nextHook()(v0, v1, v2, v3)
+	m.InsertLayerFunc.appendCall(LSIFStoreInsertLayerFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the InsertLayer method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreInsertLayerFunc) SetDefaultHook(hook func(context.Context, int, int, []string) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertLayer method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreInsertLayerFunc) PushHook(hook func(context.Context, int, int, []string) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreInsertLayerFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int, []string) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreInsertLayerFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, int, []string) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreInsertLayerFunc) nextHook() func(context.Context, int, int, []string) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreInsertLayerFunc) appendCall(r0 LSIFStoreInsertLayerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreInsertLayerFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreInsertLayerFunc) History() []LSIFStoreInsertLayerFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreInsertLayerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreInsertLayerFuncCall is an object that describes an invocation of
+// method InsertLayer on an instance of MockLSIFStore.
+type LSIFStoreInsertLayerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method invocation.
+	Arg3 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreInsertLayerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreInsertLayerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // LSIFStoreInsertMetadataFunc describes the behavior when the
 // InsertMetadata method of the parent MockLSIFStore instance is invoked.
 type LSIFStoreInsertMetadataFunc struct {
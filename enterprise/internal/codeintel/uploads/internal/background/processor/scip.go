@@ -368,11 +368,13 @@ func packageFromSymbol(symbolName string) (precise.Package, bool) {
 }
 
 // writeSCIPData transactionally writes the given correlated SCIP data into the given store targeting
-// the codeintel-db.
+// the codeintel-db. If layer is non-nil, the upload is also recorded as a partial index layered on top
+// of its base upload.
 func writeSCIPData(
 	ctx context.Context,
 	lsifStore lsifstore.Store,
 	upload shared.Upload,
+	layer *uploadLayer,
 	correlatedSCIPData lsifstore.ProcessedSCIPData,
 	trace observation.TraceLogger,
 ) (err error) {
@@ -387,12 +389,16 @@ func writeSCIPData(
 		}
 
 		var numDocuments uint32
+		writtenPaths := map[string]struct{}{}
 		for document := range correlatedSCIPData.Documents {
 			if err := scipWriter.InsertDocument(ctx, document.Path, document.Document); err != nil {
 				return err
 			}
 
 			numDocuments += 1
+			if layer != nil {
+				writtenPaths[document.Path] = struct{}{}
+			}
 		}
 		trace.AddEvent("TODO Domain Owner", attribute.Int64("numDocuments", int64(numDocuments)))
 
@@ -402,10 +408,35 @@ func writeSCIPData(
 		}
 		trace.AddEvent("TODO Domain Owner", attribute.Int64("numSymbols", int64(count)))
 
+		if layer != nil {
+			// Changed documents of the layers below that the partial index doesn't replace are stale
+			tombstonedPaths := make([]string, 0, len(layer.changedPaths))
+			for _, path := range layer.changedPaths {
+				if _, ok := writtenPaths[path]; !ok {
+					tombstonedPaths = append(tombstonedPaths, path)
+				}
+			}
+
+			depth, err := tx.InsertLayer(ctx, upload.ID, layer.baseUploadID, tombstonedPaths)
+			if err != nil {
+				return err
+			}
+			trace.AddEvent("TODO Domain Owner", attribute.Int("layerDepth", depth), attribute.Int("numTombstonedPaths", len(tombstonedPaths)))
+		}
+
 		return nil
 	})
 }
 
+// uploadLayer describes the upload that a partial index is layered on top of.
+type uploadLayer struct {
+	baseUploadID int
+
+	// changedPaths are the root-relative paths of the files that were deleted or modified
+	// between the commits of the base upload and the partial upload.
+	changedPaths []string
+}
+
 // comparePackages returns true if pi sorts lower than pj.
 func comparePackages(pi, pj precise.Package) bool {
 	if pi.Scheme == pj.Scheme {
@@ -85,9 +85,27 @@ func (s *store) DeleteLsifDataByUploadIds(ctx context.Context, bundleIDs ...int)
 	}
 
 	return s.withTransaction(ctx, func(tx *store) error {
+		// Partial uploads resolve documents through the uploads they are layered on top of, so we
+		// keep the data of any upload still below a layer that isn't being deleted. The reconciler
+		// will retry these uploads once the layers on top of them are gone. Locking the metadata
+		// first ensures no layer can be inserted on top of these uploads concurrently.
+		if err := tx.db.Exec(ctx, sqlf.Sprintf(lockSCIPMetadataQuery, pq.Array(bundleIDs))); err != nil {
+			return err
+		}
+		bundleIDs, err := basestore.ScanInts(tx.db.Query(ctx, sqlf.Sprintf(unprotectedUploadsQuery, pq.Array(bundleIDs), pq.Array(bundleIDs))))
+		if err != nil {
+			return err
+		}
+		if len(bundleIDs) == 0 {
+			return nil
+		}
+
 		if err := tx.db.Exec(ctx, sqlf.Sprintf(deleteSCIPDocumentLookupQuery, pq.Array(bundleIDs))); err != nil {
 			return err
 		}
+		if err := tx.db.Exec(ctx, sqlf.Sprintf(deleteSCIPLayersQuery, pq.Array(bundleIDs), pq.Array(bundleIDs))); err != nil {
+			return err
+		}
 		if err := tx.db.Exec(ctx, sqlf.Sprintf(deleteSCIPMetadataQuery, pq.Array(bundleIDs))); err != nil {
 			return err
 		}
@@ -109,6 +127,41 @@ func (s *store) DeleteLsifDataByUploadIds(ctx context.Context, bundleIDs ...int)
 	})
 }
 
+const lockSCIPMetadataQuery = `
+SELECT 1
+FROM codeintel_scip_metadata
+WHERE upload_id = ANY(%s)
+ORDER BY id
+FOR UPDATE
+`
+
+const unprotectedUploadsQuery = `
+WITH RECURSIVE protected(upload_id) AS (
+	SELECT sl.base_upload_id
+	FROM codeintel_scip_layers sl
+	WHERE NOT (sl.upload_id = ANY(%s))
+
+	UNION
+
+	SELECT sl.base_upload_id
+	FROM protected p
+	JOIN codeintel_scip_layers sl ON sl.upload_id = p.upload_id
+)
+SELECT u.id
+FROM unnest(%s::integer[]) AS u(id)
+WHERE NOT EXISTS (SELECT 1 FROM protected p WHERE p.upload_id = u.id)
+ORDER BY u.id
+`
+
+const deleteSCIPLayersQuery = `
+WITH deleted_layers AS (
+	DELETE FROM codeintel_scip_layers
+	WHERE upload_id = ANY(%s)
+)
+DELETE FROM codeintel_scip_document_tombstones
+WHERE upload_id = ANY(%s)
+`
+
 const deleteSCIPMetadataQuery = `
  WITH
  locked_metadata AS (
@@ -47,6 +47,58 @@ func TestDeleteLsifDataByUploadIds(t *testing.T) {
 			t.Errorf("unexpected dump identifiers (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("layers", func(t *testing.T) {
+		for i := 11; i <= 14; i++ {
+			query := sqlf.Sprintf("INSERT INTO codeintel_scip_metadata (upload_id, text_document_encoding, tooL_name, tool_version, tool_arguments, protocol_version) VALUES (%s, 'utf8', '', '', '{}', 1)", i)
+
+			if _, err := codeIntelDB.ExecContext(context.Background(), query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+				t.Fatalf("unexpected error inserting metadata: %s", err)
+			}
+		}
+
+		// 13 is layered on top of 12, which is layered on top of 11
+		if _, err := codeIntelDB.ExecContext(context.Background(), `
+			INSERT INTO codeintel_scip_layers (upload_id, base_upload_id, depth) VALUES (12, 11, 1), (13, 12, 2);
+			INSERT INTO codeintel_scip_document_tombstones (upload_id, document_path) VALUES (12, 'a.go'), (13, 'b.go');
+		`); err != nil {
+			t.Fatalf("unexpected error inserting layers: %s", err)
+		}
+
+		assertDumpIDs := func(expectedDumpIDs []int) {
+			dumpIDs, err := basestore.ScanInts(codeIntelDB.QueryContext(context.Background(), "SELECT upload_id FROM codeintel_scip_metadata WHERE upload_id > 10 ORDER BY upload_id"))
+			if err != nil {
+				t.Fatalf("Unexpected error querying dump identifiers: %s", err)
+			}
+			if diff := cmp.Diff(expectedDumpIDs, dumpIDs); diff != "" {
+				t.Errorf("unexpected dump identifiers (-want +got):\n%s", diff)
+			}
+		}
+
+		// Uploads below a live layer are kept
+		if err := store.DeleteLsifDataByUploadIds(context.Background(), 11, 12, 14); err != nil {
+			t.Fatalf("unexpected error clearing bundle data: %s", err)
+		}
+		assertDumpIDs([]int{11, 12, 13})
+
+		if err := store.DeleteLsifDataByUploadIds(context.Background(), 13); err != nil {
+			t.Fatalf("unexpected error clearing bundle data: %s", err)
+		}
+		assertDumpIDs([]int{11, 12})
+
+		if err := store.DeleteLsifDataByUploadIds(context.Background(), 11, 12); err != nil {
+			t.Fatalf("unexpected error clearing bundle data: %s", err)
+		}
+		assertDumpIDs(nil)
+
+		numRows, _, err := basestore.ScanFirstInt(codeIntelDB.QueryContext(context.Background(), "SELECT (SELECT COUNT(*) FROM codeintel_scip_layers) + (SELECT COUNT(*) FROM codeintel_scip_document_tombstones)"))
+		if err != nil {
+			t.Fatalf("unexpected error counting layers: %s", err)
+		}
+		if numRows != 0 {
+			t.Errorf("unexpected number of layer and tombstone rows. want=%d have=%d", 0, numRows)
+		}
+	})
 }
 
 func TestDeleteAbandonedSchemaVersionsRecords(t *testing.T) {
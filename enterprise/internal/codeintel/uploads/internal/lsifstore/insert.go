@@ -78,6 +78,92 @@ INSERT INTO codeintel_scip_metadata (upload_id, text_document_encoding, tool_nam
 VALUES (%s, %s, %s, %s, %s, %s)
 `
 
+// MaxLayerDepth is the maximum number of partial uploads that can be stacked on top of a complete
+// upload. Resolving a document may visit every layer, so longer chains must be cut off by uploading
+// a complete index.
+const MaxLayerDepth = 32
+
+// InsertLayer records the given upload as a partial index layered on top of the given base upload.
+// Documents of the layers below with one of the given paths are hidden from the new layer. The
+// number of layers below the new layer is returned.
+func (s *store) InsertLayer(ctx context.Context, uploadID, baseUploadID int, tombstonedPaths []string) (_ int, err error) {
+	ctx, _, endObservation := s.operations.insertLayer.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.Int("baseUploadID", baseUploadID),
+		attribute.Int("numTombstonedPaths", len(tombstonedPaths)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if !s.db.InTransaction() {
+		return 0, errors.New("InsertLayer must be called in a transaction")
+	}
+
+	depth, ok, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(
+		insertLayerQuery,
+		baseUploadID,
+		baseUploadID,
+		uploadID,
+		baseUploadID,
+	)))
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.Newf("base upload %d has no processed data", baseUploadID)
+	}
+	if depth > MaxLayerDepth {
+		return 0, errors.Newf("base upload %d is already %d layers deep; upload a complete index instead", baseUploadID, depth-1)
+	}
+
+	if err := batch.InsertValues(
+		ctx,
+		s.db.Handle(),
+		"codeintel_scip_document_tombstones",
+		batch.MaxNumPostgresParameters,
+		[]string{"upload_id", "document_path"},
+		loadTombstonesChannel(uploadID, tombstonedPaths),
+	); err != nil {
+		return 0, err
+	}
+
+	return depth, nil
+}
+
+const insertLayerQuery = `
+WITH
+-- Lock the base upload's metadata so that its data cannot be deleted before the new
+-- layer becomes visible (see DeleteLsifDataByUploadIds)
+base_metadata AS (
+	SELECT m.upload_id
+	FROM codeintel_scip_metadata m
+	WHERE m.upload_id = %s
+	LIMIT 1
+	FOR SHARE
+),
+base_layer AS (
+	SELECT COALESCE((SELECT sl.depth FROM codeintel_scip_layers sl WHERE sl.upload_id = %s), 0) AS depth
+	FROM base_metadata
+)
+INSERT INTO codeintel_scip_layers (upload_id, base_upload_id, depth)
+SELECT %s, %s, bl.depth + 1
+FROM base_layer bl
+RETURNING depth
+`
+
+func loadTombstonesChannel(uploadID int, paths []string) <-chan []any {
+	ch := make(chan []any, len(paths))
+
+	go func() {
+		defer close(ch)
+
+		for _, path := range paths {
+			ch <- []any{uploadID, path}
+		}
+	}()
+
+	return ch
+}
+
 func (s *store) NewSCIPWriter(ctx context.Context, uploadID int) (SCIPWriter, error) {
 	if !s.db.InTransaction() {
 		return nil, errors.New("WriteSCIPSymbols must be called in a transaction")
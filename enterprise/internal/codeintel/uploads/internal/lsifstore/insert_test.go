@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 
@@ -30,6 +31,59 @@ func TestInsertMetadata(t *testing.T) {
 	}
 }
 
+func TestInsertLayer(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	for _, uploadID := range []int{41, 42, 43} {
+		if err := store.InsertMetadata(ctx, uploadID, ProcessedMetadata{ToolName: "scip-test", ProtocolVersion: 1}); err != nil {
+			t.Fatalf("failed to insert metadata: %s", err)
+		}
+	}
+
+	insertLayer := func(uploadID, baseUploadID int, tombstonedPaths []string) (depth int, err error) {
+		err = store.WithTransaction(ctx, func(tx Store) (err error) {
+			depth, err = tx.InsertLayer(ctx, uploadID, baseUploadID, tombstonedPaths)
+			return err
+		})
+		return depth, err
+	}
+
+	if depth, err := insertLayer(42, 41, []string{"a.go", "b.go"}); err != nil {
+		t.Fatalf("failed to insert layer: %s", err)
+	} else if depth != 1 {
+		t.Errorf("unexpected depth. want=%d have=%d", 1, depth)
+	}
+	if depth, err := insertLayer(43, 42, nil); err != nil {
+		t.Fatalf("failed to insert layer: %s", err)
+	} else if depth != 2 {
+		t.Errorf("unexpected depth. want=%d have=%d", 2, depth)
+	}
+
+	paths, err := basestore.ScanStrings(codeIntelDB.QueryContext(ctx, "SELECT document_path FROM codeintel_scip_document_tombstones WHERE upload_id = 42 ORDER BY document_path"))
+	if err != nil {
+		t.Fatalf("failed to query tombstones: %s", err)
+	}
+	if diff := cmp.Diff([]string{"a.go", "b.go"}, paths); diff != "" {
+		t.Errorf("unexpected tombstoned paths (-want +got):\n%s", diff)
+	}
+
+	// Base upload without processed data
+	if _, err := insertLayer(44, 40, nil); err == nil {
+		t.Errorf("expected error inserting layer on top of missing upload")
+	}
+
+	// Base upload at the maximum depth
+	if _, err := codeIntelDB.ExecContext(ctx, "UPDATE codeintel_scip_layers SET depth = $1 WHERE upload_id = 43", MaxLayerDepth); err != nil {
+		t.Fatalf("failed to update depth: %s", err)
+	}
+	if _, err := insertLayer(44, 43, nil); err == nil {
+		t.Errorf("expected error inserting layer beyond the maximum depth")
+	}
+}
+
 func TestInsertSharedDocumentsConcurrently(t *testing.T) {
 	logger := logtest.Scoped(t)
 	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
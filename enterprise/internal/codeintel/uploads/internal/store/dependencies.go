@@ -150,6 +150,60 @@ SELECT %s, source.scheme, source.manager, source.name, source.version
 FROM t_lsif_references source
 `
 
+// InsertPackagesFromUpload attaches the package and package reference data of the given source upload
+// to the given upload, skipping packages and references already attached to it.
+func (s *store) InsertPackagesFromUpload(ctx context.Context, dumpID, sourceDumpID int) (err error) {
+	ctx, _, endObservation := s.operations.insertPackagesFromUpload.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("dumpID", dumpID),
+		attribute.Int("sourceDumpID", sourceDumpID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.withTransaction(ctx, func(tx *store) error {
+		if err := tx.db.Exec(ctx, sqlf.Sprintf(insertPackagesFromUploadQuery, dumpID, sourceDumpID, dumpID)); err != nil {
+			return err
+		}
+
+		return tx.db.Exec(ctx, sqlf.Sprintf(insertPackageReferencesFromUploadQuery, dumpID, sourceDumpID, dumpID))
+	})
+}
+
+const insertPackagesFromUploadQuery = `
+INSERT INTO lsif_packages (dump_id, scheme, manager, name, version)
+SELECT %s, source.scheme, source.manager, source.name, source.version
+FROM lsif_packages source
+WHERE
+	source.dump_id = %s AND
+	NOT EXISTS (
+		SELECT 1
+		FROM lsif_packages p
+		WHERE
+			p.dump_id = %s AND
+			p.scheme = source.scheme AND
+			p.manager = source.manager AND
+			p.name = source.name AND
+			p.version IS NOT DISTINCT FROM source.version
+	)
+`
+
+const insertPackageReferencesFromUploadQuery = `
+INSERT INTO lsif_references (dump_id, scheme, manager, name, version)
+SELECT %s, source.scheme, source.manager, source.name, source.version
+FROM lsif_references source
+WHERE
+	source.dump_id = %s AND
+	NOT EXISTS (
+		SELECT 1
+		FROM lsif_references r
+		WHERE
+			r.dump_id = %s AND
+			r.scheme = source.scheme AND
+			r.manager = source.manager AND
+			r.name = source.name AND
+			r.version IS NOT DISTINCT FROM source.version
+	)
+`
+
 func loadReferencesChannel(references []precise.PackageReference) <-chan []any {
 	ch := make(chan []any, len(references))
 
@@ -69,7 +69,8 @@ type operations struct {
 	deleteOverlappingDumps             *observation.Operation
 
 	// Packages
-	updatePackages *observation.Operation
+	updatePackages           *observation.Operation
+	insertPackagesFromUpload *observation.Operation
 
 	// References
 	updatePackageReferences *observation.Operation
@@ -176,7 +177,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		deleteOverlappingDumps:             op("DeleteOverlappingDumps"),
 
 		// Packages
-		updatePackages: op("UpdatePackages"),
+		updatePackages:           op("UpdatePackages"),
+		insertPackagesFromUpload: op("InsertPackagesFromUpload"),
 
 		// References
 		updatePackageReferences: op("UpdatePackageReferences"),
@@ -56,6 +56,7 @@ type Store interface {
 	ReferencesForUpload(ctx context.Context, uploadID int) (shared.PackageReferenceScanner, error)
 	UpdatePackages(ctx context.Context, dumpID int, packages []precise.Package) error
 	UpdatePackageReferences(ctx context.Context, dumpID int, references []precise.PackageReference) error
+	InsertPackagesFromUpload(ctx context.Context, dumpID, sourceDumpID int) error
 
 	// Summary
 	GetIndexers(ctx context.Context, opts shared.GetIndexersOptions) ([]string, error)
@@ -0,0 +1,42 @@
+package shared
+
+// SCIPLayersCTE selects the layers of each of the given uploads. Partial uploads only store the
+// documents that changed since the upload they are layered on top of. The layers of an upload
+// are the upload itself at depth zero, followed by the chain of uploads below it. It must be used
+// within a WITH RECURSIVE clause and is parameterized by an array of upload identifiers.
+const SCIPLayersCTE = `
+layers(upload_id, layer_id, depth) AS (
+	SELECT u.id, u.id, 0
+	FROM unnest(%s::integer[]) AS u(id)
+
+	UNION ALL
+
+	SELECT l.upload_id, sl.base_upload_id, l.depth + 1
+	FROM layers l
+	JOIN codeintel_scip_layers sl ON sl.upload_id = l.layer_id
+)
+`
+
+// SCIPVisibleDocumentCondition holds for the document lookup row sid of the layer l selected by
+// SCIPLayersCTE if no newer layer of the same upload replaces the document or records it as
+// deleted or stale.
+const SCIPVisibleDocumentCondition = `
+NOT EXISTS (
+	SELECT 1
+	FROM layers nl
+	WHERE
+		nl.upload_id = l.upload_id AND
+		nl.depth < l.depth AND (
+			EXISTS (
+				SELECT 1
+				FROM codeintel_scip_document_lookup nsid
+				WHERE nsid.upload_id = nl.layer_id AND nsid.document_path = sid.document_path
+			) OR
+			EXISTS (
+				SELECT 1
+				FROM codeintel_scip_document_tombstones t
+				WHERE t.upload_id = nl.layer_id AND t.document_path = sid.document_path
+			)
+		)
+)
+`
@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/executor"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	executorlib "github.com/sourcegraph/sourcegraph/lib/executor"
 )
 
 type Upload struct {
@@ -35,6 +36,7 @@ type Upload struct {
 	AssociatedIndexID *int
 	ContentType       string
 	ShouldReindex     bool
+	BaseUploadID      *int
 }
 
 func (u Upload) RecordID() int {
@@ -109,6 +111,7 @@ type Index struct {
 	AssociatedUploadID *int                         `json:"associatedUpload"`
 	ShouldReindex      bool                         `json:"shouldReindex"`
 	RequestedEnvVars   []string                     `json:"requestedEnvVars"`
+	IndexerResources   *executorlib.StepResources   `json:"indexerResources"`
 }
 
 func (i Index) RecordID() int {
@@ -120,9 +123,10 @@ func (i Index) RecordUID() string {
 }
 
 type DockerStep struct {
-	Root     string   `json:"root"`
-	Image    string   `json:"image"`
-	Commands []string `json:"commands"`
+	Root      string                     `json:"root"`
+	Image     string                     `json:"image"`
+	Commands  []string                   `json:"commands"`
+	Resources *executorlib.StepResources `json:"resources,omitempty"`
 }
 
 func (s *DockerStep) Scan(value any) error {
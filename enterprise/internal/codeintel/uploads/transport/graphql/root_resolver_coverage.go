@@ -77,6 +77,7 @@ func (r *rootResolver) RepositorySummary(ctx context.Context, repoID graphql.ID)
 	}
 
 	var limitErr error
+	var inferenceScriptErr error
 	inferredAvailableIndexers := map[string]uploadsShared.AvailableIndexer{}
 
 	if autoIndexingEnabled() {
@@ -99,6 +100,7 @@ func (r *rootResolver) RepositorySummary(ctx context.Context, repoID graphql.ID)
 			// 	limitErr = errors.Append(limitErr, err)
 			// }
 
+			inferenceScriptErr = result.RepositoryScriptError
 			inferredAvailableIndexers = uploadsShared.PopulateInferredAvailableIndexers(result.IndexJobs, blocklist, inferredAvailableIndexers)
 			// inferredAvailableIndexers = uploadsShared.PopulateInferredAvailableIndexers(indexJobHints, blocklist, inferredAvailableIndexers)
 		}
@@ -147,6 +149,7 @@ func (r *rootResolver) RepositorySummary(ctx context.Context, repoID graphql.ID)
 		summary,
 		inferredAvailableIndexersResolver,
 		limitErr,
+		inferenceScriptErr,
 		uploadLoader,
 		indexLoader,
 		errTracer,
@@ -318,6 +321,7 @@ type repositorySummaryResolver struct {
 	summary                     RepositorySummary
 	availableIndexers           []inferredAvailableIndexers2
 	limitErr                    error
+	inferenceScriptErr          error
 	uploadLoader                UploadLoader
 	indexLoader                 IndexLoader
 	locationResolver            *gitresolvers.CachedLocationResolver
@@ -335,6 +339,7 @@ func newRepositorySummaryResolver(
 	summary RepositorySummary,
 	availableIndexers []inferredAvailableIndexers2,
 	limitErr error,
+	inferenceScriptErr error,
 	uploadLoader UploadLoader,
 	indexLoader IndexLoader,
 	errTracer *observation.ErrCollector,
@@ -344,6 +349,7 @@ func newRepositorySummaryResolver(
 		summary:                     summary,
 		availableIndexers:           availableIndexers,
 		limitErr:                    limitErr,
+		inferenceScriptErr:          inferenceScriptErr,
 		uploadLoader:                uploadLoader,
 		indexLoader:                 indexLoader,
 		locationResolver:            locationResolver,
@@ -416,6 +422,15 @@ func (r *repositorySummaryResolver) LimitError() *string {
 	return nil
 }
 
+func (r *repositorySummaryResolver) InferenceScriptError() *string {
+	if r.inferenceScriptErr != nil {
+		m := r.inferenceScriptErr.Error()
+		return &m
+	}
+
+	return nil
+}
+
 //
 //
 
@@ -0,0 +1,80 @@
+package background
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// APIDiffer compares the public API of precise code intelligence uploads. It is
+// satisfied by *codenav.Service.
+type APIDiffer interface {
+	GetClosestDumpsForBlob(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) ([]uploadsshared.Dump, error)
+	DiffAPIs(ctx context.Context, base, head uploadsshared.Dump) ([]codenav.APIChange, error)
+}
+
+// filterAPIChanges returns the commit matches that change the public API of
+// their repository. A commit changes the API if one of the uploads for that
+// commit differs from the upload of the same root and indexer visible from its
+// first parent. Commits without a processed upload of their own, or without a
+// comparable upload at their parent, are dropped.
+func filterAPIChanges(ctx context.Context, differ APIDiffer, matches []*result.CommitMatch) ([]*result.CommitMatch, error) {
+	filtered := matches[:0]
+	for _, match := range matches {
+		ok, err := changesAPI(ctx, differ, match)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, match)
+		}
+	}
+
+	return filtered, nil
+}
+
+func changesAPI(ctx context.Context, differ APIDiffer, match *result.CommitMatch) (bool, error) {
+	if len(match.Commit.Parents) == 0 {
+		return false, nil
+	}
+	repositoryID := int(match.Repo.ID)
+	commit := string(match.Commit.ID)
+	parent := string(match.Commit.Parents[0])
+
+	heads, err := differ.GetClosestDumpsForBlob(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return false, errors.Wrap(err, "GetClosestDumpsForBlob")
+	}
+
+	for _, head := range heads {
+		if head.Commit != commit {
+			// Only the uploads of the matched commit itself describe its API
+			continue
+		}
+
+		bases, err := differ.GetClosestDumpsForBlob(ctx, repositoryID, parent, head.Root, false, head.Indexer)
+		if err != nil {
+			return false, errors.Wrap(err, "GetClosestDumpsForBlob")
+		}
+
+		for _, base := range bases {
+			if base.Root != head.Root {
+				continue
+			}
+
+			changes, err := differ.DiffAPIs(ctx, base, head)
+			if err != nil {
+				return false, errors.Wrap(err, "DiffAPIs")
+			}
+			if len(changes) > 0 {
+				return true, nil
+			}
+			break
+		}
+	}
+
+	return false, nil
+}
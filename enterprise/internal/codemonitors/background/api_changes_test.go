@@ -0,0 +1,68 @@
+package background
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeAPIDiffer struct {
+	dumps   map[string][]uploadsshared.Dump
+	changed map[int]bool
+}
+
+func (d *fakeAPIDiffer) GetClosestDumpsForBlob(_ context.Context, _ int, commit, _ string, _ bool, _ string) ([]uploadsshared.Dump, error) {
+	return d.dumps[commit], nil
+}
+
+func (d *fakeAPIDiffer) DiffAPIs(_ context.Context, _, head uploadsshared.Dump) ([]codenav.APIChange, error) {
+	if d.changed[head.ID] {
+		return []codenav.APIChange{{Kind: codenav.APIChangeKindAdded, Name: "Foo"}}, nil
+	}
+	return nil, nil
+}
+
+func TestFilterAPIChanges(t *testing.T) {
+	match := func(commit string, parents ...string) *result.CommitMatch {
+		parentIDs := []api.CommitID{}
+		for _, parent := range parents {
+			parentIDs = append(parentIDs, api.CommitID(parent))
+		}
+
+		return &result.CommitMatch{
+			Commit: gitdomain.Commit{ID: api.CommitID(commit), Parents: parentIDs},
+			Repo:   types.MinimalRepo{ID: 42},
+		}
+	}
+
+	differ := &fakeAPIDiffer{
+		dumps: map[string][]uploadsshared.Dump{
+			"base":      {{ID: 1, Commit: "base", Root: "lib/"}},
+			"changed":   {{ID: 2, Commit: "changed", Root: "lib/"}},
+			"unchanged": {{ID: 3, Commit: "unchanged", Root: "lib/"}},
+			// The closest upload belongs to an ancestor
+			"unindexed": {{ID: 4, Commit: "base", Root: "lib/"}},
+		},
+		changed: map[int]bool{2: true, 4: true},
+	}
+
+	matches := []*result.CommitMatch{
+		match("changed", "base"),
+		match("unchanged", "base"),
+		match("unindexed", "base"),
+		match("root"),
+	}
+
+	filtered, err := filterAPIChanges(context.Background(), differ, matches)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, api.CommitID("changed"), filtered[0].Commit.ID)
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 )
 
-func NewBackgroundJobs(observationCtx *observation.Context, db edb.EnterpriseDB, enterpriseJobs jobutil.EnterpriseJobs) []goroutine.BackgroundRoutine {
+func NewBackgroundJobs(observationCtx *observation.Context, db edb.EnterpriseDB, enterpriseJobs jobutil.EnterpriseJobs, apiDiffer APIDiffer) []goroutine.BackgroundRoutine {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("BackgroundJobs", "code monitors background jobs"), observationCtx)
 
 	codeMonitorsStore := db.CodeMonitors()
@@ -23,7 +23,7 @@ func NewBackgroundJobs(observationCtx *observation.Context, db edb.EnterpriseDB,
 	return []goroutine.BackgroundRoutine{
 		newTriggerQueryEnqueuer(ctx, codeMonitorsStore),
 		newTriggerJobsLogDeleter(ctx, codeMonitorsStore),
-		newTriggerQueryRunner(ctx, scopedContext("TriggerQueryRunner", observationCtx), db, enterpriseJobs, triggerMetrics),
+		newTriggerQueryRunner(ctx, scopedContext("TriggerQueryRunner", observationCtx), db, enterpriseJobs, apiDiffer, triggerMetrics),
 		newTriggerQueryResetter(ctx, scopedContext("TriggerQueryResetter", observationCtx), codeMonitorsStore, triggerMetrics),
 		newActionRunner(ctx, scopedContext("ActionRunner", observationCtx), codeMonitorsStore, actionMetrics),
 		newActionJobResetter(ctx, scopedContext("ActionJobResetter", observationCtx), codeMonitorsStore, actionMetrics),
@@ -29,7 +29,7 @@ const (
 	eventRetentionInDays int = 30
 )
 
-func newTriggerQueryRunner(ctx context.Context, observationCtx *observation.Context, db edb.EnterpriseDB, enterpriseJobs jobutil.EnterpriseJobs, metrics codeMonitorsMetrics) *workerutil.Worker[*edb.TriggerJob] {
+func newTriggerQueryRunner(ctx context.Context, observationCtx *observation.Context, db edb.EnterpriseDB, enterpriseJobs jobutil.EnterpriseJobs, apiDiffer APIDiffer, metrics codeMonitorsMetrics) *workerutil.Worker[*edb.TriggerJob] {
 	options := workerutil.WorkerOptions{
 		Name:                 "code_monitors_trigger_jobs_worker",
 		Description:          "runs trigger queries for code monitors",
@@ -42,7 +42,7 @@ func newTriggerQueryRunner(ctx context.Context, observationCtx *observation.Cont
 
 	store := createDBWorkerStoreForTriggerJobs(observationCtx, db)
 
-	worker := dbworker.NewWorker[*edb.TriggerJob](ctx, store, &queryRunner{db: db, enterpriseJobs: enterpriseJobs}, options)
+	worker := dbworker.NewWorker[*edb.TriggerJob](ctx, store, &queryRunner{db: db, enterpriseJobs: enterpriseJobs, apiDiffer: apiDiffer}, options)
 	return worker
 }
 
@@ -155,6 +155,7 @@ func createDBWorkerStoreForActionJobs(observationCtx *observation.Context, s edb
 type queryRunner struct {
 	db             edb.EnterpriseDB
 	enterpriseJobs jobutil.EnterpriseJobs
+	apiDiffer      APIDiffer
 }
 
 func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob *edb.TriggerJob) (err error) {
@@ -196,6 +197,13 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 		return errors.Wrap(searchErr, "execute search")
 	}
 
+	if q.APIChangesOnly {
+		results, err = filterAPIChanges(ctx, r.apiDiffer, results)
+		if err != nil {
+			return errors.Wrap(err, "filterAPIChanges")
+		}
+	}
+
 	// Log the actual query we ran and whether we got any new results.
 	err = cm.UpdateTriggerJobWithResults(ctx, triggerJob.ID, q.QueryString, results)
 	if err != nil {
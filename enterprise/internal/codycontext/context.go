@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeinteltypes "github.com/sourcegraph/sourcegraph/internal/codeintel/types"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -34,9 +35,15 @@ type FileChunkContext struct {
 	Path      string
 	StartLine int
 	EndLine   int
+	// Symbol is the name of the symbol enclosing the chunk, if known.
+	Symbol string
 }
 
-func NewCodyContextClient(obsCtx *observation.Context, db edb.EnterpriseDB, embeddingsClient embeddings.Client, searchClient client.SearchClient) *CodyContextClient {
+type RankingService interface {
+	GetDocumentRanks(ctx context.Context, repoName api.RepoName) (codeinteltypes.RepoPathRanks, error)
+}
+
+func NewCodyContextClient(obsCtx *observation.Context, db edb.EnterpriseDB, embeddingsClient embeddings.Client, searchClient client.SearchClient, rankingService RankingService) *CodyContextClient {
 	redMetrics := metrics.NewREDMetrics(
 		obsCtx.Registerer,
 		"codycontext_client",
@@ -58,11 +65,14 @@ func NewCodyContextClient(obsCtx *observation.Context, db edb.EnterpriseDB, embe
 		db:               db,
 		embeddingsClient: embeddingsClient,
 		searchClient:     searchClient,
+		rankingService:   rankingService,
+		hybridOptions:    DefaultHybridOptions,
 
 		obsCtx:                 obsCtx,
 		getCodyContextOp:       op("getCodyContext"),
 		getEmbeddingsContextOp: op("getEmbeddingsContext"),
 		getKeywordContextOp:    op("getKeywordContext"),
+		getHybridContextOp:     op("getHybridContext"),
 	}
 }
 
@@ -70,11 +80,14 @@ type CodyContextClient struct {
 	db               edb.EnterpriseDB
 	embeddingsClient embeddings.Client
 	searchClient     client.SearchClient
+	rankingService   RankingService
+	hybridOptions    HybridOptions
 
 	obsCtx                 *observation.Context
 	getCodyContextOp       *observation.Operation
 	getEmbeddingsContextOp *observation.Operation
 	getKeywordContextOp    *observation.Operation
+	getHybridContextOp     *observation.Operation
 }
 
 type GetContextArgs struct {
@@ -97,6 +110,10 @@ func (c *CodyContextClient) GetCodyContext(ctx context.Context, args GetContextA
 	ctx, _, endObservation := c.getCodyContextOp.With(ctx, &err, observation.Args{Attrs: args.Attrs()})
 	defer endObservation(1, observation.Args{})
 
+	if isHybridRetrievalEnabled(ctx) {
+		return c.getHybridContext(ctx, args)
+	}
+
 	embeddingRepos, keywordRepos, err := c.partitionRepos(ctx, args.Repos)
 	if err != nil {
 		return nil, err
@@ -165,9 +182,18 @@ func (c *CodyContextClient) getEmbeddingsContext(ctx context.Context, args GetCo
 	ctx, _, endObservation := c.getEmbeddingsContextOp.With(ctx, &err, observation.Args{Attrs: args.Attrs()})
 	defer endObservation(1, observation.Args{})
 
+	codeResults, textResults, err := c.searchEmbeddings(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return append(codeResults, textResults...), nil
+}
+
+// searchEmbeddings returns the ranked code and text results of an embeddings search.
+func (c *CodyContextClient) searchEmbeddings(ctx context.Context, args GetContextArgs) (codeResults, textResults []FileChunkContext, err error) {
 	if len(args.Repos) == 0 || (args.CodeResultsCount == 0 && args.TextResultsCount == 0) {
 		// Don't bother doing an API request if we can't actually have any results.
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	repoNames := make([]api.RepoName, len(args.Repos))
@@ -185,7 +211,7 @@ func (c *CodyContextClient) getEmbeddingsContext(ctx context.Context, args GetCo
 		TextResultsCount: int(args.TextResultsCount),
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	idsByName := make(map[api.RepoName]api.RepoID)
@@ -193,18 +219,23 @@ func (c *CodyContextClient) getEmbeddingsContext(ctx context.Context, args GetCo
 		idsByName[repoName] = repoIDs[i]
 	}
 
-	res := make([]FileChunkContext, 0, len(results.CodeResults)+len(results.TextResults))
-	for _, result := range append(results.CodeResults, results.TextResults...) {
-		res = append(res, FileChunkContext{
-			RepoName:  result.RepoName,
-			RepoID:    idsByName[result.RepoName],
-			CommitID:  result.Revision,
-			Path:      result.FileName,
-			StartLine: result.StartLine,
-			EndLine:   result.EndLine,
-		})
+	toContext := func(results []embeddings.EmbeddingSearchResult) []FileChunkContext {
+		res := make([]FileChunkContext, 0, len(results))
+		for _, result := range results {
+			res = append(res, FileChunkContext{
+				RepoName:  result.RepoName,
+				RepoID:    idsByName[result.RepoName],
+				CommitID:  result.Revision,
+				Path:      result.FileName,
+				StartLine: result.StartLine,
+				EndLine:   result.EndLine,
+				Symbol:    result.Symbol,
+			})
+		}
+		return res
 	}
-	return res, nil
+
+	return toContext(results.CodeResults), toContext(results.TextResults), nil
 }
 
 var textFileFilter = func() string {
@@ -220,23 +251,27 @@ func (c *CodyContextClient) getKeywordContext(ctx context.Context, args GetConte
 	ctx, _, endObservation := c.getKeywordContextOp.With(ctx, &err, observation.Args{Attrs: args.Attrs()})
 	defer endObservation(1, observation.Args{})
 
+	codeResults, textResults, err := c.searchKeyword(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return append(codeResults, textResults...), nil
+}
+
+// searchKeyword returns the ranked code and text results of a keyword search.
+func (c *CodyContextClient) searchKeyword(ctx context.Context, args GetContextArgs) (codeResults, textResults []FileChunkContext, err error) {
 	if len(args.Repos) == 0 {
 		// TODO(camdencheek): for some reason the search query `repo:^$`
 		// returns all repos, not zero repos, causing searches over zero repos
 		// to break in unexpected ways.
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	// mini-HACK: pass in the scope using repo: filters. In an ideal world, we
-	// would not be using query text manipulation for this and would be using
-	// the job structs directly.
-	regexEscapedRepoNames := make([]string, len(args.Repos))
+	repoNames := make([]api.RepoName, len(args.Repos))
 	for i, repo := range args.Repos {
-		regexEscapedRepoNames[i] = regexp.QuoteMeta(string(repo.Name))
+		repoNames[i] = repo.Name
 	}
-
-	textQuery := fmt.Sprintf(`repo:^%s$ %s content:%s`, query.UnionRegExps(regexEscapedRepoNames), textFileFilter, strconv.Quote(args.Query))
-	codeQuery := fmt.Sprintf(`repo:^%s$ -%s content:%s`, query.UnionRegExps(regexEscapedRepoNames), textFileFilter, strconv.Quote(args.Query))
+	codeQuery, textQuery := KeywordQueries(repoNames, args.Query)
 
 	doSearch := func(ctx context.Context, query string, limit int) ([]FileChunkContext, error) {
 		if limit == 0 {
@@ -302,10 +337,26 @@ func (c *CodyContextClient) getKeywordContext(ctx context.Context, args GetConte
 	})
 	results, err := p.Wait()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	return results[0], results[1], nil
+}
+
+// KeywordQueries returns the keyword search queries for code and text files that gather
+// context for the given query from the given repositories.
+func KeywordQueries(repoNames []api.RepoName, q string) (codeQuery, textQuery string) {
+	// mini-HACK: pass in the scope using repo: filters. In an ideal world, we
+	// would not be using query text manipulation for this and would be using
+	// the job structs directly.
+	regexEscapedRepoNames := make([]string, len(repoNames))
+	for i, repoName := range repoNames {
+		regexEscapedRepoNames[i] = regexp.QuoteMeta(string(repoName))
 	}
 
-	return append(results[0], results[1]...), nil
+	codeQuery = fmt.Sprintf(`repo:^%s$ -%s content:%s`, query.UnionRegExps(regexEscapedRepoNames), textFileFilter, strconv.Quote(q))
+	textQuery = fmt.Sprintf(`repo:^%s$ %s content:%s`, query.UnionRegExps(regexEscapedRepoNames), textFileFilter, strconv.Quote(q))
+	return codeQuery, textQuery
 }
 
 func fileMatchToContextMatches(fm *result.FileMatch) []FileChunkContext {
@@ -0,0 +1,92 @@
+package context
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeinteltypes "github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// LabelledQuery is a query with the paths of the files that are relevant to it, along with
+// the results each retriever returned for the query when it was recorded. Recorded results
+// allow evaluating different fusion options offline, without access to the search and
+// embeddings services. Use `sg cody-context-qa` to record and evaluate real results.
+type LabelledQuery struct {
+	Query         string                                        `json:"query"`
+	RelevantPaths []string                                      `json:"relevantPaths"`
+	Embeddings    []FileChunkContext                            `json:"embeddings"`
+	Keyword       []FileChunkContext                            `json:"keyword"`
+	Ranks         map[api.RepoName]codeinteltypes.RepoPathRanks `json:"ranks,omitempty"`
+}
+
+// LoadLabelledQueries reads a JSON array of labelled queries.
+func LoadLabelledQueries(r io.Reader) ([]LabelledQuery, error) {
+	var queries []LabelledQuery
+	if err := json.NewDecoder(r).Decode(&queries); err != nil {
+		return nil, errors.Wrap(err, "decoding labelled queries")
+	}
+	return queries, nil
+}
+
+// RetrievalMetrics summarizes the quality of the results of a retriever over a set of
+// labelled queries.
+type RetrievalMetrics struct {
+	// Recall is the mean fraction of relevant paths found in the top k results.
+	Recall float64
+	// MRR is the mean reciprocal rank of the first relevant result in the top k results.
+	MRR float64
+}
+
+type Evaluation struct {
+	Embeddings RetrievalMetrics
+	Keyword    RetrievalMetrics
+	Hybrid     RetrievalMetrics
+}
+
+// Evaluate compares the top k results of embeddings search, keyword search, and their
+// fusion with the given options over the labelled queries.
+func Evaluate(queries []LabelledQuery, k int, opts HybridOptions) Evaluation {
+	var evaluation Evaluation
+	if len(queries) == 0 {
+		return evaluation
+	}
+
+	for _, q := range queries {
+		hybrid := fuseResults(q.Embeddings, q.Keyword, q.Ranks, opts)
+
+		addMetrics(&evaluation.Embeddings, q, truncate(q.Embeddings, k))
+		addMetrics(&evaluation.Keyword, q, truncate(q.Keyword, k))
+		addMetrics(&evaluation.Hybrid, q, truncate(hybrid, k))
+	}
+
+	for _, metrics := range []*RetrievalMetrics{&evaluation.Embeddings, &evaluation.Keyword, &evaluation.Hybrid} {
+		metrics.Recall /= float64(len(queries))
+		metrics.MRR /= float64(len(queries))
+	}
+
+	return evaluation
+}
+
+func addMetrics(metrics *RetrievalMetrics, q LabelledQuery, results []FileChunkContext) {
+	relevant := make(map[string]struct{}, len(q.RelevantPaths))
+	for _, path := range q.RelevantPaths {
+		relevant[path] = struct{}{}
+	}
+
+	found := map[string]struct{}{}
+	for i, result := range results {
+		if _, ok := relevant[result.Path]; !ok {
+			continue
+		}
+		if len(found) == 0 {
+			metrics.MRR += 1 / float64(i+1)
+		}
+		found[result.Path] = struct{}{}
+	}
+
+	if len(relevant) > 0 {
+		metrics.Recall += float64(len(found)) / float64(len(relevant))
+	}
+}
@@ -0,0 +1,38 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluate(t *testing.T) {
+	chunk := func(path string) FileChunkContext {
+		return FileChunkContext{RepoName: "repo", Path: path}
+	}
+
+	queries := []LabelledQuery{
+		{
+			Query:         "first",
+			RelevantPaths: []string{"a.go", "b.go"},
+			Embeddings:    []FileChunkContext{chunk("x.go"), chunk("a.go"), chunk("b.go")},
+			Keyword:       []FileChunkContext{chunk("a.go"), chunk("y.go")},
+		},
+		{
+			Query:         "second",
+			RelevantPaths: []string{"c.go"},
+			Embeddings:    []FileChunkContext{chunk("z.go")},
+			Keyword:       []FileChunkContext{chunk("c.go")},
+		},
+	}
+
+	evaluation := Evaluate(queries, 2, DefaultHybridOptions)
+
+	// Only the top 2 results count: embeddings misses b.go for the first query.
+	if diff := cmp.Diff(RetrievalMetrics{Recall: 0.25, MRR: 0.25}, evaluation.Embeddings); diff != "" {
+		t.Errorf("unexpected embeddings metrics (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(RetrievalMetrics{Recall: 0.75, MRR: 1}, evaluation.Keyword); diff != "" {
+		t.Errorf("unexpected keyword metrics (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,229 @@
+package context
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/conc/pool"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeinteltypes "github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// hybridRetrievalFeatureFlag enables fusing keyword and embeddings search results for
+// Cody context instead of partitioning the result budget between them.
+const hybridRetrievalFeatureFlag = "cody-context-hybrid-retrieval"
+
+// hybridCandidatesMultiplier is the factor by which the number of requested results is
+// increased for each retriever, so that results ranked highly by only one of them still
+// make it into the fused results.
+const hybridCandidatesMultiplier = 2
+
+type HybridOptions struct {
+	// K dampens the contribution of the top ranked results of each retriever. Larger
+	// values make the fused ranking depend less on the exact position of a result.
+	K float64
+	// EmbeddingsWeight and KeywordWeight scale the contribution of each retriever.
+	EmbeddingsWeight float64
+	KeywordWeight    float64
+	// RankWeight scales the boost given to results in files with a high path rank.
+	RankWeight float64
+}
+
+var DefaultHybridOptions = HybridOptions{
+	K:                60,
+	EmbeddingsWeight: 1,
+	KeywordWeight:    1,
+	RankWeight:       0.5,
+}
+
+// getHybridContext runs keyword search and embeddings search concurrently and combines
+// their results with reciprocal rank fusion.
+func (c *CodyContextClient) getHybridContext(ctx context.Context, args GetContextArgs) (_ []FileChunkContext, err error) {
+	ctx, _, endObservation := c.getHybridContextOp.With(ctx, &err, observation.Args{Attrs: args.Attrs()})
+	defer endObservation(1, observation.Args{})
+
+	embeddingRepos, _, err := c.partitionRepos(ctx, args.Repos)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := c.getHybridCandidates(ctx, args, embeddingRepos)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := c.getPathRanks(ctx, candidates)
+
+	code := fuseResults(candidates.embeddingsCode, candidates.keywordCode, ranks, c.hybridOptions)
+	text := fuseResults(candidates.embeddingsText, candidates.keywordText, ranks, c.hybridOptions)
+	return append(truncate(code, int(args.CodeResultsCount)), truncate(text, int(args.TextResultsCount))...), nil
+}
+
+type hybridCandidates struct {
+	embeddingsCode, embeddingsText []FileChunkContext
+	keywordCode, keywordText       []FileChunkContext
+}
+
+func (c *CodyContextClient) getHybridCandidates(ctx context.Context, args GetContextArgs, embeddingRepos []types.RepoIDName) (candidates hybridCandidates, err error) {
+	candidatesArgs := GetContextArgs{
+		Repos:            args.Repos,
+		Query:            args.Query,
+		CodeResultsCount: args.CodeResultsCount * hybridCandidatesMultiplier,
+		TextResultsCount: args.TextResultsCount * hybridCandidatesMultiplier,
+	}
+
+	embeddingsArgs := candidatesArgs
+	embeddingsArgs.Repos = embeddingRepos
+
+	p := pool.New().WithErrors()
+	p.Go(func() (err error) {
+		candidates.embeddingsCode, candidates.embeddingsText, err = c.searchEmbeddings(ctx, embeddingsArgs)
+		return err
+	})
+	p.Go(func() (err error) {
+		// Keyword search runs over all repos, including the ones with embeddings
+		candidates.keywordCode, candidates.keywordText, err = c.searchKeyword(ctx, candidatesArgs)
+		return err
+	})
+
+	err = p.Wait()
+	return candidates, err
+}
+
+// getPathRanks returns the path ranks of every repository with a candidate result. Ranks
+// only adjust the order of results, so failing to fetch them does not fail the request.
+func (c *CodyContextClient) getPathRanks(ctx context.Context, candidates hybridCandidates) map[api.RepoName]codeinteltypes.RepoPathRanks {
+	ranks := map[api.RepoName]codeinteltypes.RepoPathRanks{}
+	if c.rankingService == nil {
+		return ranks
+	}
+
+	for _, results := range [][]FileChunkContext{candidates.embeddingsCode, candidates.embeddingsText, candidates.keywordCode, candidates.keywordText} {
+		for _, result := range results {
+			if _, ok := ranks[result.RepoName]; ok {
+				continue
+			}
+
+			repoRanks, err := c.rankingService.GetDocumentRanks(ctx, result.RepoName)
+			if err != nil {
+				c.obsCtx.Logger.Warn("failed to get document ranks", log.String("repoName", string(result.RepoName)), log.Error(err))
+			}
+			ranks[result.RepoName] = repoRanks
+		}
+	}
+
+	return ranks
+}
+
+func isHybridRetrievalEnabled(ctx context.Context) bool {
+	return featureflag.FromContext(ctx).GetBoolOr(hybridRetrievalFeatureFlag, false)
+}
+
+type fusedResult struct {
+	FileChunkContext
+	score float64
+}
+
+// fuseResults combines the ranked embeddings and keyword results with reciprocal rank fusion.
+//
+// Each result contributes weight / (K + rank) to the score of its line range, where rank is
+// its one-based position in the results of its retriever. Results with overlapping line ranges
+// in the same file are merged into a single result whose score is the sum of their
+// contributions, so chunks found by both retrievers are ranked higher. Finally, each score is
+// boosted by the path rank of the file.
+func fuseResults(embeddingsResults, keywordResults []FileChunkContext, ranks map[api.RepoName]codeinteltypes.RepoPathRanks, opts HybridOptions) []FileChunkContext {
+	type fileKey struct {
+		repoName api.RepoName
+		path     string
+	}
+	byFile := map[fileKey][]fusedResult{}
+
+	for _, list := range []struct {
+		results []FileChunkContext
+		weight  float64
+	}{
+		{embeddingsResults, opts.EmbeddingsWeight},
+		{keywordResults, opts.KeywordWeight},
+	} {
+		for i, result := range list.results {
+			key := fileKey{result.RepoName, result.Path}
+			byFile[key] = append(byFile[key], fusedResult{
+				FileChunkContext: result,
+				score:            list.weight / (opts.K + float64(i+1)),
+			})
+		}
+	}
+
+	var fused []fusedResult
+	for key, results := range byFile {
+		merged := mergeOverlappingResults(results)
+
+		rankBoost := 1.0
+		if repoRanks, ok := ranks[key.repoName]; ok {
+			// Path ranks are log (base 2) counts which should be bounded at 32
+			rankBoost += opts.RankWeight * min64(repoRanks.Paths[key.path]/32, 1)
+		}
+		for i := range merged {
+			merged[i].score *= rankBoost
+		}
+
+		fused = append(fused, merged...)
+	}
+
+	sort.Slice(fused, func(i, j int) bool {
+		if fused[i].score != fused[j].score {
+			return fused[i].score > fused[j].score
+		}
+		if fused[i].RepoName != fused[j].RepoName {
+			return fused[i].RepoName < fused[j].RepoName
+		}
+		if fused[i].Path != fused[j].Path {
+			return fused[i].Path < fused[j].Path
+		}
+		return fused[i].StartLine < fused[j].StartLine
+	})
+
+	results := make([]FileChunkContext, 0, len(fused))
+	for _, result := range fused {
+		results = append(results, result.FileChunkContext)
+	}
+	return results
+}
+
+// mergeOverlappingResults merges results of a single file whose line ranges overlap. The
+// merged result spans the union of the line ranges and its score is the sum of their scores.
+func mergeOverlappingResults(results []fusedResult) []fusedResult {
+	sort.Slice(results, func(i, j int) bool { return results[i].StartLine < results[j].StartLine })
+
+	merged := results[:1]
+	for _, result := range results[1:] {
+		last := &merged[len(merged)-1]
+		if result.StartLine >= last.EndLine {
+			merged = append(merged, result)
+			continue
+		}
+
+		last.EndLine = max(last.EndLine, result.EndLine)
+		last.score += result.score
+		if last.Symbol == "" {
+			last.Symbol = result.Symbol
+		} else if result.Symbol != "" && result.Symbol != last.Symbol {
+			// A merged range that spans multiple symbols has no single enclosing symbol
+			last.Symbol = ""
+		}
+	}
+
+	return merged
+}
+
+func min64(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
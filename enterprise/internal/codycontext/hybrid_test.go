@@ -0,0 +1,74 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeinteltypes "github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+)
+
+func TestFuseResults(t *testing.T) {
+	chunk := func(path string, startLine, endLine int) FileChunkContext {
+		return FileChunkContext{RepoName: "repo", Path: path, StartLine: startLine, EndLine: endLine}
+	}
+
+	t.Run("results found by both retrievers rank first", func(t *testing.T) {
+		embeddings := []FileChunkContext{chunk("a.go", 0, 10), chunk("b.go", 0, 10)}
+		keyword := []FileChunkContext{chunk("c.go", 0, 10), chunk("b.go", 0, 10)}
+
+		results := fuseResults(embeddings, keyword, nil, DefaultHybridOptions)
+		expected := []FileChunkContext{chunk("b.go", 0, 10), chunk("a.go", 0, 10), chunk("c.go", 0, 10)}
+		if diff := cmp.Diff(expected, results); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("overlapping results are merged", func(t *testing.T) {
+		embeddings := []FileChunkContext{chunk("a.go", 0, 10), chunk("a.go", 20, 30)}
+		keyword := []FileChunkContext{chunk("a.go", 5, 15), chunk("a.go", 10, 12)}
+
+		results := fuseResults(embeddings, keyword, nil, DefaultHybridOptions)
+		expected := []FileChunkContext{chunk("a.go", 0, 15), chunk("a.go", 20, 30)}
+		if diff := cmp.Diff(expected, results); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("symbols of merged results", func(t *testing.T) {
+		first := chunk("a.go", 0, 10)
+		first.Symbol = "first"
+		second := chunk("a.go", 5, 20)
+		second.Symbol = "second"
+		unknown := chunk("b.go", 0, 10)
+		withSymbol := chunk("b.go", 0, 8)
+		withSymbol.Symbol = "main"
+
+		results := fuseResults([]FileChunkContext{first, withSymbol}, []FileChunkContext{second, unknown}, nil, DefaultHybridOptions)
+		expected := []FileChunkContext{chunk("a.go", 0, 20), unknown}
+		expected[1].Symbol = "main"
+		if diff := cmp.Diff(expected, results); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("path ranks boost results", func(t *testing.T) {
+		embeddings := []FileChunkContext{chunk("a.go", 0, 10), chunk("b.go", 0, 10)}
+		ranks := map[api.RepoName]codeinteltypes.RepoPathRanks{
+			"repo": {Paths: map[string]float64{"b.go": 32}},
+		}
+
+		results := fuseResults(embeddings, nil, ranks, DefaultHybridOptions)
+		expected := []FileChunkContext{chunk("b.go", 0, 10), chunk("a.go", 0, 10)}
+		if diff := cmp.Diff(expected, results); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+
+		results = fuseResults(embeddings, nil, ranks, HybridOptions{K: 60, EmbeddingsWeight: 1})
+		expected = []FileChunkContext{chunk("a.go", 0, 10), chunk("b.go", 0, 10)}
+		if diff := cmp.Diff(expected, results); diff != "" {
+			t.Errorf("unexpected results without rank weight (-want +got):\n%s", diff)
+		}
+	})
+}
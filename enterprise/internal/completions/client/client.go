@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/completions/client/anthropic"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/completions/client/codygateway"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/completions/client/local"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/completions/client/openai"
 	"github.com/sourcegraph/sourcegraph/internal/completions/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
@@ -10,15 +11,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func Get(endpoint string, provider conftypes.CompletionsProviderName, accessToken string) (types.CompletionsClient, error) {
-	client, err := getBasic(endpoint, provider, accessToken)
+func Get(endpoint string, provider conftypes.CompletionsProviderName, accessToken string, promptTemplates []conftypes.CompletionsPromptTemplate) (types.CompletionsClient, error) {
+	client, err := getBasic(endpoint, provider, accessToken, promptTemplates)
 	if err != nil {
 		return nil, err
 	}
 	return newObservedClient(client), nil
 }
 
-func getBasic(endpoint string, provider conftypes.CompletionsProviderName, accessToken string) (types.CompletionsClient, error) {
+func getBasic(endpoint string, provider conftypes.CompletionsProviderName, accessToken string, promptTemplates []conftypes.CompletionsPromptTemplate) (types.CompletionsClient, error) {
 	switch provider {
 	case conftypes.CompletionsProviderNameAnthropic:
 		return anthropic.NewClient(httpcli.ExternalDoer, endpoint, accessToken), nil
@@ -26,6 +27,8 @@ func getBasic(endpoint string, provider conftypes.CompletionsProviderName, acces
 		return openai.NewClient(httpcli.ExternalDoer, endpoint, accessToken), nil
 	case conftypes.CompletionsProviderNameSourcegraph:
 		return codygateway.NewClient(httpcli.ExternalDoer, endpoint, accessToken)
+	case conftypes.CompletionsProviderNameLocal:
+		return local.NewClient(httpcli.ExternalDoer, endpoint, accessToken, promptTemplates), nil
 	default:
 		return nil, errors.Newf("unknown completion stream provider: %s", provider)
 	}
@@ -0,0 +1,293 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/completions/client/openai"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewClient creates a completions client for a self-hosted model server exposing an
+// OpenAI-compatible API, such as vLLM or the llama.cpp server. The endpoint is the base
+// URL of the API, for example http://vllm:8000/v1.
+//
+// Requests to models with a prompt template are sent to the text completions API with a
+// prompt rendered from the template. Requests to all other models are sent to the chat
+// completions API, and the model server applies the chat template of the model.
+func NewClient(cli httpcli.Doer, endpoint, accessToken string, promptTemplates []conftypes.CompletionsPromptTemplate) types.CompletionsClient {
+	templates := make(map[string]conftypes.CompletionsPromptTemplate, len(promptTemplates))
+	for _, template := range promptTemplates {
+		templates[strings.ToLower(template.Model)] = template
+	}
+
+	return &localClient{
+		cli:         cli,
+		endpoint:    strings.TrimRight(endpoint, "/"),
+		accessToken: accessToken,
+		templates:   templates,
+	}
+}
+
+type localClient struct {
+	cli         httpcli.Doer
+	endpoint    string
+	accessToken string
+	templates   map[string]conftypes.CompletionsPromptTemplate
+}
+
+func (c *localClient) Complete(
+	ctx context.Context,
+	feature types.CompletionsFeature,
+	requestParams types.CompletionRequestParameters,
+) (*types.CompletionResponse, error) {
+	req, err := c.newRequest(requestParams, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var response localResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	if len(response.Choices) == 0 {
+		// Empty response.
+		return &types.CompletionResponse{}, nil
+	}
+
+	completion, stopReason := response.Choices[0].content(), response.Choices[0].FinishReason
+	if truncated, ok := truncateAtStopSequence(completion, req.payload.Stop); ok {
+		completion, stopReason = truncated, stopReasonStopSequence
+	}
+	recordTokenUsage(req, response.Usage, completion)
+
+	return &types.CompletionResponse{
+		Completion: completion,
+		StopReason: stopReason,
+	}, nil
+}
+
+func (c *localClient) Stream(
+	ctx context.Context,
+	feature types.CompletionsFeature,
+	requestParams types.CompletionRequestParameters,
+	sendEvent types.SendCompletionEvent,
+) error {
+	req, err := c.newRequest(requestParams, true)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var (
+		content string
+		usage   *localUsage
+	)
+	defer func() { recordTokenUsage(req, usage, content) }()
+
+	// The streaming format of OpenAI-compatible servers is the same as the one of OpenAI.
+	dec := openai.NewDecoder(resp.Body)
+	for dec.Scan() {
+		if ctx.Err() != nil && ctx.Err() == context.Canceled {
+			return nil
+		}
+
+		data := dec.Data()
+		// Gracefully skip over any data that isn't JSON-like.
+		if !bytes.HasPrefix(data, []byte("{")) {
+			continue
+		}
+
+		var event localResponse
+		if err := json.Unmarshal(data, &event); err != nil {
+			return errors.Errorf("failed to decode event payload: %w - body: %s", err, string(data))
+		}
+
+		if event.Usage != nil {
+			usage = event.Usage
+		}
+		if len(event.Choices) == 0 {
+			continue
+		}
+
+		content += event.Choices[0].content()
+		ev := types.CompletionResponse{
+			Completion: content,
+			StopReason: event.Choices[0].FinishReason,
+		}
+
+		// Not every server supports every stop sequence (for example, sequences that
+		// contain special tokens), so stop the stream ourselves if the model emits one.
+		truncated, stopped := truncateAtStopSequence(content, req.payload.Stop)
+		if stopped {
+			content = truncated
+			ev = types.CompletionResponse{Completion: content, StopReason: stopReasonStopSequence}
+		}
+
+		if err := sendEvent(ev); err != nil {
+			return err
+		}
+		if stopped {
+			return nil
+		}
+	}
+
+	return dec.Err()
+}
+
+// stopReasonStopSequence is the stop reason reported by OpenAI-compatible servers when
+// the completion ended with a stop sequence.
+const stopReasonStopSequence = "stop"
+
+// request is a request to the model server along with the estimated size of its prompt.
+type request struct {
+	path         string
+	payload      localRequestParameters
+	promptTokens int
+}
+
+func (c *localClient) newRequest(requestParams types.CompletionRequestParameters, stream bool) (*request, error) {
+	if requestParams.TopK < 0 {
+		requestParams.TopK = 0
+	}
+	if requestParams.TopP < 0 {
+		requestParams.TopP = 0
+	}
+
+	payload := localRequestParameters{
+		Model:       requestParams.Model,
+		Temperature: requestParams.Temperature,
+		TopP:        requestParams.TopP,
+		TopK:        requestParams.TopK,
+		MaxTokens:   requestParams.MaxTokensToSample,
+		Stop:        requestParams.StopSequences,
+		Stream:      stream,
+	}
+
+	template, ok := c.templates[strings.ToLower(requestParams.Model)]
+	if !ok {
+		payload.Messages = getChatMessages(requestParams.Messages)
+
+		promptTokens := 0
+		for _, m := range payload.Messages {
+			promptTokens += estimateTokens(m.Content)
+		}
+		return &request{path: "/chat/completions", payload: payload, promptTokens: promptTokens}, nil
+	}
+
+	prompt, err := getPrompt(template, requestParams.Messages)
+	if err != nil {
+		return nil, err
+	}
+	// Backcompat: Remove this code once enough clients are upgraded and we drop the
+	// Prompt field on requestParams.
+	if len(requestParams.Messages) == 0 {
+		prompt = requestParams.Prompt
+	}
+	payload.Prompt = prompt
+	payload.Stop = appendStopSequences(payload.Stop, template.StopSequences)
+
+	promptTokens := estimateTokens(prompt)
+	if template.ContextWindow > 0 {
+		available := template.ContextWindow - promptTokens
+		if available <= 0 {
+			return nil, errors.Newf("prompt of approximately %d tokens exceeds the context window of %d tokens of model %q", promptTokens, template.ContextWindow, requestParams.Model)
+		}
+		if payload.MaxTokens <= 0 || payload.MaxTokens > available {
+			payload.MaxTokens = available
+		}
+	}
+
+	return &request{path: "/completions", payload: payload, promptTokens: promptTokens}, nil
+}
+
+func (c *localClient) do(ctx context.Context, r *request) (*http.Response, error) {
+	reqBody, err := json.Marshal(r.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint+r.path, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	// Model servers on internal networks commonly run without authentication.
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, types.NewErrStatusNotOK("Local", resp)
+	}
+
+	return resp, nil
+}
+
+// localRequestParameters are the parameters of both the text completions and the chat
+// completions API. Exactly one of Prompt and Messages is set.
+type localRequestParameters struct {
+	Model       string    `json:"model"`
+	Prompt      string    `json:"prompt,omitempty"`
+	Messages    []message `json:"messages,omitempty"`
+	Temperature float32   `json:"temperature,omitempty"`
+	TopP        float32   `json:"top_p,omitempty"`
+	// TopK is not part of the OpenAI API, but is supported by vLLM and the llama.cpp server.
+	TopK      int      `json:"top_k,omitempty"`
+	MaxTokens int      `json:"max_tokens,omitempty"`
+	Stop      []string `json:"stop,omitempty"`
+	Stream    bool     `json:"stream,omitempty"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type localUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+type localChoice struct {
+	// Text is set by the text completions API.
+	Text string `json:"text"`
+	// Message is set by the chat completions API, and Delta is set instead when streaming.
+	Message      message `json:"message"`
+	Delta        message `json:"delta"`
+	FinishReason string  `json:"finish_reason"`
+}
+
+func (c localChoice) content() string {
+	return c.Text + c.Message.Content + c.Delta.Content
+}
+
+type localResponse struct {
+	Choices []localChoice `json:"choices"`
+	// Usage is not sent by all servers when streaming.
+	Usage *localUsage `json:"usage"`
+}
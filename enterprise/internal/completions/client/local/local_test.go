@@ -0,0 +1,182 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+)
+
+type mockDoer struct {
+	do func(*http.Request) (*http.Response, error)
+}
+
+func (c *mockDoer) Do(r *http.Request) (*http.Response, error) {
+	return c.do(r)
+}
+
+// recordingClient returns a client that responds with the given body and the path and
+// payload of the last request.
+func recordingClient(body string, templates ...conftypes.CompletionsPromptTemplate) (types.CompletionsClient, *string, *localRequestParameters) {
+	var (
+		path    string
+		payload localRequestParameters
+	)
+	client := NewClient(&mockDoer{
+		func(r *http.Request) (*http.Response, error) {
+			path = r.URL.String()
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				return nil, err
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}, "http://vllm:8000/v1/", "", templates)
+	return client, &path, &payload
+}
+
+var messages = []types.Message{
+	{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Write a loop"},
+	{Speaker: types.ASISSTANT_MESSAGE_SPEAKER},
+}
+
+func TestComplete(t *testing.T) {
+	t.Run("chat completions without template", func(t *testing.T) {
+		client, path, payload := recordingClient(`{"choices":[{"message":{"role":"assistant","content":"for {}"},"finish_reason":"stop"}]}`)
+
+		resp, err := client.Complete(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:             "mistral-7b-instruct",
+			Messages:          messages,
+			MaxTokensToSample: 100,
+			StopSequences:     []string{"\n\nHuman:"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &types.CompletionResponse{Completion: "for {}", StopReason: "stop"}, resp)
+
+		assert.Equal(t, "http://vllm:8000/v1/chat/completions", *path)
+		assert.Equal(t, localRequestParameters{
+			Model:     "mistral-7b-instruct",
+			Messages:  []message{{Role: "user", Content: "Write a loop"}},
+			MaxTokens: 100,
+			Stop:      []string{"\n\nHuman:"},
+		}, *payload)
+	})
+
+	t.Run("text completions with template", func(t *testing.T) {
+		client, path, payload := recordingClient(`{"choices":[{"text":"for {} </s>","finish_reason":"length"}],"usage":{"prompt_tokens":12,"completion_tokens":5}}`, llamaTemplate)
+
+		resp, err := client.Complete(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:             "CodeLlama",
+			Messages:          messages,
+			MaxTokensToSample: 100,
+		})
+		require.NoError(t, err)
+		// The stop sequence is enforced even though the server did not stop.
+		assert.Equal(t, &types.CompletionResponse{Completion: "for {} ", StopReason: "stop"}, resp)
+
+		assert.Equal(t, "http://vllm:8000/v1/completions", *path)
+		assert.Equal(t, localRequestParameters{
+			Model:     "CodeLlama",
+			Prompt:    "<s>[INST] Write a loop [/INST]",
+			MaxTokens: 100,
+			Stop:      []string{"</s>"},
+		}, *payload)
+	})
+
+	t.Run("context window", func(t *testing.T) {
+		template := llamaTemplate
+		template.ContextWindow = 20
+		client, _, payload := recordingClient(`{"choices":[]}`, template)
+
+		_, err := client.Complete(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:             "codellama",
+			Messages:          messages,
+			MaxTokensToSample: 100,
+		})
+		require.NoError(t, err)
+		// The prompt is 30 characters, or approximately 8 tokens.
+		assert.Equal(t, 12, payload.MaxTokens)
+
+		template.ContextWindow = 8
+		client, _, _ = recordingClient(`{"choices":[]}`, template)
+		_, err = client.Complete(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "codellama",
+			Messages: messages,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestStream(t *testing.T) {
+	stream := func(t *testing.T, body string) []types.CompletionResponse {
+		client, _, payload := recordingClient(body, llamaTemplate)
+
+		var events []types.CompletionResponse
+		err := client.Stream(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "codellama",
+			Messages: messages,
+		}, func(event types.CompletionResponse) error {
+			events = append(events, event)
+			return nil
+		})
+		require.NoError(t, err)
+		require.True(t, payload.Stream)
+		return events
+	}
+
+	t.Run("text completions", func(t *testing.T) {
+		events := stream(t, `data: {"choices":[{"text":"for","finish_reason":null}]}
+
+data: {"choices":[{"text":" {}","finish_reason":"stop"}]}
+
+data: [DONE]
+
+`)
+		assert.Equal(t, []types.CompletionResponse{
+			{Completion: "for"},
+			{Completion: "for {}", StopReason: "stop"},
+		}, events)
+	})
+
+	t.Run("stops at stop sequence", func(t *testing.T) {
+		events := stream(t, `data: {"choices":[{"text":"for {} </"}]}
+
+data: {"choices":[{"text":"s> and more"}]}
+
+data: {"choices":[{"text":" ignored"}]}
+
+`)
+		assert.Equal(t, []types.CompletionResponse{
+			{Completion: "for {} </"},
+			{Completion: "for {} ", StopReason: "stop"},
+		}, events)
+	})
+}
+
+func TestErrStatusNotOK(t *testing.T) {
+	client := NewClient(&mockDoer{
+		func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       io.NopCloser(bytes.NewReader([]byte("model is loading"))),
+			}, nil
+		},
+	}, "http://vllm:8000/v1", "", nil)
+
+	_, err := client.Complete(context.Background(), types.CompletionsFeatureChat, types.CompletionRequestParameters{Messages: messages})
+	require.Error(t, err)
+	assert.Equal(t, "Local: unexpected status code 503: model is loading", err.Error())
+	_, ok := types.IsErrStatusNotOK(err)
+	assert.True(t, ok)
+}
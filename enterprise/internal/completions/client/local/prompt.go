@@ -0,0 +1,103 @@
+package local
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// getPrompt renders the messages into a single prompt with the given template. The prompt
+// always ends with the assistant message prefix, so that the model responds as the assistant.
+// A trailing assistant message is the beginning of the response and is not terminated.
+func getPrompt(template conftypes.CompletionsPromptTemplate, messages []types.Message) (string, error) {
+	var prompt strings.Builder
+	for idx, message := range messages {
+		if idx > 0 && messages[idx-1].Speaker == message.Speaker {
+			return "", errors.Newf("found consecutive messages with the same speaker '%s'", message.Speaker)
+		}
+
+		switch message.Speaker {
+		case types.HUMAN_MESSAGE_SPEAKER:
+			prompt.WriteString(template.HumanMessagePrefix)
+			prompt.WriteString(message.Text)
+			prompt.WriteString(template.HumanMessageSuffix)
+		case types.ASISSTANT_MESSAGE_SPEAKER:
+			prompt.WriteString(template.AssistantMessagePrefix)
+			prompt.WriteString(message.Text)
+			if idx == len(messages)-1 {
+				return prompt.String(), nil
+			}
+			prompt.WriteString(template.AssistantMessageSuffix)
+		default:
+			return "", errors.Newf("expected message speaker to be 'human' or 'assistant', got %s", message.Speaker)
+		}
+	}
+
+	prompt.WriteString(template.AssistantMessagePrefix)
+	return prompt.String(), nil
+}
+
+// getChatMessages converts the messages to the messages of the chat completions API.
+func getChatMessages(messages []types.Message) []message {
+	chatMessages := make([]message, 0, len(messages))
+	for idx, m := range messages {
+		var role string
+		switch m.Speaker {
+		case types.HUMAN_MESSAGE_SPEAKER:
+			role = "user"
+		case types.ASISSTANT_MESSAGE_SPEAKER:
+			// Clients end the conversation with an empty assistant message for the
+			// response, which chat templates would render as a completed turn.
+			if idx == len(messages)-1 && m.Text == "" {
+				continue
+			}
+			role = "assistant"
+		default:
+			role = strings.ToLower(m.Speaker)
+		}
+
+		chatMessages = append(chatMessages, message{
+			Role:    role,
+			Content: m.Text,
+		})
+	}
+	return chatMessages
+}
+
+// appendStopSequences appends the stop sequences of the template that are not requested already.
+func appendStopSequences(stopSequences, templateStopSequences []string) []string {
+	seen := make(map[string]struct{}, len(stopSequences))
+	for _, s := range stopSequences {
+		seen[s] = struct{}{}
+	}
+
+	for _, s := range templateStopSequences {
+		if _, ok := seen[s]; ok || s == "" {
+			continue
+		}
+		seen[s] = struct{}{}
+		stopSequences = append(stopSequences, s)
+	}
+	return stopSequences
+}
+
+// truncateAtStopSequence truncates the completion before the first occurrence of any stop
+// sequence. It returns false if the completion contains none of them.
+func truncateAtStopSequence(completion string, stopSequences []string) (string, bool) {
+	end := -1
+	for _, s := range stopSequences {
+		if s == "" {
+			continue
+		}
+		if i := strings.Index(completion, s); i >= 0 && (end < 0 || i < end) {
+			end = i
+		}
+	}
+
+	if end < 0 {
+		return completion, false
+	}
+	return completion[:end], true
+}
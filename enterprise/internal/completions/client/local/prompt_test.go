@@ -0,0 +1,97 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+)
+
+var llamaTemplate = conftypes.CompletionsPromptTemplate{
+	Model:                  "codellama",
+	HumanMessagePrefix:     "<s>[INST] ",
+	HumanMessageSuffix:     " [/INST]",
+	AssistantMessageSuffix: " </s>",
+	StopSequences:          []string{"</s>"},
+}
+
+func TestGetPrompt(t *testing.T) {
+	t.Run("ends with assistant prefix", func(t *testing.T) {
+		prompt, err := getPrompt(llamaTemplate, []types.Message{
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Hi"},
+			{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: "Hello"},
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Write a loop"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "<s>[INST] Hi [/INST]Hello </s><s>[INST] Write a loop [/INST]", prompt)
+	})
+
+	t.Run("trailing assistant message is not terminated", func(t *testing.T) {
+		prompt, err := getPrompt(conftypes.CompletionsPromptTemplate{
+			HumanMessagePrefix:     "### User:\n",
+			HumanMessageSuffix:     "\n",
+			AssistantMessagePrefix: "### Assistant:\n",
+			AssistantMessageSuffix: "\n",
+		}, []types.Message{
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Complete this"},
+			{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: "func main() {"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "### User:\nComplete this\n### Assistant:\nfunc main() {", prompt)
+	})
+
+	t.Run("consecutive speakers", func(t *testing.T) {
+		_, err := getPrompt(llamaTemplate, []types.Message{
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "a"},
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "b"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown speaker", func(t *testing.T) {
+		_, err := getPrompt(llamaTemplate, []types.Message{{Speaker: "system", Text: "a"}})
+		require.Error(t, err)
+	})
+}
+
+func TestGetChatMessages(t *testing.T) {
+	messages := getChatMessages([]types.Message{
+		{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Hi"},
+		{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: "Hello"},
+		{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "Write a loop"},
+		{Speaker: types.ASISSTANT_MESSAGE_SPEAKER},
+	})
+	assert.Equal(t, []message{
+		{Role: "user", Content: "Hi"},
+		{Role: "assistant", Content: "Hello"},
+		{Role: "user", Content: "Write a loop"},
+	}, messages)
+}
+
+func TestTruncateAtStopSequence(t *testing.T) {
+	for _, tc := range []struct {
+		completion    string
+		stopSequences []string
+		want          string
+		wantStopped   bool
+	}{
+		{completion: "for {}", stopSequences: []string{"</s>"}, want: "for {}"},
+		{completion: "for {} </s> more", stopSequences: []string{"</s>"}, want: "for {} ", wantStopped: true},
+		{completion: "a\n\nHuman: b</s>", stopSequences: []string{"</s>", "\n\nHuman:"}, want: "a", wantStopped: true},
+		{completion: "abc", stopSequences: []string{""}, want: "abc"},
+	} {
+		got, stopped := truncateAtStopSequence(tc.completion, tc.stopSequences)
+		assert.Equal(t, tc.want, got)
+		assert.Equal(t, tc.wantStopped, stopped)
+	}
+}
+
+func TestAppendStopSequences(t *testing.T) {
+	assert.Equal(t,
+		[]string{"\n\nHuman:", "</s>", "[INST]"},
+		appendStopSequences([]string{"\n\nHuman:", "</s>"}, []string{"</s>", "", "[INST]"}),
+	)
+}
@@ -0,0 +1,31 @@
+package local
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var tokensTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "completions_local_tokens_total",
+	Help:      "The number of prompt and completion tokens processed by self-hosted completions models.",
+}, []string{"model", "type"})
+
+// estimateTokens estimates the number of tokens of the text. Tokenizers of self-hosted
+// models are not available to us, so we use the common approximation of four characters
+// per token.
+func estimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// recordTokenUsage records the number of tokens of a request. The usage reported by the
+// model server is used when available, since not all servers report it when streaming.
+func recordTokenUsage(r *request, usage *localUsage, completion string) {
+	promptTokens, completionTokens := r.promptTokens, estimateTokens(completion)
+	if usage != nil {
+		promptTokens, completionTokens = usage.PromptTokens, usage.CompletionTokens
+	}
+
+	tokensTotal.WithLabelValues(r.payload.Model, "prompt").Add(float64(promptTokens))
+	tokensTotal.WithLabelValues(r.payload.Model, "completion").Add(float64(completionTokens))
+}
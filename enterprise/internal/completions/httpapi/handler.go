@@ -64,6 +64,7 @@ func newCompletionsHandler(
 			completionsConfig.Endpoint,
 			completionsConfig.Provider,
 			completionsConfig.AccessToken,
+			completionsConfig.PromptTemplates,
 		)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
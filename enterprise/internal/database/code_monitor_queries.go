@@ -20,6 +20,11 @@ type QueryTrigger struct {
 	CreatedAt    time.Time
 	ChangedBy    int32
 	ChangedAt    time.Time
+
+	// APIChangesOnly restricts the trigger to matched commits that change the
+	// public API of the repository according to its precise code intelligence
+	// indexes.
+	APIChangesOnly bool
 }
 
 // queryColumns is the set of columns in cm_queries
@@ -34,16 +39,17 @@ var queryColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_queries.created_at"),
 	sqlf.Sprintf("cm_queries.changed_by"),
 	sqlf.Sprintf("cm_queries.changed_at"),
+	sqlf.Sprintf("cm_queries.api_changes_only"),
 }
 
 const createTriggerQueryFmtStr = `
 INSERT INTO cm_queries
-(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result, api_changes_only)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error) {
+func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string, apiChangesOnly bool) (*QueryTrigger, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
@@ -56,6 +62,7 @@ func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int
 		now,
 		now,
 		now,
+		apiChangesOnly,
 		sqlf.Join(queryColumns, ", "),
 	)
 	row := s.QueryRow(ctx, q)
@@ -67,7 +74,8 @@ UPDATE cm_queries
 SET query = %s,
 	changed_by = %s,
 	changed_at = %s,
-	latest_result = %s
+	latest_result = %s,
+	api_changes_only = %s
 WHERE
 	id = %s
 	AND EXISTS (
@@ -78,7 +86,7 @@ WHERE
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string) error {
+func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string, apiChangesOnly bool) error {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
@@ -87,6 +95,7 @@ func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, que
 		a.UID,
 		now,
 		now,
+		apiChangesOnly,
 		id,
 		a.UID,
 		sqlf.Join(queryColumns, ", "),
@@ -171,6 +180,7 @@ func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
 		&m.CreatedAt,
 		&m.ChangedBy,
 		&m.ChangedAt,
+		&m.APIChangesOnly,
 	)
 	return m, err
 }
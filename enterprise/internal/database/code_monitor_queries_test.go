@@ -68,16 +68,17 @@ func TestUpdateTrigger(t *testing.T) {
 	_ = s.insertTestMonitor(ctx2, t)
 
 	// User1 can update it
-	err := s.UpdateQueryTrigger(ctx1, fixtures.query.ID, "query1")
+	err := s.UpdateQueryTrigger(ctx1, fixtures.query.ID, "query1", true)
 	require.NoError(t, err)
 
 	// User2 cannot update it
-	err = s.UpdateQueryTrigger(ctx2, fixtures.query.ID, "query2")
+	err = s.UpdateQueryTrigger(ctx2, fixtures.query.ID, "query2", false)
 	require.Error(t, err)
 
 	qt, err := s.GetQueryTriggerForMonitor(ctx1, fixtures.query.ID)
 	require.NoError(t, err)
 	require.Equal(t, qt.QueryString, "query1")
+	require.True(t, qt.APIChangesOnly)
 }
 
 func TestResetTriggerQueryTimestamps(t *testing.T) {
@@ -39,8 +39,8 @@ type CodeMonitorStore interface {
 	ListMonitors(context.Context, ListMonitorsOpts) ([]*Monitor, error)
 	CountMonitors(ctx context.Context, userID int32) (int32, error)
 
-	CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error)
-	UpdateQueryTrigger(ctx context.Context, id int64, query string) error
+	CreateQueryTrigger(ctx context.Context, monitorID int64, query string, apiChangesOnly bool) (*QueryTrigger, error)
+	UpdateQueryTrigger(ctx context.Context, id int64, query string, apiChangesOnly bool) error
 	GetQueryTriggerForMonitor(ctx context.Context, monitorID int64) (*QueryTrigger, error)
 	ResetQueryTriggerTimestamps(ctx context.Context, queryID int64) error
 	SetQueryTriggerNextRun(ctx context.Context, triggerQueryID int64, next time.Time, latestResults time.Time) error
@@ -204,7 +204,7 @@ func (s *TestStore) InsertTestMonitor(ctx context.Context, t *testing.T) (*Monit
 	}
 
 	// Create trigger.
-	_, err = s.CreateQueryTrigger(ctx, m.ID, testQuery)
+	_, err = s.CreateQueryTrigger(ctx, m.ID, testQuery, false)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,240 @@
+package embeddings
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+// ANNIndex is an inverted file (IVF) index used for approximate nearest neighbor search over the
+// rows of an EmbeddingIndex. The rows are partitioned into lists by their nearest centroid (found
+// with k-means clustering), and a search only scores the rows in the lists whose centroids are the
+// most similar to the query.
+type ANNIndex struct {
+	// NumRows is the number of rows of the embedding index at the time the ANN index was built.
+	NumRows int
+	// Centroids holds the quantized, normalized centroid of each list. Each centroid has the same
+	// dimensionality as the rows of the embedding index.
+	Centroids []int8
+	// Lists holds the indexes of the rows assigned to each centroid.
+	Lists [][]int32
+}
+
+func (ann *ANNIndex) centroid(dimension, n int) []int8 {
+	return ann.Centroids[n*dimension : (n+1)*dimension]
+}
+
+func (ann *ANNIndex) estimateSize() int64 {
+	return int64(len(ann.Centroids) + ann.NumRows*4)
+}
+
+type ANNIndexOptions struct {
+	// MinRows is the minimum number of rows of an embedding index to build an ANN index for.
+	// Smaller indexes are always searched exhaustively. If zero, no ANN indexes are built.
+	MinRows int
+	// NumLists is the number of lists (centroids) of the index. If zero, the square root of the
+	// number of rows is used.
+	NumLists int
+	// TrainingIterations is the number of k-means iterations used to compute the centroids.
+	TrainingIterations int
+	// TrainingSampleSize is the maximum number of rows used to compute the centroids. If zero,
+	// all rows are used.
+	TrainingSampleSize int
+}
+
+// BuildANNIndexes builds approximate nearest neighbor indexes for the code and text indexes.
+func (i *RepoEmbeddingIndex) BuildANNIndexes(opts ANNIndexOptions) {
+	i.CodeIndex.BuildANNIndex(opts)
+	i.TextIndex.BuildANNIndex(opts)
+}
+
+// BuildANNIndex builds an approximate nearest neighbor index for the rows of the embedding index,
+// replacing any existing one. No index is built for embedding indexes with fewer rows than the
+// configured minimum.
+// IMPORTANT: The vectors in the embedding index have to be normalized.
+func (index *EmbeddingIndex) BuildANNIndex(opts ANNIndexOptions) {
+	index.ANN = nil
+
+	numRows := len(index.RowMetadata)
+	if opts.MinRows <= 0 || numRows < opts.MinRows || numRows == 0 || index.ColumnDimension == 0 {
+		return
+	}
+
+	numLists := opts.NumLists
+	if numLists <= 0 {
+		numLists = int(math.Sqrt(float64(numRows)))
+	}
+	numLists = max(1, min(numLists, numRows))
+
+	// Use a fixed seed so that building an index for the same rows is deterministic.
+	rng := rand.New(rand.NewSource(int64(numRows)))
+
+	sample := make([]int, numRows)
+	for i := range sample {
+		sample[i] = i
+	}
+	if opts.TrainingSampleSize > 0 && numRows > opts.TrainingSampleSize {
+		rng.Shuffle(len(sample), func(i, j int) { sample[i], sample[j] = sample[j], sample[i] })
+		sample = sample[:max(opts.TrainingSampleSize, numLists)]
+	}
+
+	centroids := index.trainCentroids(rng, sample, numLists, opts.TrainingIterations)
+
+	lists := make([][]int32, numLists)
+	for i := 0; i < numRows; i++ {
+		nearest := nearestCentroid(index.Row(i), centroids, index.ColumnDimension)
+		lists[nearest] = append(lists[nearest], int32(i))
+	}
+
+	index.ANN = &ANNIndex{
+		NumRows:   numRows,
+		Centroids: centroids,
+		Lists:     lists,
+	}
+}
+
+// trainCentroids runs spherical k-means over the sampled rows and returns the quantized centroids.
+func (index *EmbeddingIndex) trainCentroids(rng *rand.Rand, sample []int, numLists, iterations int) []int8 {
+	dimension := index.ColumnDimension
+
+	// Initialize the centroids with randomly chosen rows.
+	centroids := make([]int8, 0, numLists*dimension)
+	for _, i := range rng.Perm(len(sample))[:numLists] {
+		centroids = append(centroids, index.Row(sample[i])...)
+	}
+
+	sums := make([]float32, numLists*dimension)
+	counts := make([]int, numLists)
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		for i := range sums {
+			sums[i] = 0
+		}
+		for i := range counts {
+			counts[i] = 0
+		}
+
+		for _, i := range sample {
+			row := index.Row(i)
+			nearest := nearestCentroid(row, centroids, dimension)
+			counts[nearest]++
+
+			sum := sums[nearest*dimension : (nearest+1)*dimension]
+			for j, v := range row {
+				sum[j] += float32(v)
+			}
+		}
+
+		for n := 0; n < numLists; n++ {
+			// Keep the previous centroid of empty lists.
+			if counts[n] == 0 {
+				continue
+			}
+
+			copy(centroids[n*dimension:(n+1)*dimension], Quantize(normalize(sums[n*dimension:(n+1)*dimension])))
+		}
+	}
+
+	return centroids
+}
+
+func nearestCentroid(row []int8, centroids []int8, dimension int) int {
+	nearest, nearestScore := 0, int32(math.MinInt32)
+	for n := 0; n < len(centroids)/dimension; n++ {
+		if score := Dot(row, centroids[n*dimension:(n+1)*dimension]); score > nearestScore {
+			nearest, nearestScore = n, score
+		}
+	}
+	return nearest
+}
+
+// normalize scales the given vector to unit length in place.
+func normalize(vector []float32) []float32 {
+	var sum float64
+	for _, v := range vector {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return vector
+	}
+
+	norm := float32(math.Sqrt(sum))
+	for i := range vector {
+		vector[i] /= norm
+	}
+	return vector
+}
+
+// useApproximateSearch returns true if the embedding index has an up-to-date ANN index that should be
+// used for the search instead of an exhaustive search.
+func (index *EmbeddingIndex) useApproximateSearch(opts SearchOptions) bool {
+	return opts.ApproximateSearchProbes > 0 &&
+		index.ANN != nil &&
+		index.ANN.NumRows == len(index.RowMetadata) &&
+		len(index.RowMetadata) > opts.ExactSearchMaxRows
+}
+
+// approximateSimilaritySearch finds the numResults most similar rows to the query vector among the
+// rows in the lists of the ANN index whose centroids are the most similar to the query.
+func (index *EmbeddingIndex) approximateSimilaritySearch(query []int8, numResults int, opts SearchOptions) []nearestNeighbor {
+	ann := index.ANN
+	numLists := len(ann.Lists)
+
+	lists := make([]int, numLists)
+	centroidScores := make([]int32, numLists)
+	for n := 0; n < numLists; n++ {
+		lists[n] = n
+		centroidScores[n] = Dot(query, ann.centroid(index.ColumnDimension, n))
+	}
+	sort.Slice(lists, func(i, j int) bool { return centroidScores[lists[i]] > centroidScores[lists[j]] })
+
+	nnHeap := newNearestNeighborsHeap()
+	for _, n := range lists[:min(opts.ApproximateSearchProbes, numLists)] {
+		for _, row := range ann.Lists[n] {
+			scoreDetails := index.score(query, int(row), opts)
+			if nnHeap.Len() < numResults {
+				heap.Push(nnHeap, nearestNeighbor{index: int(row), scoreDetails: scoreDetails})
+			} else if scoreDetails.Score > nnHeap.Peek().scoreDetails.Score {
+				heap.Pop(nnHeap)
+				heap.Push(nnHeap, nearestNeighbor{index: int(row), scoreDetails: scoreDetails})
+			}
+		}
+	}
+
+	return nnHeap.neighbors
+}
+
+type EmbeddingsANNIndexConfig struct {
+	env.BaseConfig
+
+	MinRows            int
+	NumLists           int
+	TrainingIterations int
+	TrainingSampleSize int
+}
+
+func (c *EmbeddingsANNIndexConfig) Load() {
+	c.MinRows = c.GetInt("EMBEDDINGS_ANN_INDEX_MIN_ROWS", strconv.Itoa(DefaultExactSearchMaxRows), "The minimum number of rows of an embeddings index to build an approximate nearest neighbor index for. Set to 0 to disable approximate nearest neighbor indexes.")
+	c.NumLists = c.GetInt("EMBEDDINGS_ANN_INDEX_NUM_LISTS", "0", "The number of lists of approximate nearest neighbor indexes. Defaults to the square root of the number of rows.")
+	c.TrainingIterations = c.GetInt("EMBEDDINGS_ANN_INDEX_TRAINING_ITERATIONS", "10", "The number of k-means iterations used to build approximate nearest neighbor indexes.")
+	c.TrainingSampleSize = c.GetInt("EMBEDDINGS_ANN_INDEX_TRAINING_SAMPLE_SIZE", "100000", "The maximum number of rows used to train approximate nearest neighbor indexes.")
+}
+
+func (c *EmbeddingsANNIndexConfig) Options() ANNIndexOptions {
+	return ANNIndexOptions{
+		MinRows:            c.MinRows,
+		NumLists:           c.NumLists,
+		TrainingIterations: c.TrainingIterations,
+		TrainingSampleSize: c.TrainingSampleSize,
+	}
+}
+
+var EmbeddingsANNIndexConfigInst = &EmbeddingsANNIndexConfig{}
+
+// DefaultExactSearchMaxRows is the default number of rows up to which embedding indexes are searched
+// exhaustively, even if they have an ANN index.
+const DefaultExactSearchMaxRows = 50_000
@@ -0,0 +1,134 @@
+package embeddings
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// getClusteredEmbeddingIndex returns an index with normalized rows scattered around a number of
+// random cluster centers, which resembles the distribution of real embeddings more closely than
+// uniformly random rows.
+func getClusteredEmbeddingIndex(rng *rand.Rand, numRows, numClusters, columnDimension int) EmbeddingIndex {
+	centers := make([][]float32, numClusters)
+	for i := range centers {
+		centers[i] = randomNormalizedVector(rng, columnDimension)
+	}
+
+	index := EmbeddingIndex{ColumnDimension: columnDimension}
+	for i := 0; i < numRows; i++ {
+		center := centers[rng.Intn(numClusters)]
+		row := make([]float32, columnDimension)
+		for j := range row {
+			row[j] = center[j] + float32(rng.NormFloat64())*0.1
+		}
+
+		index.Embeddings = append(index.Embeddings, Quantize(normalize(row))...)
+		index.RowMetadata = append(index.RowMetadata, RepoEmbeddingRowMetadata{FileName: strconv.Itoa(i)})
+	}
+
+	return index
+}
+
+func randomNormalizedVector(rng *rand.Rand, columnDimension int) []float32 {
+	vector := make([]float32, columnDimension)
+	for i := range vector {
+		vector[i] = float32(rng.NormFloat64())
+	}
+	return normalize(vector)
+}
+
+func TestBuildANNIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	index := getClusteredEmbeddingIndex(rng, 1000, 10, 16)
+
+	t.Run("too few rows", func(t *testing.T) {
+		index.BuildANNIndex(ANNIndexOptions{MinRows: 1001, TrainingIterations: 5})
+		require.Nil(t, index.ANN)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		index.BuildANNIndex(ANNIndexOptions{TrainingIterations: 5})
+		require.Nil(t, index.ANN)
+	})
+
+	t.Run("every row is assigned to exactly one list", func(t *testing.T) {
+		index.BuildANNIndex(ANNIndexOptions{MinRows: 100, NumLists: 20, TrainingIterations: 5, TrainingSampleSize: 500})
+		require.NotNil(t, index.ANN)
+		require.Equal(t, 1000, index.ANN.NumRows)
+		require.Len(t, index.ANN.Lists, 20)
+		require.Len(t, index.ANN.Centroids, 20*16)
+
+		seen := make(map[int32]struct{}, 1000)
+		for _, list := range index.ANN.Lists {
+			for _, row := range list {
+				_, ok := seen[row]
+				require.False(t, ok, "row %d assigned to multiple lists", row)
+				seen[row] = struct{}{}
+			}
+		}
+		require.Len(t, seen, 1000)
+	})
+
+	t.Run("default number of lists", func(t *testing.T) {
+		index.BuildANNIndex(ANNIndexOptions{MinRows: 100, TrainingIterations: 5})
+		require.NotNil(t, index.ANN)
+		require.Len(t, index.ANN.Lists, 31)
+	})
+
+	t.Run("rows changed", func(t *testing.T) {
+		index.BuildANNIndex(ANNIndexOptions{MinRows: 100, TrainingIterations: 5})
+		index.append(getClusteredEmbeddingIndex(rng, 10, 1, 16))
+		require.Nil(t, index.ANN)
+	})
+}
+
+func TestApproximateSimilaritySearch(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	numRows, numResults, columnDimension := 4000, 10, 32
+	index := getClusteredEmbeddingIndex(rng, numRows, 40, columnDimension)
+	index.BuildANNIndex(ANNIndexOptions{MinRows: 1, TrainingIterations: 10})
+	require.NotNil(t, index.ANN)
+
+	queries := make([][]int8, 50)
+	for i := range queries {
+		queries[i] = index.Row(rng.Intn(numRows))
+	}
+
+	recall := func(opts SearchOptions) float64 {
+		found := 0
+		for _, query := range queries {
+			expected := map[string]struct{}{}
+			for _, r := range index.SimilaritySearch(query, numResults, WorkerOptions{NumWorkers: 1}, SearchOptions{}, "", "") {
+				expected[r.FileName] = struct{}{}
+			}
+			for _, r := range index.SimilaritySearch(query, numResults, WorkerOptions{NumWorkers: 1}, opts, "", "") {
+				if _, ok := expected[r.FileName]; ok {
+					found++
+				}
+			}
+		}
+		return float64(found) / float64(len(queries)*numResults)
+	}
+
+	t.Run("probing all lists is exact", func(t *testing.T) {
+		require.Equal(t, 1.0, recall(SearchOptions{ApproximateSearchProbes: len(index.ANN.Lists)}))
+	})
+
+	t.Run("probing some lists has high recall", func(t *testing.T) {
+		require.GreaterOrEqual(t, recall(SearchOptions{ApproximateSearchProbes: 8}), 0.9)
+	})
+
+	t.Run("small indexes are searched exactly", func(t *testing.T) {
+		require.False(t, index.useApproximateSearch(SearchOptions{ApproximateSearchProbes: 1, ExactSearchMaxRows: numRows}))
+		require.Equal(t, 1.0, recall(SearchOptions{ApproximateSearchProbes: 1, ExactSearchMaxRows: numRows}))
+	})
+
+	t.Run("stale indexes are ignored", func(t *testing.T) {
+		stale := index
+		stale.ANN = &ANNIndex{NumRows: numRows - 1, Centroids: index.ANN.Centroids, Lists: index.ANN.Lists}
+		require.False(t, stale.useApproximateSearch(SearchOptions{ApproximateSearchProbes: 1}))
+	})
+}
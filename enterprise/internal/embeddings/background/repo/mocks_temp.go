@@ -38,6 +38,10 @@ type MockRepoEmbeddingJobsStore struct {
 	// GetEmbeddableReposFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmbeddableRepos.
 	GetEmbeddableReposFunc *RepoEmbeddingJobsStoreGetEmbeddableReposFunc
+	// GetLastCompletedFullRepoEmbeddingJobFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetLastCompletedFullRepoEmbeddingJob.
+	GetLastCompletedFullRepoEmbeddingJobFunc *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc
 	// GetLastCompletedRepoEmbeddingJobFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetLastCompletedRepoEmbeddingJob.
@@ -99,6 +103,11 @@ func NewMockRepoEmbeddingJobsStore() *MockRepoEmbeddingJobsStore {
 				return
 			},
 		},
+		GetLastCompletedFullRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *RepoEmbeddingJob, r1 error) {
+				return
+			},
+		},
 		GetLastCompletedRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedRepoEmbeddingJobFunc{
 			defaultHook: func(context.Context, api.RepoID) (r0 *RepoEmbeddingJob, r1 error) {
 				return
@@ -172,6 +181,11 @@ func NewStrictMockRepoEmbeddingJobsStore() *MockRepoEmbeddingJobsStore {
 				panic("unexpected invocation of MockRepoEmbeddingJobsStore.GetEmbeddableRepos")
 			},
 		},
+		GetLastCompletedFullRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc{
+			defaultHook: func(context.Context, api.RepoID) (*RepoEmbeddingJob, error) {
+				panic("unexpected invocation of MockRepoEmbeddingJobsStore.GetLastCompletedFullRepoEmbeddingJob")
+			},
+		},
 		GetLastCompletedRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedRepoEmbeddingJobFunc{
 			defaultHook: func(context.Context, api.RepoID) (*RepoEmbeddingJob, error) {
 				panic("unexpected invocation of MockRepoEmbeddingJobsStore.GetLastCompletedRepoEmbeddingJob")
@@ -233,6 +247,9 @@ func NewMockRepoEmbeddingJobsStoreFrom(i RepoEmbeddingJobsStore) *MockRepoEmbedd
 		GetEmbeddableReposFunc: &RepoEmbeddingJobsStoreGetEmbeddableReposFunc{
 			defaultHook: i.GetEmbeddableRepos,
 		},
+		GetLastCompletedFullRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc{
+			defaultHook: i.GetLastCompletedFullRepoEmbeddingJob,
+		},
 		GetLastCompletedRepoEmbeddingJobFunc: &RepoEmbeddingJobsStoreGetLastCompletedRepoEmbeddingJobFunc{
 			defaultHook: i.GetLastCompletedRepoEmbeddingJob,
 		},
@@ -912,6 +929,121 @@ func (c RepoEmbeddingJobsStoreGetEmbeddableReposFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc describes
+// the behavior when the GetLastCompletedFullRepoEmbeddingJob method of the
+// parent MockRepoEmbeddingJobsStore instance is invoked.
+type RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*RepoEmbeddingJob, error)
+	hooks       []func(context.Context, api.RepoID) (*RepoEmbeddingJob, error)
+	history     []RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLastCompletedFullRepoEmbeddingJob delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoEmbeddingJobsStore) GetLastCompletedFullRepoEmbeddingJob(v0 context.Context, v1 api.RepoID) (*RepoEmbeddingJob, error) {
+	r0, r1 := m.GetLastCompletedFullRepoEmbeddingJobFunc.nextHook()(v0, v1)
+	m.GetLastCompletedFullRepoEmbeddingJobFunc.appendCall(RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetLastCompletedFullRepoEmbeddingJob method of the parent
+// MockRepoEmbeddingJobsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*RepoEmbeddingJob, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLastCompletedFullRepoEmbeddingJob method of the parent
+// MockRepoEmbeddingJobsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) PushHook(hook func(context.Context, api.RepoID) (*RepoEmbeddingJob, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) SetDefaultReturn(r0 *RepoEmbeddingJob, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*RepoEmbeddingJob, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) PushReturn(r0 *RepoEmbeddingJob, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*RepoEmbeddingJob, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) nextHook() func(context.Context, api.RepoID) (*RepoEmbeddingJob, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) appendCall(r0 RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall
+// objects describing the invocations of this function.
+func (f *RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFunc) History() []RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall is an
+// object that describes an invocation of method
+// GetLastCompletedFullRepoEmbeddingJob on an instance of
+// MockRepoEmbeddingJobsStore.
+type RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *RepoEmbeddingJob
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoEmbeddingJobsStoreGetLastCompletedFullRepoEmbeddingJobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // RepoEmbeddingJobsStoreGetLastCompletedRepoEmbeddingJobFunc describes the
 // behavior when the GetLastCompletedRepoEmbeddingJob method of the parent
 // MockRepoEmbeddingJobsStore instance is invoked.
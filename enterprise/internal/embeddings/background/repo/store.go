@@ -101,6 +101,7 @@ type RepoEmbeddingJobsStore interface {
 
 	CreateRepoEmbeddingJob(ctx context.Context, repoID api.RepoID, revision api.CommitID) (int, error)
 	GetLastCompletedRepoEmbeddingJob(ctx context.Context, repoID api.RepoID) (*RepoEmbeddingJob, error)
+	GetLastCompletedFullRepoEmbeddingJob(ctx context.Context, repoID api.RepoID) (*RepoEmbeddingJob, error)
 	GetLastRepoEmbeddingJobForRevision(ctx context.Context, repoID api.RepoID, revision api.CommitID) (*RepoEmbeddingJob, error)
 	ListRepoEmbeddingJobs(ctx context.Context, args ListOpts) ([]*RepoEmbeddingJob, error)
 	CountRepoEmbeddingJobs(ctx context.Context, args ListOpts) (int, error)
@@ -393,6 +394,30 @@ func (s *repoEmbeddingJobsStore) GetLastCompletedRepoEmbeddingJob(ctx context.Co
 	return job, nil
 }
 
+// Jobs without stats predate incremental indexing and are therefore full index jobs.
+const getLastFinishedFullRepoEmbeddingJob = `
+SELECT %s
+FROM repo_embedding_jobs
+LEFT JOIN repo_embedding_job_stats ON repo_embedding_job_stats.job_id = repo_embedding_jobs.id
+WHERE
+	repo_embedding_jobs.state = 'completed' AND
+	repo_embedding_jobs.repo_id = %d AND
+	NOT COALESCE(repo_embedding_job_stats.is_incremental, false)
+ORDER BY repo_embedding_jobs.finished_at DESC
+LIMIT 1
+`
+
+// GetLastCompletedFullRepoEmbeddingJob returns the most recently completed job for the given
+// repository that computed a full (non-incremental) embeddings index.
+func (s *repoEmbeddingJobsStore) GetLastCompletedFullRepoEmbeddingJob(ctx context.Context, repoID api.RepoID) (*RepoEmbeddingJob, error) {
+	q := sqlf.Sprintf(getLastFinishedFullRepoEmbeddingJob, sqlf.Join(repoEmbeddingJobsColumns, ", "), repoID)
+	job, err := scanRepoEmbeddingJob(s.QueryRow(ctx, q))
+	if err == sql.ErrNoRows {
+		return nil, &RepoEmbeddingJobNotFoundErr{repoID: repoID}
+	}
+	return job, err
+}
+
 const getLastRepoEmbeddingJobForRevision = `
 SELECT %s
 FROM repo_embedding_jobs
@@ -139,6 +139,55 @@ func TestRepoEmbeddingJobsStore(t *testing.T) {
 	})
 }
 
+func TestGetLastCompletedFullRepoEmbeddingJob(t *testing.T) {
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	repoStore := db.Repos()
+
+	ctx := context.Background()
+
+	createdRepo := &types.Repo{Name: "github.com/sourcegraph/sourcegraph", URI: "github.com/sourcegraph/sourcegraph", ExternalRepo: api.ExternalRepoSpec{}}
+	err := repoStore.Create(ctx, createdRepo)
+	require.NoError(t, err)
+
+	store := NewRepoEmbeddingJobsStore(db)
+
+	// no completed job exists
+	_, err = store.GetLastCompletedFullRepoEmbeddingJob(ctx, createdRepo.ID)
+	require.Error(t, err)
+
+	// A job without stats predates incremental indexing
+	id1, err := store.CreateRepoEmbeddingJob(ctx, createdRepo.ID, "deadbeef")
+	require.NoError(t, err)
+	setJobState(t, ctx, store, id1, "completed")
+
+	job, err := store.GetLastCompletedFullRepoEmbeddingJob(ctx, createdRepo.ID)
+	require.NoError(t, err)
+	require.Equal(t, id1, job.ID)
+
+	id2, err := store.CreateRepoEmbeddingJob(ctx, createdRepo.ID, "coffee")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateRepoEmbeddingJobStats(ctx, id2, &EmbedRepoStats{IsIncremental: false}))
+	setJobState(t, ctx, store, id2, "completed")
+
+	id3, err := store.CreateRepoEmbeddingJob(ctx, createdRepo.ID, "tea")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateRepoEmbeddingJobStats(ctx, id3, &EmbedRepoStats{IsIncremental: true}))
+	setJobState(t, ctx, store, id3, "completed")
+
+	// Full jobs which are not completed are ignored
+	id4, err := store.CreateRepoEmbeddingJob(ctx, createdRepo.ID, "juice")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateRepoEmbeddingJobStats(ctx, id4, &EmbedRepoStats{IsIncremental: false}))
+	setJobState(t, ctx, store, id4, "failed")
+
+	job, err = store.GetLastCompletedFullRepoEmbeddingJob(ctx, createdRepo.ID)
+	require.NoError(t, err)
+	require.Equal(t, id2, job.ID)
+}
+
 func TestCancelRepoEmbeddingJob(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,202 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewClient creates an embeddings client for a self-hosted model server. The server can either
+// expose an OpenAI-compatible embeddings API or the API of Hugging Face Text Embeddings Inference
+// (TEI), as configured by the API format.
+func NewClient(httpClient *http.Client, config *conftypes.EmbeddingsConfig) *localEmbeddingsClient {
+	apiFormat := config.APIFormat
+	if apiFormat == "" {
+		apiFormat = conftypes.EmbeddingsAPIFormatOpenAI
+	}
+
+	return &localEmbeddingsClient{
+		httpClient:     httpClient,
+		model:          config.Model,
+		dimensions:     config.Dimensions,
+		endpoint:       config.Endpoint,
+		accessToken:    config.AccessToken,
+		apiFormat:      apiFormat,
+		maxInputTokens: config.MaxInputTokens,
+	}
+}
+
+type localEmbeddingsClient struct {
+	httpClient     *http.Client
+	model          string
+	dimensions     int
+	endpoint       string
+	accessToken    string
+	apiFormat      conftypes.EmbeddingsAPIFormat
+	maxInputTokens int
+}
+
+func (c *localEmbeddingsClient) GetDimensions() (int, error) {
+	if c.dimensions <= 0 {
+		return 0, errors.New("invalid config for embeddings.dimensions, must be > 0")
+	}
+	return c.dimensions, nil
+}
+
+func (c *localEmbeddingsClient) GetModelIdentifier() string {
+	return fmt.Sprintf("local/%s", c.model)
+}
+
+// GetEmbeddingsWithRetries tries to embed the given texts using the configured model server.
+// In case of failure, it retries the embedding procedure up to maxRetries.
+func (c *localEmbeddingsClient) GetEmbeddingsWithRetries(ctx context.Context, texts []string, maxRetries int) ([]float32, error) {
+	inputs := make([]string, 0, len(texts))
+	for _, text := range texts {
+		if text == "" {
+			// Most model servers reject empty inputs, so fail fast to avoid making
+			// tons of retryable requests.
+			return nil, errors.New("cannot generate embeddings for an empty string")
+		}
+
+		inputs = append(inputs, truncate(text, c.maxInputTokens))
+	}
+
+	embeddings, err := c.getEmbeddings(ctx, inputs)
+	if err == nil {
+		return embeddings, nil
+	}
+
+	for i := 0; i < maxRetries; i++ {
+		// Exponential delay
+		delay := time.Duration(int(math.Pow(float64(2), float64(i))))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay * time.Second):
+		}
+
+		embeddings, err = c.getEmbeddings(ctx, inputs)
+		if err == nil {
+			return embeddings, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (c *localEmbeddingsClient) getEmbeddings(ctx context.Context, texts []string) ([]float32, error) {
+	var vectors [][]float32
+	switch c.apiFormat {
+	case conftypes.EmbeddingsAPIFormatOpenAI:
+		var response openaiEmbeddingAPIResponse
+		if err := c.do(ctx, openaiEmbeddingAPIRequest{Model: c.model, Input: texts}, &response); err != nil {
+			return nil, err
+		}
+
+		// Ensure embedding responses are sorted in the original order.
+		sort.Slice(response.Data, func(i, j int) bool {
+			return response.Data[i].Index < response.Data[j].Index
+		})
+
+		for _, data := range response.Data {
+			vectors = append(vectors, data.Embedding)
+		}
+
+	case conftypes.EmbeddingsAPIFormatTEI:
+		// The TEI API responds with a list of vectors in the order of the inputs.
+		if err := c.do(ctx, teiEmbeddingAPIRequest{Inputs: texts, Truncate: true}, &vectors); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.Newf("unsupported embeddings API format %q", c.apiFormat)
+	}
+
+	if len(vectors) != len(texts) {
+		return nil, errors.Newf("expected %d embeddings, got %d", len(texts), len(vectors))
+	}
+
+	embeddings := make([]float32, 0, len(vectors)*c.dimensions)
+	for _, vector := range vectors {
+		if len(vector) != c.dimensions {
+			return nil, errors.Newf("expected embeddings with %d dimensions, got %d (check embeddings.dimensions)", c.dimensions, len(vector))
+		}
+		embeddings = append(embeddings, vector...)
+	}
+
+	return embeddings, nil
+}
+
+func (c *localEmbeddingsClient) do(ctx context.Context, request any, response any) error {
+	bodyBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("embeddings: %s %q: failed with status %d: %s", req.Method, req.URL.String(), resp.StatusCode, string(respBody))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// truncate shortens the given text to the estimated number of characters that fit into
+// maxTokens tokens, without splitting a multi-byte character. A maxTokens value of zero
+// disables truncation.
+func truncate(text string, maxTokens int) string {
+	maxLength := maxTokens * embeddings.CHARS_PER_TOKEN
+	if maxTokens <= 0 || len(text) <= maxLength {
+		return text
+	}
+
+	for maxLength > 0 && !utf8.RuneStart(text[maxLength]) {
+		maxLength--
+	}
+	return text[:maxLength]
+}
+
+type openaiEmbeddingAPIRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type openaiEmbeddingAPIResponse struct {
+	Data []openaiEmbeddingAPIResponseData `json:"data"`
+}
+
+type openaiEmbeddingAPIResponseData struct {
+	Index     int       `json:"index"`
+	Embedding []float32 `json:"embedding"`
+}
+
+type teiEmbeddingAPIRequest struct {
+	Inputs   []string `json:"inputs"`
+	Truncate bool     `json:"truncate"`
+}
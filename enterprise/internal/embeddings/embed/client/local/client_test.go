@@ -0,0 +1,119 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+)
+
+func TestLocal(t *testing.T) {
+	t.Run("errors on empty embedding string", func(t *testing.T) {
+		client := NewClient(http.DefaultClient, &conftypes.EmbeddingsConfig{})
+		invalidTexts := []string{"a", ""} // empty string is invalid
+		_, err := client.GetEmbeddingsWithRetries(context.Background(), invalidTexts, 10)
+		require.ErrorContains(t, err, "empty string")
+	})
+
+	t.Run("openai format", func(t *testing.T) {
+		var gotRequest openaiEmbeddingAPIRequest
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+
+			// Respond out of order to ensure the client sorts by index
+			json.NewEncoder(w).Encode(openaiEmbeddingAPIResponse{
+				Data: []openaiEmbeddingAPIResponseData{
+					{Index: 1, Embedding: []float32{3, 4}},
+					{Index: 0, Embedding: []float32{1, 2}},
+				},
+			})
+		}))
+		defer s.Close()
+
+		client := NewClient(s.Client(), &conftypes.EmbeddingsConfig{
+			Endpoint:    s.URL,
+			AccessToken: "secret",
+			Model:       "bge-small-en",
+			Dimensions:  2,
+		})
+		resp, err := client.GetEmbeddingsWithRetries(context.Background(), []string{"a", "b"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float32{1, 2, 3, 4}, resp)
+		require.Equal(t, openaiEmbeddingAPIRequest{Model: "bge-small-en", Input: []string{"a", "b"}}, gotRequest)
+		require.Equal(t, "local/bge-small-en", client.GetModelIdentifier())
+	})
+
+	t.Run("tei format", func(t *testing.T) {
+		var gotRequest teiEmbeddingAPIRequest
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Empty(t, r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+
+			json.NewEncoder(w).Encode([][]float32{{1, 2}, {3, 4}})
+		}))
+		defer s.Close()
+
+		client := NewClient(s.Client(), &conftypes.EmbeddingsConfig{
+			Endpoint:       s.URL,
+			Dimensions:     2,
+			APIFormat:      conftypes.EmbeddingsAPIFormatTEI,
+			MaxInputTokens: 1,
+		})
+		resp, err := client.GetEmbeddingsWithRetries(context.Background(), []string{"abcdefgh", "b"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float32{1, 2, 3, 4}, resp)
+		require.Equal(t, teiEmbeddingAPIRequest{Inputs: []string{"abcd", "b"}, Truncate: true}, gotRequest)
+	})
+
+	t.Run("errors on dimension mismatch", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			json.NewEncoder(w).Encode([][]float32{{1, 2, 3}})
+		}))
+		defer s.Close()
+
+		client := NewClient(s.Client(), &conftypes.EmbeddingsConfig{
+			Endpoint:   s.URL,
+			Dimensions: 2,
+			APIFormat:  conftypes.EmbeddingsAPIFormatTEI,
+		})
+		_, err := client.GetEmbeddingsWithRetries(context.Background(), []string{"a"}, 0)
+		require.ErrorContains(t, err, "expected embeddings with 2 dimensions, got 3")
+	})
+
+	t.Run("retries on server errors", func(t *testing.T) {
+		requests := 0
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			json.NewEncoder(w).Encode([][]float32{{1, 2}})
+		}))
+		defer s.Close()
+
+		client := NewClient(s.Client(), &conftypes.EmbeddingsConfig{
+			Endpoint:   s.URL,
+			Dimensions: 2,
+			APIFormat:  conftypes.EmbeddingsAPIFormatTEI,
+		})
+		resp, err := client.GetEmbeddingsWithRetries(context.Background(), []string{"a"}, 1)
+		require.NoError(t, err)
+		require.Equal(t, []float32{1, 2}, resp)
+		require.Equal(t, 2, requests)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "abcdefgh", truncate("abcdefgh", 0))
+	require.Equal(t, "abcdefgh", truncate("abcdefgh", 2))
+	require.Equal(t, "abcd", truncate("abcdefgh", 1))
+	// Does not split the multi-byte character at offset 3
+	require.Equal(t, "abc", truncate("abcéfgh", 1))
+}
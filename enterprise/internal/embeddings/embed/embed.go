@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	bgrepo "github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client/local"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client/openai"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client/sourcegraph"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/paths"
@@ -25,6 +26,8 @@ func NewEmbeddingsClient(config *conftypes.EmbeddingsConfig) (client.EmbeddingsC
 		return sourcegraph.NewClient(config), nil
 	case "openai":
 		return openai.NewClient(httpcli.ExternalClient, config), nil
+	case "local":
+		return local.NewClient(httpcli.ExternalClient, config), nil
 	default:
 		return nil, errors.Newf("invalid provider %q", config.Provider)
 	}
@@ -97,7 +100,7 @@ func EmbedRepo(
 		reportProgress(&stats)
 	}
 
-	codeIndex, codeIndexStats, err := embedFiles(ctx, codeFileNames, client, contextService, opts.ExcludePatterns, opts.SplitOptions, readLister, opts.MaxCodeEmbeddings, ranks, reportCodeProgress)
+	codeIndex, codeIndexStats, err := embedFiles(ctx, opts.RepoName, opts.Revision, codeFileNames, client, contextService, opts.ExcludePatterns, opts.SplitOptions, readLister, opts.MaxCodeEmbeddings, opts.batchSize(), ranks, reportCodeProgress)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -108,7 +111,7 @@ func EmbedRepo(
 		reportProgress(&stats)
 	}
 
-	textIndex, textIndexStats, err := embedFiles(ctx, textFileNames, client, contextService, opts.ExcludePatterns, opts.SplitOptions, readLister, opts.MaxTextEmbeddings, ranks, reportTextProgress)
+	textIndex, textIndexStats, err := embedFiles(ctx, opts.RepoName, opts.Revision, textFileNames, client, contextService, opts.ExcludePatterns, opts.SplitOptions, readLister, opts.MaxTextEmbeddings, opts.batchSize(), ranks, reportTextProgress)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -134,15 +137,28 @@ type EmbedRepoOpts struct {
 	MaxCodeEmbeddings int
 	MaxTextEmbeddings int
 
+	// BatchSize is the maximum number of chunks embedded in a single request. If not
+	// set, a default batch size is used.
+	BatchSize int
+
 	// If set, we already have an index for a previous commit.
 	IndexedRevision api.CommitID
 }
 
+func (o EmbedRepoOpts) batchSize() int {
+	if o.BatchSize > 0 {
+		return o.BatchSize
+	}
+	return embeddingsBatchSize
+}
+
 // embedFiles embeds file contents from the given file names. Since embedding models can only handle a certain amount of text (tokens) we cannot embed
 // entire files. So we split the file contents into chunks and get embeddings for the chunks in batches. Functions returns an EmbeddingIndex containing
 // the embeddings and metadata about the chunks the embeddings correspond to.
 func embedFiles(
 	ctx context.Context,
+	repoName api.RepoName,
+	revision api.CommitID,
 	files []FileEntry,
 	client client.EmbeddingsClient,
 	contextService ContextService,
@@ -150,6 +166,7 @@ func embedFiles(
 	splitOptions codeintelContext.SplitOptions,
 	reader FileReader,
 	maxEmbeddingVectors int,
+	batchSize int,
 	repoPathRanks types.RepoPathRanks,
 	reportProgress func(bgrepo.EmbedFilesStats),
 ) (embeddings.EmbeddingIndex, bgrepo.EmbedFilesStats, error) {
@@ -177,7 +194,7 @@ func embedFiles(
 		batchChunks := make([]string, len(batch))
 		for idx, chunk := range batch {
 			batchChunks[idx] = chunk.Content
-			index.RowMetadata = append(index.RowMetadata, embeddings.RepoEmbeddingRowMetadata{FileName: chunk.FileName, StartLine: chunk.StartLine, EndLine: chunk.EndLine})
+			index.RowMetadata = append(index.RowMetadata, embeddings.RepoEmbeddingRowMetadata{FileName: chunk.FileName, StartLine: chunk.StartLine, EndLine: chunk.EndLine, Symbol: chunk.Symbol})
 
 			// Unknown documents have rank 0. Zoekt is a bit smarter about this, assigning 0
 			// to "unimportant" files and the average for unknown files. We should probably
@@ -201,7 +218,7 @@ func embedFiles(
 
 	addToBatch := func(chunk codeintelContext.EmbeddableChunk) error {
 		batch = append(batch, chunk)
-		if len(batch) >= embeddingsBatchSize {
+		if len(batch) >= batchSize {
 			// Flush if we've hit batch size
 			return flush()
 		}
@@ -240,7 +257,7 @@ func embedFiles(
 		}
 
 		// At this point, we have determined that we want to embed this file.
-		chunks, err := contextService.SplitIntoEmbeddableChunks(ctx, string(contentBytes), file.Name, splitOptions)
+		chunks, err := contextService.SplitIntoEmbeddableChunks(ctx, repoName, revision, string(contentBytes), file.Name, splitOptions)
 		if err != nil {
 			return embeddings.EmbeddingIndex{}, bgrepo.EmbedFilesStats{}, errors.Wrap(err, "error while splitting file")
 		}
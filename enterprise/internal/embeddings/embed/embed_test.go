@@ -20,7 +20,7 @@ func mockFile(lines ...string) []byte {
 	return []byte(strings.Join(lines, "\n"))
 }
 
-func defaultSplitter(ctx context.Context, text, fileName string, splitOptions codeintelContext.SplitOptions) ([]codeintelContext.EmbeddableChunk, error) {
+func defaultSplitter(ctx context.Context, repo api.RepoName, revision api.CommitID, text, fileName string, splitOptions codeintelContext.SplitOptions) ([]codeintelContext.EmbeddableChunk, error) {
 	return codeintelContext.SplitIntoEmbeddableChunks(text, fileName, splitOptions), nil
 }
 
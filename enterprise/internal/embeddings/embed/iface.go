@@ -4,8 +4,9 @@ import (
 	"context"
 
 	codeintelContext "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/context"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 )
 
 type ContextService interface {
-	SplitIntoEmbeddableChunks(ctx context.Context, text string, fileName string, splitOptions codeintelContext.SplitOptions) ([]codeintelContext.EmbeddableChunk, error)
+	SplitIntoEmbeddableChunks(ctx context.Context, repo api.RepoName, revision api.CommitID, text string, fileName string, splitOptions codeintelContext.SplitOptions) ([]codeintelContext.EmbeddableChunk, error)
 }
@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	context1 "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/context"
+	api "github.com/sourcegraph/sourcegraph/internal/api"
 )
 
 // MockContextService is a mock implementation of the ContextService
@@ -29,7 +30,7 @@ type MockContextService struct {
 func NewMockContextService() *MockContextService {
 	return &MockContextService{
 		SplitIntoEmbeddableChunksFunc: &ContextServiceSplitIntoEmbeddableChunksFunc{
-			defaultHook: func(context.Context, string, string, context1.SplitOptions) (r0 []context1.EmbeddableChunk, r1 error) {
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) (r0 []context1.EmbeddableChunk, r1 error) {
 				return
 			},
 		},
@@ -41,7 +42,7 @@ func NewMockContextService() *MockContextService {
 func NewStrictMockContextService() *MockContextService {
 	return &MockContextService{
 		SplitIntoEmbeddableChunksFunc: &ContextServiceSplitIntoEmbeddableChunksFunc{
-			defaultHook: func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
 				panic("unexpected invocation of MockContextService.SplitIntoEmbeddableChunks")
 			},
 		},
@@ -63,24 +64,24 @@ func NewMockContextServiceFrom(i ContextService) *MockContextService {
 // the SplitIntoEmbeddableChunks method of the parent MockContextService
 // instance is invoked.
 type ContextServiceSplitIntoEmbeddableChunksFunc struct {
-	defaultHook func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)
-	hooks       []func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)
 	history     []ContextServiceSplitIntoEmbeddableChunksFuncCall
 	mutex       sync.Mutex
 }
 
 // SplitIntoEmbeddableChunks delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockContextService) SplitIntoEmbeddableChunks(v0 context.Context, v1 string, v2 string, v3 context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
-	r0, r1 := m.SplitIntoEmbeddableChunksFunc.nextHook()(v0, v1, v2, v3)
-	m.SplitIntoEmbeddableChunksFunc.appendCall(ContextServiceSplitIntoEmbeddableChunksFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockContextService) SplitIntoEmbeddableChunks(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string, v4 string, v5 context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
+	r0, r1 := m.SplitIntoEmbeddableChunksFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.SplitIntoEmbeddableChunksFunc.appendCall(ContextServiceSplitIntoEmbeddableChunksFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // SplitIntoEmbeddableChunks method of the parent MockContextService
 // instance is invoked and the hook queue is empty.
-func (f *ContextServiceSplitIntoEmbeddableChunksFunc) SetDefaultHook(hook func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)) {
+func (f *ContextServiceSplitIntoEmbeddableChunksFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)) {
 	f.defaultHook = hook
 }
 
@@ -89,7 +90,7 @@ func (f *ContextServiceSplitIntoEmbeddableChunksFunc) SetDefaultHook(hook func(c
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *ContextServiceSplitIntoEmbeddableChunksFunc) PushHook(hook func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)) {
+func (f *ContextServiceSplitIntoEmbeddableChunksFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -98,19 +99,19 @@ func (f *ContextServiceSplitIntoEmbeddableChunksFunc) PushHook(hook func(context
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *ContextServiceSplitIntoEmbeddableChunksFunc) SetDefaultReturn(r0 []context1.EmbeddableChunk, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *ContextServiceSplitIntoEmbeddableChunksFunc) PushReturn(r0 []context1.EmbeddableChunk, r1 error) {
-	f.PushHook(func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
 		return r0, r1
 	})
 }
 
-func (f *ContextServiceSplitIntoEmbeddableChunksFunc) nextHook() func(context.Context, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
+func (f *ContextServiceSplitIntoEmbeddableChunksFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string, string, context1.SplitOptions) ([]context1.EmbeddableChunk, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -150,13 +151,19 @@ type ContextServiceSplitIntoEmbeddableChunksFuncCall struct {
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 api.CommitID
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 context1.SplitOptions
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 context1.SplitOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 []context1.EmbeddableChunk
@@ -168,7 +175,7 @@ type ContextServiceSplitIntoEmbeddableChunksFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c ContextServiceSplitIntoEmbeddableChunksFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -19,10 +19,11 @@ import (
 // way that affects how it's decoded, we add a new format version and update CurrentFormatVersion to the latest.
 type IndexFormatVersion int
 
-const CurrentFormatVersion = EmbeddingModelVersion
+const CurrentFormatVersion = ANNIndexVersion
 const (
 	InitialVersion        IndexFormatVersion = iota // The initial format, before we started tracking format versions
 	EmbeddingModelVersion                           // Added the model name used to create embeddings
+	ANNIndexVersion                                 // Added the optional approximate nearest neighbor index
 )
 
 func DownloadIndex[T any](ctx context.Context, uploadStore uploadstore.Store, key string) (_ *T, err error) {
@@ -88,6 +89,7 @@ func UpdateRepoEmbeddingIndex(
 	new *RepoEmbeddingIndex,
 	toRemove []string,
 	ranks types.RepoPathRanks,
+	annOpts ANNIndexOptions,
 ) error {
 	// update revision
 	previous.Revision = new.Revision
@@ -106,6 +108,9 @@ func UpdateRepoEmbeddingIndex(
 	previous.CodeIndex.append(new.CodeIndex)
 	previous.TextIndex.append(new.TextIndex)
 
+	// rebuild the ANN indexes for the updated rows
+	previous.BuildANNIndexes(annOpts)
+
 	// re-upload
 	return UploadRepoEmbeddingIndex(ctx, uploadStore, key, previous)
 }
@@ -218,6 +223,20 @@ func (d *decoder) decode() (*RepoEmbeddingIndex, error) {
 			}
 			ei.Embeddings = append(ei.Embeddings, Quantize(embeddingSlice)...)
 		}
+
+		if d.formatVersion >= ANNIndexVersion {
+			var hasANN bool
+			if err := d.dec.Decode(&hasANN); err != nil {
+				return nil, err
+			}
+
+			if hasANN {
+				ei.ANN = &ANNIndex{}
+				if err := d.dec.Decode(ei.ANN); err != nil {
+					return nil, err
+				}
+			}
+		}
 	}
 
 	return rei, nil
@@ -294,6 +313,18 @@ func (e *encoder) encode(rei *RepoEmbeddingIndex) error {
 				return err
 			}
 		}
+
+		if e.formatVersion >= ANNIndexVersion {
+			if err := e.enc.Encode(ei.ANN != nil); err != nil {
+				return err
+			}
+
+			if ei.ANN != nil {
+				if err := e.enc.Encode(ei.ANN); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	return nil
@@ -129,6 +129,39 @@ func TestRepoEmbeddingIndexStorage(t *testing.T) {
 	require.Equal(t, index, downloadedIndex)
 }
 
+func TestRepoEmbeddingIndexStorageWithANNIndex(t *testing.T) {
+	index := &RepoEmbeddingIndex{
+		RepoName: api.RepoName("repo"),
+		Revision: api.CommitID("commit"),
+		CodeIndex: EmbeddingIndex{
+			Embeddings:      []int8{0, 1, 2, 3, 4, 5},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "a.go", StartLine: 0, EndLine: 1}, {FileName: "b.go", StartLine: 0, EndLine: 1}},
+			ANN: &ANNIndex{
+				NumRows:   2,
+				Centroids: []int8{0, 1, 2, 3, 4, 5},
+				Lists:     [][]int32{{1}, {0}},
+			},
+		},
+		TextIndex: EmbeddingIndex{
+			Embeddings:      []int8{10, 21, 32},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "b.py", StartLine: 0, EndLine: 1}},
+		},
+	}
+
+	ctx := context.Background()
+	uploadStore := newMockUploadStore()
+
+	err := UploadRepoEmbeddingIndex(ctx, uploadStore, "index", index)
+	require.NoError(t, err)
+
+	downloadedIndex, err := DownloadRepoEmbeddingIndex(ctx, uploadStore, "index")
+	require.NoError(t, err)
+
+	require.Equal(t, index, downloadedIndex)
+}
+
 func TestIndexFormatVersion(t *testing.T) {
 	index := &RepoEmbeddingIndex{
 		RepoName: api.RepoName("repo"),
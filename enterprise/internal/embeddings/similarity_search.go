@@ -97,6 +97,38 @@ func (index *EmbeddingIndex) SimilaritySearch(
 	numRows := len(index.RowMetadata)
 	// Cannot request more results than there are rows.
 	numResults = min(numRows, numResults)
+
+	var neighbors []nearestNeighbor
+	if index.useApproximateSearch(opts) {
+		neighbors = index.approximateSimilaritySearch(query, numResults, opts)
+	} else {
+		neighbors = index.exactSimilaritySearch(query, numResults, workerOptions, opts)
+	}
+	// Sort the neighbors according to the score (descending).
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].scoreDetails.Score > neighbors[j].scoreDetails.Score })
+
+	// Take top neighbors and return them as results.
+	results := make([]EmbeddingSearchResult, min(numResults, len(neighbors)))
+
+	for idx := 0; idx < len(results); idx++ {
+		metadata := index.RowMetadata[neighbors[idx].index]
+		results[idx] = EmbeddingSearchResult{
+			RepoName:     repoName,
+			Revision:     revision,
+			FileName:     metadata.FileName,
+			StartLine:    metadata.StartLine,
+			EndLine:      metadata.EndLine,
+			Symbol:       metadata.Symbol,
+			ScoreDetails: neighbors[idx].scoreDetails,
+		}
+	}
+
+	return results
+}
+
+// exactSimilaritySearch scores every row of the index against the query vector.
+func (index *EmbeddingIndex) exactSimilaritySearch(query []int8, numResults int, workerOptions WorkerOptions, opts SearchOptions) []nearestNeighbor {
+	numRows := len(index.RowMetadata)
 	// We need at least 1 worker.
 	numWorkers := max(1, workerOptions.NumWorkers)
 
@@ -126,25 +158,7 @@ func (index *EmbeddingIndex) SimilaritySearch(
 			neighbors = append(neighbors, heap.neighbors...)
 		}
 	}
-	// And re-sort it according to the score (descending).
-	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].scoreDetails.Score > neighbors[j].scoreDetails.Score })
-
-	// Take top neighbors and return them as results.
-	results := make([]EmbeddingSearchResult, numResults)
-
-	for idx := 0; idx < min(numResults, len(neighbors)); idx++ {
-		metadata := index.RowMetadata[neighbors[idx].index]
-		results[idx] = EmbeddingSearchResult{
-			RepoName:     repoName,
-			Revision:     revision,
-			FileName:     metadata.FileName,
-			StartLine:    metadata.StartLine,
-			EndLine:      metadata.EndLine,
-			ScoreDetails: neighbors[idx].scoreDetails,
-		}
-	}
-
-	return results
+	return neighbors
 }
 
 func (index *EmbeddingIndex) partialSimilaritySearch(query []int8, numResults int, partialRows partialRows, opts SearchOptions) *nearestNeighborsHeap {
@@ -218,4 +232,10 @@ func max(a, b int) int {
 
 type SearchOptions struct {
 	UseDocumentRanks bool
+	// ApproximateSearchProbes is the number of lists of the ANN index to search. Higher values
+	// improve recall at the cost of latency. If zero, the index is always searched exhaustively.
+	ApproximateSearchProbes int
+	// ExactSearchMaxRows is the number of rows up to which the index is searched exhaustively,
+	// even if it has an ANN index.
+	ExactSearchMaxRows int
 }
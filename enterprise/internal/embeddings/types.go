@@ -13,6 +13,9 @@ type EmbeddingIndex struct {
 	ColumnDimension int
 	RowMetadata     []RepoEmbeddingRowMetadata
 	Ranks           []float32
+	// ANN is an optional approximate nearest neighbor index over the rows. It is
+	// discarded whenever the rows change.
+	ANN *ANNIndex
 }
 
 // Row returns the embeddings for the nth row in the index
@@ -21,7 +24,11 @@ func (index *EmbeddingIndex) Row(n int) []int8 {
 }
 
 func (index *EmbeddingIndex) EstimateSize() int64 {
-	return int64(len(index.Embeddings) + len(index.RowMetadata)*(16+8+8) + len(index.Ranks)*4)
+	size := int64(len(index.Embeddings) + len(index.RowMetadata)*(16+8+8) + len(index.Ranks)*4)
+	if index.ANN != nil {
+		size += index.ANN.estimateSize()
+	}
+	return size
 }
 
 // Filter removes all files from the index that are in the set and updates the ranks
@@ -49,18 +56,22 @@ func (index *EmbeddingIndex) filter(set map[string]struct{}, ranks types.RepoPat
 	index.RowMetadata = index.RowMetadata[:cursor]
 	index.Ranks = index.Ranks[:cursor]
 	index.Embeddings = index.Embeddings[:cursor*index.ColumnDimension]
+	index.ANN = nil
 }
 
 func (index *EmbeddingIndex) append(other EmbeddingIndex) {
 	index.RowMetadata = append(index.RowMetadata, other.RowMetadata...)
 	index.Ranks = append(index.Ranks, other.Ranks...)
 	index.Embeddings = append(index.Embeddings, other.Embeddings...)
+	index.ANN = nil
 }
 
 type RepoEmbeddingRowMetadata struct {
 	FileName  string `json:"fileName"`
 	StartLine int    `json:"startLine"`
 	EndLine   int    `json:"endLine"`
+	// Symbol is the name of the symbol enclosing the row, if known.
+	Symbol string `json:"symbol,omitempty"`
 }
 
 type RepoEmbeddingIndex struct {
@@ -109,6 +120,7 @@ type EmbeddingSearchResult struct {
 	FileName  string `json:"fileName"`
 	StartLine int    `json:"startLine"`
 	EndLine   int    `json:"endLine"`
+	Symbol    string `json:"symbol,omitempty"`
 
 	ScoreDetails SearchScoreDetails `json:"scoreDetails"`
 }
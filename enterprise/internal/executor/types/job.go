@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"strconv"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 )
 
 // Job describes a series of steps to perform within an executor.
@@ -280,6 +282,10 @@ type DockerStep struct {
 
 	// Env specifies a set of NAME=value pairs to supply to the docker command.
 	Env []string `json:"env"`
+
+	// Resources optionally specifies the compute resources of this step. It is currently
+	// only honored by executors running on Kubernetes.
+	Resources *executor.StepResources `json:"resources,omitempty"`
 }
 
 // CliStep is a step that runs a src-cli command.
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -24,3 +25,53 @@ func FormatPostKey(index int) string {
 func IsPreStepKey(key string) bool {
 	return strings.HasSuffix(key, ".pre")
 }
+
+// IsPostStepKey returns true if the given key is a post step key.
+func IsPostStepKey(key string) bool {
+	return strings.HasSuffix(key, ".post")
+}
+
+// ParseRunKey returns the index of the step of the given run step key. The key may be
+// prefixed by the runtime, such as "step.docker.step.0.run". If the key is not a run step
+// key, a false-valued flag is returned.
+func ParseRunKey(key string) (int, bool) {
+	if !strings.HasSuffix(key, ".run") {
+		return 0, false
+	}
+	key = strings.TrimSuffix(key, ".run")
+	i := strings.LastIndex(key, "step.")
+	if i < 0 || (i > 0 && key[i-1] != '.') {
+		return 0, false
+	}
+	index, err := strconv.Atoi(key[i+len("step."):])
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// RunStepInputs returns the patterns of the files the run step with the given index reads
+// besides the repository, relative to the working directory of the job. These are written
+// by the pre step of batcheshelper, including the scripts and the list of elements of
+// steps with a foreach list.
+func RunStepInputs(index int) []string {
+	return []string{
+		fmt.Sprintf("step%d.sh", index),
+		fmt.Sprintf("step%d.*.sh", index),
+		fmt.Sprintf("foreach%d.json", index),
+		fmt.Sprintf("step%dfiles", index),
+		"workspace-files",
+	}
+}
+
+// RunStepOutputs returns the patterns of the files the run step with the given index writes
+// besides the repository, relative to the working directory of the job. These are read by
+// the post step of batcheshelper, including the output of every element of steps with a
+// foreach list.
+func RunStepOutputs(index int) []string {
+	return []string{
+		fmt.Sprintf("stdout%d.log", index),
+		fmt.Sprintf("stderr%d.log", index),
+		fmt.Sprintf("stdout%d.*.log", index),
+	}
+}
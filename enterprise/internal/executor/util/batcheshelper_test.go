@@ -27,3 +27,31 @@ func TestIsPreStepKey(t *testing.T) {
 	actual := util.IsPreStepKey("step.1.pre")
 	assert.True(t, actual)
 }
+
+func TestIsPostStepKey(t *testing.T) {
+	actual := util.IsPostStepKey("step.1.post")
+	assert.True(t, actual)
+}
+
+func TestParseRunKey(t *testing.T) {
+	for key, expected := range map[string]int{
+		"step.12.run":                12,
+		"step.docker.step.3.run":     3,
+		"step.kubernetes.step.0.run": 0,
+		util.FormatRunKey(7):         7,
+	} {
+		index, ok := util.ParseRunKey(key)
+		assert.True(t, ok, key)
+		assert.Equal(t, expected, index, key)
+	}
+
+	for _, key := range []string{"step.1.pre", "step.1.post", "step.x.run", "step.docker.step.1.pre", "step.docker.mystep.run", "batch-exec"} {
+		_, ok := util.ParseRunKey(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestRunStepInputsAndOutputs(t *testing.T) {
+	assert.Equal(t, []string{"step2.sh", "step2.*.sh", "foreach2.json", "step2files", "workspace-files"}, util.RunStepInputs(2))
+	assert.Equal(t, []string{"stdout2.log", "stderr2.log", "stdout2.*.log"}, util.RunStepOutputs(2))
+}
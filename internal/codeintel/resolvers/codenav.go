@@ -33,6 +33,11 @@ type GitBlobLSIFDataResolver interface {
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Prototypes(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	IncomingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyConnectionResolver, error)
+	OutgoingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyConnectionResolver, error)
+	Supertypes(ctx context.Context, args *LSIFTypeHierarchyArgs) ([]TypeHierarchyItemResolver, error)
+	Subtypes(ctx context.Context, args *LSIFTypeHierarchyArgs) ([]TypeHierarchyItemResolver, error)
+	RenameImpact(ctx context.Context, args *LSIFRenameImpactArgs) (RenameImpactResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	VisibleIndexes(ctx context.Context) (_ *[]PreciseIndexResolver, err error)
 	Snapshot(ctx context.Context, args *struct{ IndexID graphql.ID }) (_ *[]SnapshotDataResolver, err error)
@@ -61,6 +66,70 @@ type LSIFPagedQueryPositionArgs struct {
 	Filter *string
 }
 
+type LSIFCallHierarchyArgs struct {
+	Line      int32
+	Character int32
+	Depth     int32
+	PagedConnectionArgs
+}
+
+type (
+	CallHierarchyConnectionResolver = PagedConnectionWithTotalCountResolver[CallHierarchyCallResolver]
+)
+
+type CallHierarchyCallResolver interface {
+	From() CallHierarchyItemResolver
+	To() CallHierarchyItemResolver
+	CallSites() []LocationResolver
+	Depth() int32
+}
+
+type CallHierarchyItemResolver interface {
+	Symbol() string
+	Name() string
+	Location() LocationResolver
+}
+
+type LSIFTypeHierarchyArgs struct {
+	Line      int32
+	Character int32
+	Depth     int32
+}
+
+type TypeHierarchyItemResolver interface {
+	Symbol() string
+	Name() string
+	Location() LocationResolver
+	Children() []TypeHierarchyItemResolver
+	Cycle() bool
+}
+
+type LSIFRenameImpactArgs struct {
+	Line      int32
+	Character int32
+	NewName   string
+}
+
+type RenameImpactResolver interface {
+	OldName() string
+	NewName() string
+	Repositories() []RenameImpactRepositoryResolver
+}
+
+type RenameImpactRepositoryResolver interface {
+	Commit() GitCommitResolver
+	Files() []RenameImpactFileResolver
+	Diff() string
+}
+
+type RenameImpactFileResolver interface {
+	Blob() GitTreeEntryResolver
+	Definitions() []RangeResolver
+	References() []RangeResolver
+	Mismatches() []RangeResolver
+	Diff() string
+}
+
 type (
 	CodeIntelligenceRangeConnectionResolver = ConnectionResolver[CodeIntelligenceRangeResolver]
 )
@@ -111,6 +180,8 @@ func (m Markdown) HTML() (string, error) {
 
 type GitTreeLSIFDataResolver interface {
 	Diagnostics(ctx context.Context, args *LSIFDiagnosticsArgs) (DiagnosticConnectionResolver, error)
+	DeadCode(ctx context.Context, args *LSIFDeadCodeArgs) (DeadCodeConnectionResolver, error)
+	APIChanges(ctx context.Context, args *LSIFAPIChangesArgs) (APIChangeConnectionResolver, error)
 }
 
 type (
@@ -125,3 +196,41 @@ type DiagnosticResolver interface {
 	Message() (*string, error)
 	Location(ctx context.Context) (LocationResolver, error)
 }
+
+type LSIFDeadCodeArgs struct {
+	ConnectionArgs
+	Exported *bool
+}
+
+type DeadCodeConnectionResolver = PagedConnectionWithTotalCountResolver[DeadCodeDefinitionResolver]
+
+type DeadCodeDefinitionResolver interface {
+	Symbol() string
+	Name() string
+	Exported() bool
+	Location() LocationResolver
+}
+
+type LSIFAPIChangesArgs struct {
+	ConnectionArgs
+	Base string
+	Kind *string
+}
+
+type APIChangeConnectionResolver = PagedConnectionWithTotalCountResolver[APIChangeResolver]
+
+type APIChangeResolver interface {
+	Kind() string
+	Name() string
+	Base() APISymbolResolver
+	Head() APISymbolResolver
+	SignatureChanged() bool
+	DocumentationChanged() bool
+}
+
+type APISymbolResolver interface {
+	Symbol() string
+	Signature() string
+	Documentation() string
+	Location() LocationResolver
+}
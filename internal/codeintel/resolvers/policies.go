@@ -21,6 +21,9 @@ type PoliciesServiceResolver interface {
 	// Filter previews
 	PreviewRepositoryFilter(ctx context.Context, args *PreviewRepositoryFilterArgs) (RepositoryFilterPreviewResolver, error)
 	PreviewGitObjectFilter(ctx context.Context, id graphql.ID, args *PreviewGitObjectFilterArgs) (GitObjectFilterPreviewResolver, error)
+
+	// Policy simulation
+	SimulateCodeIntelligenceConfigurationPolicy(ctx context.Context, args *SimulateCodeIntelligenceConfigurationPolicyArgs) (CodeIntelligenceConfigurationPolicySimulationResolver, error)
 }
 
 type CodeIntelligenceConfigurationPoliciesArgs struct {
@@ -76,6 +79,14 @@ type PreviewGitObjectFilterArgs struct {
 	CountObjectsYoungerThanHours *int32
 }
 
+type SimulateCodeIntelligenceConfigurationPolicyArgs struct {
+	ID           *graphql.ID
+	Repository   *graphql.ID
+	Repositories *[]graphql.ID
+	First        *int32
+	CodeIntelConfigurationPolicy
+}
+
 type (
 	CodeIntelligenceConfigurationPolicyConnectionResolver = PagedConnectionWithTotalCountResolver[CodeIntelligenceConfigurationPolicyResolver]
 )
@@ -111,6 +122,39 @@ type GitObjectFilterPreviewResolver interface {
 	TotalCountYoungerThanThreshold() *int32
 }
 
+type CodeIntelligenceConfigurationPolicySimulationResolver interface {
+	Repositories() []CodeIntelligenceRepositoryPolicySimulationResolver
+	TotalRepositoryCount() int32
+	Summary() CodeIntelligenceRetentionSimulationSummaryResolver
+}
+
+type CodeIntelligenceRepositoryPolicySimulationResolver interface {
+	Repository() RepositoryResolver
+	IndexedCommits() []CodeIntelGitObjectResolver
+	Uploads() []CodeIntelligenceSimulatedUploadResolver
+	Summary() CodeIntelligenceRetentionSimulationSummaryResolver
+}
+
+type CodeIntelligenceSimulatedUploadResolver interface {
+	UploadID() int32
+	Commit() string
+	Root() string
+	Indexer() string
+	UploadedAt() gqlutil.DateTime
+	UploadSizeBytes() *float64
+	RetainedBefore() bool
+	RetainedAfter() bool
+}
+
+type CodeIntelligenceRetentionSimulationSummaryResolver interface {
+	RetainedCount() int32
+	ExpiredCount() int32
+	NewlyRetainedCount() int32
+	NewlyExpiredCount() int32
+	NewlyRetainedBytes() float64
+	NewlyExpiredBytes() float64
+}
+
 type CodeIntelGitObjectResolver interface {
 	Name() string
 	Rev() string
@@ -209,6 +209,10 @@ func (r *Resolver) PreviewGitObjectFilter(ctx context.Context, id graphql.ID, ar
 	return r.policiesRootResolver.PreviewGitObjectFilter(ctx, id, args)
 }
 
+func (r *Resolver) SimulateCodeIntelligenceConfigurationPolicy(ctx context.Context, args *SimulateCodeIntelligenceConfigurationPolicyArgs) (_ CodeIntelligenceConfigurationPolicySimulationResolver, err error) {
+	return r.policiesRootResolver.SimulateCodeIntelligenceConfigurationPolicy(ctx, args)
+}
+
 func (r *Resolver) RankingSummary(ctx context.Context) (_ GlobalRankingSummaryResolver, err error) {
 	return r.rankingServiceResolver.RankingSummary(ctx)
 }
@@ -32,6 +32,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       *string
 	Language       *string
 	RepositoryName *string
+	Reachability   *string
 }
 
 type VulnerabilityResolver interface {
@@ -74,6 +75,13 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	Reachability() *string
+	CallSites() []VulnerabilityCallSiteResolver
+}
+
+type VulnerabilityCallSiteResolver interface {
+	Path() string
+	Range() RangeResolver
 }
 
 type VulnerabilityMatchesSummaryCountResolver interface {
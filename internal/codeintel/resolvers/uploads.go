@@ -183,6 +183,7 @@ type CodeIntelRepositorySummaryResolver interface {
 	LastIndexScan() *gqlutil.DateTime
 	AvailableIndexers() []InferredAvailableIndexersResolver
 	LimitError() *string
+	InferenceScriptError() *string
 }
 
 type InferredAvailableIndexersResolver interface {
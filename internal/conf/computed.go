@@ -659,7 +659,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 	// Additionally, completions in App are disabled if there is no dotcom auth token
 	// and the user hasn't provided their own api token.
 	if deploy.IsApp() {
-		if (siteConfig.App == nil || len(siteConfig.App.DotcomAuthToken) == 0) && (siteConfig.Completions == nil || siteConfig.Completions.AccessToken == "") {
+		if (siteConfig.App == nil || len(siteConfig.App.DotcomAuthToken) == 0) && (siteConfig.Completions == nil || (siteConfig.Completions.AccessToken == "" && siteConfig.Completions.Provider != string(conftypes.CompletionsProviderNameLocal))) {
 			return nil
 		}
 	}
@@ -772,6 +772,21 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 		if completionsConfig.CompletionModel == "" {
 			completionsConfig.CompletionModel = "claude-instant-v1"
 		}
+	} else if completionsConfig.Provider == string(conftypes.CompletionsProviderNameLocal) {
+		// There is no sensible default for a self-hosted model server, so we cannot
+		// use completions without an endpoint. The access token is optional.
+		if completionsConfig.Endpoint == "" {
+			return nil
+		}
+
+		// Self-hosted deployments often serve a single model, so use the chat model
+		// for everything unless configured otherwise.
+		if completionsConfig.FastChatModel == "" {
+			completionsConfig.FastChatModel = completionsConfig.ChatModel
+		}
+		if completionsConfig.CompletionModel == "" {
+			completionsConfig.CompletionModel = completionsConfig.ChatModel
+		}
 	}
 
 	// Make sure models are always treated case-insensitive.
@@ -798,6 +813,18 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 		PerUserDailyLimit:                completionsConfig.PerUserDailyLimit,
 		PerUserCodeCompletionsDailyLimit: completionsConfig.PerUserCodeCompletionsDailyLimit,
 	}
+	for _, template := range completionsConfig.PromptTemplates {
+		computedConfig.PromptTemplates = append(computedConfig.PromptTemplates, conftypes.CompletionsPromptTemplate{
+			// Models are treated case-insensitive, see above.
+			Model:                  strings.ToLower(template.Model),
+			HumanMessagePrefix:     template.HumanMessagePrefix,
+			HumanMessageSuffix:     template.HumanMessageSuffix,
+			AssistantMessagePrefix: template.AssistantMessagePrefix,
+			AssistantMessageSuffix: template.AssistantMessageSuffix,
+			StopSequences:          template.StopSequences,
+			ContextWindow:          template.ContextWindow,
+		})
+	}
 
 	return computedConfig
 }
@@ -811,9 +838,9 @@ func GetEmbeddingsConfig(siteConfig schema.SiteConfiguration) *conftypes.Embeddi
 	}
 
 	// Additionally Embeddings in App are disabled if there is no dotcom auth token
-	// and the user hasn't provided their own api token.
+	// and the user hasn't provided their own api token or local model server.
 	if deploy.IsApp() {
-		if (siteConfig.App == nil || len(siteConfig.App.DotcomAuthToken) == 0) && (siteConfig.Embeddings == nil || siteConfig.Embeddings.AccessToken == "") {
+		if (siteConfig.App == nil || len(siteConfig.App.DotcomAuthToken) == 0) && (siteConfig.Embeddings == nil || (siteConfig.Embeddings.AccessToken == "" && siteConfig.Embeddings.Provider != string(conftypes.EmbeddingsProviderNameLocal))) {
 			return nil
 		}
 	}
@@ -922,17 +949,31 @@ func GetEmbeddingsConfig(siteConfig schema.SiteConfiguration) *conftypes.Embeddi
 		if embeddingsConfig.Dimensions <= 0 && embeddingsConfig.Model == "text-embedding-ada-002" {
 			embeddingsConfig.Dimensions = 1536
 		}
+	} else if embeddingsConfig.Provider == string(conftypes.EmbeddingsProviderNameLocal) {
+		// There is no sensible default for a self-hosted model server, so we cannot
+		// use embeddings without an endpoint and the dimensionality of the model.
+		if embeddingsConfig.Endpoint == "" || embeddingsConfig.Dimensions <= 0 {
+			return nil
+		}
+
+		// Default to the most common API of self-hosted model servers.
+		if embeddingsConfig.ApiFormat == "" {
+			embeddingsConfig.ApiFormat = string(conftypes.EmbeddingsAPIFormatOpenAI)
+		}
 	} else {
 		// Unknown provider value.
 		return nil
 	}
 
 	computedConfig := &conftypes.EmbeddingsConfig{
-		Provider:    conftypes.EmbeddingsProviderName(embeddingsConfig.Provider),
-		AccessToken: embeddingsConfig.AccessToken,
-		Model:       embeddingsConfig.Model,
-		Endpoint:    embeddingsConfig.Endpoint,
-		Dimensions:  embeddingsConfig.Dimensions,
+		Provider:       conftypes.EmbeddingsProviderName(embeddingsConfig.Provider),
+		AccessToken:    embeddingsConfig.AccessToken,
+		Model:          embeddingsConfig.Model,
+		Endpoint:       embeddingsConfig.Endpoint,
+		Dimensions:     embeddingsConfig.Dimensions,
+		APIFormat:      conftypes.EmbeddingsAPIFormat(embeddingsConfig.ApiFormat),
+		BatchSize:      embeddingsConfig.BatchSize,
+		MaxInputTokens: embeddingsConfig.MaxInputTokens,
 		// This is definitely set at this point.
 		Incremental:                *embeddingsConfig.Incremental,
 		ExcludedFilePathPatterns:   embeddingsConfig.ExcludedFilePathPatterns,
@@ -947,6 +988,13 @@ func GetEmbeddingsConfig(siteConfig schema.SiteConfiguration) *conftypes.Embeddi
 		computedConfig.MinimumInterval = d
 	}
 
+	computedConfig.FullIndexInterval = defaultFullIndexInterval
+	if embeddingsConfig.FullIndexInterval != "" {
+		if d, err := time.ParseDuration(embeddingsConfig.FullIndexInterval); err == nil {
+			computedConfig.FullIndexInterval = d
+		}
+	}
+
 	return computedConfig
 }
 
@@ -972,6 +1020,7 @@ func getSourcegraphProviderAccessToken(accessToken string, config schema.SiteCon
 const (
 	defaultPolicyRepositoryMatchLimit = 5000
 	defaultMinimumInterval            = 24 * time.Hour
+	defaultFullIndexInterval          = 7 * 24 * time.Hour
 	defaultMaxCodeEmbeddingsPerRepo   = 3_072_000
 	defaultMaxTextEmbeddingsPerRepo   = 512_000
 )
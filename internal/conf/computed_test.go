@@ -533,6 +533,51 @@ func TestGetCompletionsConfig(t *testing.T) {
 				Endpoint:        "https://api.openai.com/v1/chat/completions",
 			},
 		},
+		{
+			name: "Local completions",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider:  "local",
+					Endpoint:  "http://vllm.internal:8000/v1",
+					ChatModel: "CodeLlama-13b-Instruct",
+					PromptTemplates: []*schema.CompletionsPromptTemplate{{
+						Model:              "CodeLlama-13b-Instruct",
+						HumanMessagePrefix: "[INST] ",
+						HumanMessageSuffix: " [/INST]",
+						StopSequences:      []string{"</s>"},
+						ContextWindow:      16384,
+					}},
+				},
+			},
+			wantConfig: &conftypes.CompletionsConfig{
+				ChatModel:       "codellama-13b-instruct",
+				FastChatModel:   "codellama-13b-instruct",
+				CompletionModel: "codellama-13b-instruct",
+				Provider:        "local",
+				Endpoint:        "http://vllm.internal:8000/v1",
+				PromptTemplates: []conftypes.CompletionsPromptTemplate{{
+					Model:              "codellama-13b-instruct",
+					HumanMessagePrefix: "[INST] ",
+					HumanMessageSuffix: " [/INST]",
+					StopSequences:      []string{"</s>"},
+					ContextWindow:      16384,
+				}},
+			},
+		},
+		{
+			name: "Local completions without endpoint",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider:  "local",
+					ChatModel: "codellama-13b-instruct",
+				},
+			},
+			wantDisabled: true,
+		},
 		{
 			name: "zero-config cody gateway completions without license key",
 			siteConfig: schema.SiteConfiguration{
@@ -683,6 +728,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 		Dimensions:                 1536,
 		Incremental:                true,
 		MinimumInterval:            24 * time.Hour,
+		FullIndexInterval:          7 * 24 * time.Hour,
 		MaxCodeEmbeddingsPerRepo:   3_072_000,
 		MaxTextEmbeddingsPerRepo:   512_000,
 		PolicyRepositoryMatchLimit: pointers.Ptr(5000),
@@ -778,6 +824,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 				Dimensions:                 0, // unknown model used for test case
 				Incremental:                true,
 				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
 				MaxCodeEmbeddingsPerRepo:   3_072_000,
 				MaxTextEmbeddingsPerRepo:   512_000,
 				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
@@ -812,6 +859,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 				Dimensions:                 1536,
 				Incremental:                true,
 				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
 				MaxCodeEmbeddingsPerRepo:   3_072_000,
 				MaxTextEmbeddingsPerRepo:   512_000,
 				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
@@ -828,6 +876,48 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 			},
 			wantDisabled: true,
 		},
+		{
+			name: "Local provider",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Embeddings: &schema.Embeddings{
+					Provider:       "local",
+					Endpoint:       "http://tei.internal:8080/embed",
+					Model:          "bge-small-en",
+					Dimensions:     384,
+					BatchSize:      32,
+					MaxInputTokens: 512,
+				},
+			},
+			wantConfig: &conftypes.EmbeddingsConfig{
+				Provider:                   "local",
+				Model:                      "bge-small-en",
+				Endpoint:                   "http://tei.internal:8080/embed",
+				Dimensions:                 384,
+				APIFormat:                  "openai",
+				BatchSize:                  32,
+				MaxInputTokens:             512,
+				Incremental:                true,
+				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
+				MaxCodeEmbeddingsPerRepo:   3_072_000,
+				MaxTextEmbeddingsPerRepo:   512_000,
+				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
+			},
+		},
+		{
+			name: "Local provider without dimensions",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Embeddings: &schema.Embeddings{
+					Provider: "local",
+					Endpoint: "http://tei.internal:8080/embed",
+				},
+			},
+			wantDisabled: true,
+		},
 		{
 			name:       "App default config",
 			deployType: deploy.App,
@@ -845,6 +935,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 				Dimensions:                 1536,
 				Incremental:                true,
 				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
 				MaxCodeEmbeddingsPerRepo:   3_072_000,
 				MaxTextEmbeddingsPerRepo:   512_000,
 				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
@@ -880,6 +971,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 				Dimensions:                 1536,
 				Incremental:                true,
 				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
 				MaxCodeEmbeddingsPerRepo:   3_072_000,
 				MaxTextEmbeddingsPerRepo:   512_000,
 				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
@@ -903,6 +995,7 @@ func TestGetEmbeddingsConfig(t *testing.T) {
 				Dimensions:                 1536,
 				Incremental:                true,
 				MinimumInterval:            24 * time.Hour,
+				FullIndexInterval:          7 * 24 * time.Hour,
 				MaxCodeEmbeddingsPerRepo:   3_072_000,
 				MaxTextEmbeddingsPerRepo:   512_000,
 				PolicyRepositoryMatchLimit: pointers.Ptr(5000),
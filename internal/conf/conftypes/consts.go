@@ -17,6 +17,19 @@ type CompletionsConfig struct {
 	Endpoint                         string
 	PerUserDailyLimit                int
 	PerUserCodeCompletionsDailyLimit int
+
+	// PromptTemplates are the prompt formats of the models served by the local provider.
+	PromptTemplates []CompletionsPromptTemplate
+}
+
+type CompletionsPromptTemplate struct {
+	Model                  string
+	HumanMessagePrefix     string
+	HumanMessageSuffix     string
+	AssistantMessagePrefix string
+	AssistantMessageSuffix string
+	StopSequences          []string
+	ContextWindow          int
 }
 
 type CompletionsProviderName string
@@ -25,6 +38,7 @@ const (
 	CompletionsProviderNameAnthropic   CompletionsProviderName = "anthropic"
 	CompletionsProviderNameOpenAI      CompletionsProviderName = "openai"
 	CompletionsProviderNameSourcegraph CompletionsProviderName = "sourcegraph"
+	CompletionsProviderNameLocal       CompletionsProviderName = "local"
 )
 
 type EmbeddingsConfig struct {
@@ -33,8 +47,12 @@ type EmbeddingsConfig struct {
 	Model                      string
 	Endpoint                   string
 	Dimensions                 int
+	APIFormat                  EmbeddingsAPIFormat
+	BatchSize                  int
+	MaxInputTokens             int
 	Incremental                bool
 	MinimumInterval            time.Duration
+	FullIndexInterval          time.Duration
 	ExcludedFilePathPatterns   []string
 	MaxCodeEmbeddingsPerRepo   int
 	MaxTextEmbeddingsPerRepo   int
@@ -46,4 +64,12 @@ type EmbeddingsProviderName string
 const (
 	EmbeddingsProviderNameOpenAI      EmbeddingsProviderName = "openai"
 	EmbeddingsProviderNameSourcegraph EmbeddingsProviderName = "sourcegraph"
+	EmbeddingsProviderNameLocal       EmbeddingsProviderName = "local"
+)
+
+type EmbeddingsAPIFormat string
+
+const (
+	EmbeddingsAPIFormatOpenAI EmbeddingsAPIFormat = "openai"
+	EmbeddingsAPIFormatTEI    EmbeddingsAPIFormat = "tei"
 )
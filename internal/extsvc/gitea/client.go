@@ -0,0 +1,233 @@
+//nolint:bodyclose // Body is closed in Client.do, but the response is still returned to provide access to the headers
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// apiPath is the path prefix of the Gitea REST API. Forgejo serves the same API
+// under the same prefix.
+const apiPath = "api/v1/"
+
+// defaultPageSize is the page size requested from paginated endpoints. Gitea
+// caps the page size using its MAX_RESPONSE_ITEMS setting, which defaults to 50.
+const defaultPageSize = 50
+
+// Client accesses a Gitea or Forgejo instance via the REST API.
+type Client interface {
+	GetURL() *url.URL
+	WithAuthenticator(a auth.Authenticator) (Client, error)
+	Authenticator() auth.Authenticator
+
+	// WithSudo returns a copy of the client that performs requests on behalf of
+	// the given user. The authenticated user must be a site admin.
+	WithSudo(username string) Client
+
+	GetAuthenticatedUser(ctx context.Context) (*User, error)
+	GetUser(ctx context.Context, username string) (*User, error)
+
+	GetRepo(ctx context.Context, owner, name string) (*Repository, error)
+	ListOrgRepos(ctx context.Context, org string, page int) (repos []*Repository, hasNextPage bool, err error)
+	ListUserRepos(ctx context.Context, page int) (repos []*Repository, hasNextPage bool, err error)
+	SearchRepos(ctx context.Context, page int) (repos []*Repository, hasNextPage bool, err error)
+	ForkRepo(ctx context.Context, owner, name string, input CreateForkInput) (*Repository, error)
+
+	CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error)
+	GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error)
+	GetPullRequestByBranch(ctx context.Context, owner, name, base, head string) (*PullRequest, error)
+	EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error)
+	MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error
+	ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error)
+	CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) error
+	ListCommitStatuses(ctx context.Context, owner, name, ref string) ([]*CommitStatus, error)
+}
+
+type client struct {
+	// HTTP Client used to communicate with the API.
+	httpClient httpcli.Doer
+
+	// URL is the base URL of Gitea.
+	URL *url.URL
+
+	// rateLimit is the self-imposed rate limiter, since Gitea does not
+	// advertise rate limits in response headers.
+	rateLimit *ratelimit.InstrumentedLimiter
+
+	// auther is used to authenticate HTTP requests.
+	auther auth.Authenticator
+
+	// sudo is the username to impersonate, if any.
+	sudo string
+}
+
+// NewClient returns an authenticated Gitea API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(urn string, u *url.URL, a auth.Authenticator, httpClient httpcli.Doer) (Client, error) {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	// Make sure the base URL ends in a slash, so that API paths are resolved
+	// relative to it even if Gitea is served from a sub-path.
+	base := *u
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	return &client{
+		httpClient: httpClient,
+		URL:        &base,
+		rateLimit:  ratelimit.DefaultRegistry.Get(urn),
+		auther:     a,
+	}, nil
+}
+
+// WithAuthenticator returns a new Client that uses the same configuration,
+// HTTPClient, and RateLimiter as the current Client, except authenticated with
+// the given authenticator instance.
+//
+// Only OAuthBearerToken and BasicAuth (and their SSH variants) are supported.
+func (c *client) WithAuthenticator(a auth.Authenticator) (Client, error) {
+	switch a.(type) {
+	case *auth.OAuthBearerToken, *auth.OAuthBearerTokenWithSSH, *auth.BasicAuth, *auth.BasicAuthWithSSH:
+		break
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Gitea clients: %s", a)
+	}
+
+	return &client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		rateLimit:  c.rateLimit,
+		auther:     a,
+		sudo:       c.sudo,
+	}, nil
+}
+
+func (c *client) WithSudo(username string) Client {
+	cc := *c
+	cc.sudo = username
+	return &cc
+}
+
+func (c *client) Authenticator() auth.Authenticator {
+	return c.auther
+}
+
+func (c *client) GetURL() *url.URL {
+	return c.URL
+}
+
+func (c *client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequest("GET", "user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if _, err := c.do(ctx, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUser returns the user with the given username.
+func (c *client) GetUser(ctx context.Context, username string) (*User, error) {
+	req, err := http.NewRequest("GET", "users/"+url.PathEscape(username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if _, err := c.do(ctx, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// newJSONRequest creates a request with the given body encoded as JSON.
+func newJSONRequest(method, path string, body any) (*http.Request, error) {
+	bs, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request body")
+	}
+	req, err := http.NewRequest(method, path, bytes.NewReader(bs))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// pageQuery returns the query string used to request the given page.
+func pageQuery(page int) string {
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+	q.Set("limit", strconv.Itoa(defaultPageSize))
+	return q.Encode()
+}
+
+// hasNextPage reports whether there is a further page of results. Gitea sets
+// a Link header on paginated responses; older versions that don't are handled
+// by comparing the number of results with the requested page size.
+func hasNextPage(resp *http.Response, got int) bool {
+	if link := resp.Header.Get("Link"); link != "" {
+		return strings.Contains(link, `rel="next"`)
+	}
+	return got >= defaultPageSize
+}
+
+func (c *client) do(ctx context.Context, req *http.Request, result any) (*http.Response, error) {
+	req.URL = c.URL.ResolveReference(&url.URL{Path: apiPath}).ResolveReference(req.URL)
+	req.Header.Set("Accept", "application/json")
+	if c.sudo != "" {
+		req.Header.Set("Sudo", c.sudo)
+	}
+
+	if c.auther != nil {
+		if err := c.auther.Authenticate(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.rateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, &httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		}
+	}
+
+	if result == nil || len(bs) == 0 {
+		return resp, nil
+	}
+	return resp, json.Unmarshal(bs, result)
+}
@@ -0,0 +1,136 @@
+package gitea
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// EventTypeHeader is the request header Gitea uses to identify the event
+	// type of a webhook delivery.
+	EventTypeHeader = "X-Gitea-Event"
+	// SignatureHeader is the request header carrying the hex encoded
+	// HMAC-SHA256 of the payload, keyed with the webhook secret.
+	SignatureHeader = "X-Gitea-Signature"
+
+	// Forgejo sends its own headers alongside the Gitea ones, so older
+	// Forgejo versions that dropped the Gitea headers are handled too.
+	forgejoEventTypeHeader = "X-Forgejo-Event"
+	forgejoSignatureHeader = "X-Forgejo-Signature"
+)
+
+const (
+	PushEventType                     = "push"
+	PullRequestEventType              = "pull_request"
+	PullRequestReviewApprovedType     = "pull_request_review_approved"
+	PullRequestReviewRejectedType     = "pull_request_review_rejected"
+	PullRequestReviewCommentEventType = "pull_request_review_comment"
+	PullRequestSyncEventType          = "pull_request_sync"
+	StatusEventType                   = "status"
+)
+
+// WebhookEventType returns the event type of the webhook delivery r.
+func WebhookEventType(r *http.Request) string {
+	if t := r.Header.Get(EventTypeHeader); t != "" {
+		return t
+	}
+	return r.Header.Get(forgejoEventTypeHeader)
+}
+
+// ValidateSignature checks the signature of a webhook payload against the
+// given secret.
+func ValidateSignature(r *http.Request, payload []byte, secret string) error {
+	sig := r.Header.Get(SignatureHeader)
+	if sig == "" {
+		sig = r.Header.Get(forgejoSignatureHeader)
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.Wrap(err, "decoding signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errors.New("payload signature does not match")
+	}
+	return nil
+}
+
+// ParseWebhookEvent parses the payload of a webhook delivery of the given
+// event type.
+func ParseWebhookEvent(eventType string, payload []byte) (any, error) {
+	var target any
+	switch eventType {
+	case PushEventType:
+		target = &PushEvent{}
+	case PullRequestEventType, PullRequestSyncEventType,
+		PullRequestReviewApprovedType, PullRequestReviewRejectedType, PullRequestReviewCommentEventType:
+		target = &PullRequestEvent{}
+	case StatusEventType:
+		target = &StatusEvent{}
+	default:
+		return nil, webhookNotFoundErr{eventType: eventType}
+	}
+
+	if err := json.Unmarshal(payload, target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// PushEvent is sent when commits are pushed to a repository.
+type PushEvent struct {
+	Ref        string      `json:"ref"`
+	Before     string      `json:"before"`
+	After      string      `json:"after"`
+	Repository *Repository `json:"repository"`
+	Pusher     *User       `json:"pusher"`
+}
+
+// PullRequestEvent is sent when a pull request is changed or reviewed.
+type PullRequestEvent struct {
+	Action      string       `json:"action"`
+	Number      int64        `json:"number"`
+	PullRequest *PullRequest `json:"pull_request"`
+	Repository  *Repository  `json:"repository"`
+	Sender      *User        `json:"sender"`
+	Review      *struct {
+		Type    string `json:"type"`
+		Content string `json:"content"`
+	} `json:"review,omitempty"`
+}
+
+// Key returns a key identifying the event.
+func (e *PullRequestEvent) Key() string {
+	return strconv.FormatInt(e.PullRequest.ID, 10) + ":" + e.Action + ":" + e.PullRequest.Updated.String()
+}
+
+// StatusEvent is sent when a commit status is created.
+type StatusEvent struct {
+	ID          int64             `json:"id"`
+	SHA         string            `json:"sha"`
+	Context     string            `json:"context"`
+	State       CommitStatusState `json:"state"`
+	TargetURL   string            `json:"target_url"`
+	Description string            `json:"description"`
+	Repository  *Repository       `json:"repository"`
+}
+
+type webhookNotFoundErr struct {
+	eventType string
+}
+
+func (w webhookNotFoundErr) Error() string {
+	return "unknown webhook event type: " + w.eventType
+}
+
+func (w webhookNotFoundErr) NotFound() bool {
+	return true
+}
@@ -0,0 +1,71 @@
+package gitea
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+func TestValidateSignature(t *testing.T) {
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	for name, tc := range map[string]struct {
+		header  string
+		sig     string
+		secret  string
+		wantErr bool
+	}{
+		"gitea header":    {header: SignatureHeader, sig: sig, secret: "secret"},
+		"forgejo header":  {header: forgejoSignatureHeader, sig: sig, secret: "secret"},
+		"wrong secret":    {header: SignatureHeader, sig: sig, secret: "other", wantErr: true},
+		"missing":         {secret: "secret", wantErr: true},
+		"not hex encoded": {header: SignatureHeader, sig: "not-hex", secret: "secret", wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/", nil)
+			if tc.header != "" {
+				r.Header.Set(tc.header, tc.sig)
+			}
+			err := ValidateSignature(r, payload, tc.secret)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	t.Run("push", func(t *testing.T) {
+		e, err := ParseWebhookEvent(PushEventType, []byte(`{"ref":"refs/heads/main","repository":{"full_name":"sourcegraph/sourcegraph","clone_url":"https://gitea.sgdev.org/sourcegraph/sourcegraph.git"}}`))
+		require.NoError(t, err)
+		push, ok := e.(*PushEvent)
+		require.True(t, ok)
+		assert.Equal(t, "https://gitea.sgdev.org/sourcegraph/sourcegraph.git", push.Repository.CloneURL)
+	})
+
+	t.Run("review", func(t *testing.T) {
+		e, err := ParseWebhookEvent(PullRequestReviewApprovedType, []byte(`{"action":"reviewed","number":1,"pull_request":{"id":1001,"number":1},"review":{"type":"pull_request_review_approved","content":"LGTM"}}`))
+		require.NoError(t, err)
+		pr, ok := e.(*PullRequestEvent)
+		require.True(t, ok)
+		assert.EqualValues(t, 1, pr.PullRequest.Index)
+		assert.Equal(t, "LGTM", pr.Review.Content)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := ParseWebhookEvent("wiki", []byte(`{}`))
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
@@ -0,0 +1,119 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func pullsPath(owner, name string) string {
+	return fmt.Sprintf("repos/%s/%s/pulls", url.PathEscape(owner), url.PathEscape(name))
+}
+
+// CreatePullRequest opens a pull request. If a pull request for the same head
+// and base already exists, Gitea responds with a 409 Conflict.
+func (c *client) CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error) {
+	req, err := newJSONRequest("POST", pullsPath(owner, name), input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// GetPullRequest returns the pull request with the given index.
+func (c *client) GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%d", pullsPath(owner, name), index), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// GetPullRequestByBranch returns the open pull request merging head into base.
+// Branches in forks are given as "owner:branch".
+func (c *client) GetPullRequestByBranch(ctx context.Context, owner, name, base, head string) (*PullRequest, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/%s", pullsPath(owner, name), url.PathEscape(base), url.PathEscape(head)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// EditPullRequest updates the pull request with the given index.
+func (c *client) EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error) {
+	req, err := newJSONRequest("PATCH", fmt.Sprintf("%s/%d", pullsPath(owner, name), index), input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges the pull request with the given index. Gitea
+// responds with 405 Method Not Allowed if the pull request cannot be merged,
+// and with 409 Conflict if the head has moved or conflicts with the base.
+func (c *client) MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error {
+	req, err := newJSONRequest("POST", fmt.Sprintf("%s/%d/merge", pullsPath(owner, name), index), input)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// ListPullRequestReviews returns the reviews of the pull request with the
+// given index.
+func (c *client) ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error) {
+	var all []*PullReview
+	for page := 1; ; page++ {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/%d/reviews?%s", pullsPath(owner, name), index, pageQuery(page)), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var reviews []*PullReview
+		resp, err := c.do(ctx, req, &reviews)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, reviews...)
+		if !hasNextPage(resp, len(reviews)) {
+			return all, nil
+		}
+	}
+}
+
+// CreateIssueComment adds a comment to the issue or pull request with the
+// given index.
+func (c *client) CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) error {
+	req, err := newJSONRequest("POST", fmt.Sprintf("repos/%s/%s/issues/%d/comments", url.PathEscape(owner), url.PathEscape(name), index), struct {
+		Body string `json:"body"`
+	}{Body: body})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
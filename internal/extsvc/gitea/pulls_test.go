@@ -0,0 +1,91 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_GetPullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "GetPullRequest", *update)
+	defer save()
+
+	pr, err := cli.GetPullRequest(context.Background(), "sourcegraph", "automation-testing", 1)
+	require.NoError(t, err)
+	assert.True(t, pr.IsDraft())
+
+	testutil.AssertGolden(t, "testdata/golden/GetPullRequest.json", *update, pr)
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	ctx := context.Background()
+	input := CreatePullRequestInput{
+		Head:  "test-pr",
+		Base:  "main",
+		Title: "Test PR",
+		Body:  "Test body",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequest", *update)
+		defer save()
+
+		pr, err := cli.CreatePullRequest(ctx, "sourcegraph", "automation-testing", input)
+		require.NoError(t, err)
+
+		testutil.AssertGolden(t, "testdata/golden/CreatePullRequest.json", *update, pr)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequest-AlreadyExists", *update)
+		defer save()
+
+		_, err := cli.CreatePullRequest(ctx, "sourcegraph", "automation-testing", input)
+		assert.Equal(t, http.StatusConflict, HTTPErrorCode(err))
+	})
+}
+
+func TestClient_MergePullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "MergePullRequest-NotMergeable", *update)
+	defer save()
+
+	err := cli.MergePullRequest(context.Background(), "sourcegraph", "automation-testing", 2, MergePullRequestInput{Style: MergeStyleMerge})
+	assert.Equal(t, http.StatusMethodNotAllowed, HTTPErrorCode(err))
+}
+
+func TestClient_ListPullRequestReviews(t *testing.T) {
+	cli, save := NewTestClient(t, "ListPullRequestReviews", *update)
+	defer save()
+
+	reviews, err := cli.ListPullRequestReviews(context.Background(), "sourcegraph", "automation-testing", 1)
+	require.NoError(t, err)
+
+	testutil.AssertGolden(t, "testdata/golden/ListPullRequestReviews.json", *update, reviews)
+}
+
+func TestPullRequest_IsDraft(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		draft bool
+		want  bool
+	}{
+		{title: "Add a README", want: false},
+		{title: "WIP: Add a README", want: true},
+		{title: "wip: Add a README", want: true},
+		{title: "[WIP] Add a README", want: true},
+		{title: "Add a README", draft: true, want: true},
+	} {
+		pr := &PullRequest{Title: tc.title, Draft: tc.draft}
+		assert.Equal(t, tc.want, pr.IsDraft(), tc.title)
+	}
+
+	assert.Equal(t, "WIP: Add a README", DraftTitle("Add a README"))
+	assert.Equal(t, "Add a README", UndraftTitle("WIP: Add a README"))
+	assert.Equal(t, "Add a README", UndraftTitle("[wip] Add a README"))
+	assert.Equal(t, "Add a README", UndraftTitle("Add a README"))
+}
@@ -0,0 +1,104 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetRepo returns the repository with the given owner and name.
+func (c *client) GetRepo(ctx context.Context, owner, name string) (*Repository, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("repos/%s/%s", url.PathEscape(owner), url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repository
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListOrgRepos returns a page of repositories of the given organization.
+// Pages are 1-indexed.
+func (c *client) ListOrgRepos(ctx context.Context, org string, page int) ([]*Repository, bool, error) {
+	return c.listRepos(ctx, fmt.Sprintf("orgs/%s/repos?%s", url.PathEscape(org), pageQuery(page)))
+}
+
+// ListUserRepos returns a page of repositories the authenticated user has
+// access to. When the client is impersonating a user via WithSudo, these are
+// the repositories that user can access. Pages are 1-indexed.
+func (c *client) ListUserRepos(ctx context.Context, page int) ([]*Repository, bool, error) {
+	return c.listRepos(ctx, "user/repos?"+pageQuery(page))
+}
+
+func (c *client) listRepos(ctx context.Context, path string) ([]*Repository, bool, error) {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var repos []*Repository
+	resp, err := c.do(ctx, req, &repos)
+	if err != nil {
+		return nil, false, err
+	}
+	return repos, hasNextPage(resp, len(repos)), nil
+}
+
+// SearchRepos returns a page of all repositories visible to the authenticated
+// user. Pages are 1-indexed.
+func (c *client) SearchRepos(ctx context.Context, page int) ([]*Repository, bool, error) {
+	req, err := http.NewRequest("GET", "repos/search?"+pageQuery(page), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var result struct {
+		OK   bool          `json:"ok"`
+		Data []*Repository `json:"data"`
+	}
+	resp, err := c.do(ctx, req, &result)
+	if err != nil {
+		return nil, false, err
+	}
+	return result.Data, hasNextPage(resp, len(result.Data)), nil
+}
+
+// ForkRepo forks the given repository. If a fork with the requested name
+// already exists in the target namespace, Gitea responds with a 409 Conflict.
+func (c *client) ForkRepo(ctx context.Context, owner, name string, input CreateForkInput) (*Repository, error) {
+	req, err := newJSONRequest("POST", fmt.Sprintf("repos/%s/%s/forks", url.PathEscape(owner), url.PathEscape(name)), input)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repository
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListCommitStatuses returns the statuses reported for the given ref.
+func (c *client) ListCommitStatuses(ctx context.Context, owner, name, ref string) ([]*CommitStatus, error) {
+	var all []*CommitStatus
+	for page := 1; ; page++ {
+		req, err := http.NewRequest("GET", fmt.Sprintf("repos/%s/%s/commits/%s/statuses?%s", url.PathEscape(owner), url.PathEscape(name), url.PathEscape(ref), pageQuery(page)), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var statuses []*CommitStatus
+		resp, err := c.do(ctx, req, &statuses)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, statuses...)
+		if !hasNextPage(resp, len(statuses)) {
+			return all, nil
+		}
+	}
+}
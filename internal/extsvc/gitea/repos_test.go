@@ -0,0 +1,80 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_GetRepo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetRepo", *update)
+		defer save()
+
+		repo, err := cli.GetRepo(ctx, "sourcegraph", "automation-testing")
+		require.NoError(t, err)
+
+		testutil.AssertGolden(t, "testdata/golden/GetRepo.json", *update, repo)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetRepo-NotFound", *update)
+		defer save()
+
+		_, err := cli.GetRepo(ctx, "sourcegraph", "does-not-exist")
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func TestClient_ListOrgRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListOrgRepos", *update)
+	defer save()
+
+	ctx := context.Background()
+
+	var names []string
+	for page := 1; ; page++ {
+		repos, hasNext, err := cli.ListOrgRepos(ctx, "sourcegraph", page)
+		require.NoError(t, err)
+		for _, r := range repos {
+			names = append(names, r.FullName)
+		}
+		if !hasNext {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{
+		"sourcegraph/automation-testing",
+		"sourcegraph/src-cli",
+		"sourcegraph/sourcegraph",
+	}, names)
+}
+
+func TestClient_SearchRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "SearchRepos", *update)
+	defer save()
+
+	repos, hasNext, err := cli.SearchRepos(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, hasNext)
+
+	testutil.AssertGolden(t, "testdata/golden/SearchRepos.json", *update, repos)
+}
+
+func TestClient_ListCommitStatuses(t *testing.T) {
+	cli, save := NewTestClient(t, "ListCommitStatuses", *update)
+	defer save()
+
+	statuses, err := cli.ListCommitStatuses(context.Background(), "sourcegraph", "automation-testing", "3b9a8e1f0c2d4e6f8a0b2c4d6e8f0a1b3c5d7e9f")
+	require.NoError(t, err)
+
+	testutil.AssertGolden(t, "testdata/golden/ListCommitStatuses.json", *update, statuses)
+}
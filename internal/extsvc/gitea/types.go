@@ -0,0 +1,251 @@
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// User is a Gitea user account.
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+	IsAdmin   bool   `json:"is_admin"`
+}
+
+// Permission describes the access the authenticated user has to a repository.
+type Permission struct {
+	Admin bool `json:"admin"`
+	Push  bool `json:"push"`
+	Pull  bool `json:"pull"`
+}
+
+// Repository is a Gitea repository.
+type Repository struct {
+	ID            int64       `json:"id"`
+	Owner         *User       `json:"owner"`
+	Name          string      `json:"name"`
+	FullName      string      `json:"full_name"`
+	Description   string      `json:"description"`
+	Private       bool        `json:"private"`
+	Fork          bool        `json:"fork"`
+	Parent        *Repository `json:"parent,omitempty"`
+	Archived      bool        `json:"archived"`
+	Mirror        bool        `json:"mirror"`
+	HTMLURL       string      `json:"html_url"`
+	SSHURL        string      `json:"ssh_url"`
+	CloneURL      string      `json:"clone_url"`
+	DefaultBranch string      `json:"default_branch"`
+	StarsCount    int         `json:"stars_count"`
+	Permissions   *Permission `json:"permissions,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// CreateForkInput is the payload of a request to fork a repository.
+type CreateForkInput struct {
+	// Organization is the organization to fork into. If empty, the repository
+	// is forked into the namespace of the authenticated user.
+	Organization string `json:"organization,omitempty"`
+	// Name is the name of the fork. If empty, the name of the upstream
+	// repository is used.
+	Name string `json:"name,omitempty"`
+}
+
+// PullRequestState is the state of a pull request as reported by Gitea.
+type PullRequestState string
+
+const (
+	PullRequestStateOpen   PullRequestState = "open"
+	PullRequestStateClosed PullRequestState = "closed"
+)
+
+// PRBranchInfo describes one side of a pull request.
+type PRBranchInfo struct {
+	Label  string      `json:"label"`
+	Ref    string      `json:"ref"`
+	Sha    string      `json:"sha"`
+	RepoID int64       `json:"repo_id"`
+	Repo   *Repository `json:"repo"`
+}
+
+// Label is an issue or pull request label.
+type Label struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// PullRequest is a Gitea pull request.
+type PullRequest struct {
+	ID             int64            `json:"id"`
+	Index          int64            `json:"number"`
+	URL            string           `json:"url"`
+	HTMLURL        string           `json:"html_url"`
+	User           *User            `json:"user"`
+	Title          string           `json:"title"`
+	Body           string           `json:"body"`
+	Labels         []*Label         `json:"labels"`
+	State          PullRequestState `json:"state"`
+	Draft          bool             `json:"draft"`
+	Mergeable      bool             `json:"mergeable"`
+	HasMerged      bool             `json:"merged"`
+	MergedAt       *time.Time       `json:"merged_at"`
+	MergedCommitID *string          `json:"merge_commit_sha"`
+	Base           *PRBranchInfo    `json:"base"`
+	Head           *PRBranchInfo    `json:"head"`
+	Created        time.Time        `json:"created_at"`
+	Updated        time.Time        `json:"updated_at"`
+	Closed         *time.Time       `json:"closed_at"`
+}
+
+// draftPrefixes are the title prefixes Gitea uses to mark a pull request as a
+// work in progress. These are the defaults of the WORK_IN_PROGRESS_PREFIXES
+// setting.
+var draftPrefixes = []string{"WIP:", "[WIP]"}
+
+// IsDraft reports whether the pull request is marked as a work in progress.
+func (pr *PullRequest) IsDraft() bool {
+	if pr.Draft {
+		return true
+	}
+	for _, p := range draftPrefixes {
+		if strings.HasPrefix(strings.ToUpper(pr.Title), p) {
+			return true
+		}
+	}
+	return false
+}
+
+// DraftTitle returns the given title prefixed so that Gitea treats the pull
+// request as a work in progress.
+func DraftTitle(title string) string {
+	return draftPrefixes[0] + " " + title
+}
+
+// UndraftTitle returns the given title without any work in progress prefix.
+func UndraftTitle(title string) string {
+	for _, p := range draftPrefixes {
+		if len(title) >= len(p) && strings.EqualFold(title[:len(p)], p) {
+			return strings.TrimSpace(title[len(p):])
+		}
+	}
+	return title
+}
+
+// CreatePullRequestInput is the payload of a request to create a pull request.
+type CreatePullRequestInput struct {
+	// Head is the branch to merge. Branches in forks are given as
+	// "owner:branch".
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// EditPullRequestInput is the payload of a request to update a pull request.
+// Fields left nil are not modified.
+type EditPullRequestInput struct {
+	Title *string           `json:"title,omitempty"`
+	Body  *string           `json:"body,omitempty"`
+	Base  *string           `json:"base,omitempty"`
+	State *PullRequestState `json:"state,omitempty"`
+}
+
+// MergeStyle is the way a pull request is merged.
+type MergeStyle string
+
+const (
+	MergeStyleMerge  MergeStyle = "merge"
+	MergeStyleSquash MergeStyle = "squash"
+)
+
+// MergePullRequestInput is the payload of a request to merge a pull request.
+type MergePullRequestInput struct {
+	Style                  MergeStyle `json:"Do"`
+	DeleteBranchAfterMerge bool       `json:"delete_branch_after_merge,omitempty"`
+}
+
+// ReviewState is the state of a pull request review.
+type ReviewState string
+
+const (
+	ReviewStateApproved       ReviewState = "APPROVED"
+	ReviewStatePending        ReviewState = "PENDING"
+	ReviewStateComment        ReviewState = "COMMENT"
+	ReviewStateRequestChanges ReviewState = "REQUEST_CHANGES"
+	ReviewStateRequestReview  ReviewState = "REQUEST_REVIEW"
+)
+
+// PullReview is a review of a pull request.
+type PullReview struct {
+	ID          int64       `json:"id"`
+	Reviewer    *User       `json:"user"`
+	State       ReviewState `json:"state"`
+	Body        string      `json:"body"`
+	CommitID    string      `json:"commit_id"`
+	Stale       bool        `json:"stale"`
+	Official    bool        `json:"official"`
+	Dismissed   bool        `json:"dismissed"`
+	Submitted   time.Time   `json:"submitted_at"`
+	HTMLURL     string      `json:"html_url"`
+	CommentsNum int         `json:"comments_count"`
+}
+
+// CommitStatusState is the state of a commit status.
+type CommitStatusState string
+
+const (
+	CommitStatusPending CommitStatusState = "pending"
+	CommitStatusSuccess CommitStatusState = "success"
+	CommitStatusError   CommitStatusState = "error"
+	CommitStatusFailure CommitStatusState = "failure"
+	CommitStatusWarning CommitStatusState = "warning"
+)
+
+// CommitStatus is a status reported for a commit, usually by a CI system.
+type CommitStatus struct {
+	ID          int64             `json:"id"`
+	State       CommitStatusState `json:"status"`
+	TargetURL   string            `json:"target_url"`
+	Description string            `json:"description"`
+	Context     string            `json:"context"`
+	Created     time.Time         `json:"created_at"`
+	Updated     time.Time         `json:"updated_at"`
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Gitea API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// HTTPErrorCode returns err's HTTP status code, if it is an HTTP error from
+// this package. Otherwise it returns 0.
+func HTTPErrorCode(err error) int {
+	var e *httpError
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
@@ -274,6 +274,10 @@ type Client interface {
 	// MergeBase returns the merge base commit for the specified commits.
 	MergeBase(ctx context.Context, repo api.RepoName, a, b api.CommitID) (api.CommitID, error)
 
+	// UnappliedCommits returns the commits of head that have no equivalent
+	// change in upstream, comparing commits by patch ID like git cherry does.
+	UnappliedCommits(ctx context.Context, repo api.RepoName, upstream, head api.CommitID) ([]api.CommitID, error)
+
 	// P4Exec sends a p4 command with given arguments and returns an io.ReadCloser for the output.
 	P4Exec(_ context.Context, host, user, password string, args ...string) (io.ReadCloser, http.Header, error)
 
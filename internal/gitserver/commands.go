@@ -1312,6 +1312,34 @@ func (c *clientImplementor) MergeBase(ctx context.Context, repo api.RepoName, a,
 	return api.CommitID(bytes.TrimSpace(out)), nil
 }
 
+// UnappliedCommits returns the commits of head that have no equivalent change
+// in upstream. Commits are compared by patch ID, so a commit that was applied
+// to upstream under a different hash, e.g. by git am or a cherry-pick, counts
+// as applied.
+func (c *clientImplementor) UnappliedCommits(ctx context.Context, repo api.RepoName, upstream, head api.CommitID) (_ []api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.unappliedCommits.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("upstream", string(upstream)),
+		attribute.String("head", string(head)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	cmd := c.gitCommand(repo, "cherry", string(upstream), string(head))
+	out, err := cmd.CombinedOutput(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args(), out))
+	}
+
+	// Every line is a commit of head, prefixed with "-" if an equivalent
+	// change exists in upstream and with "+" otherwise.
+	var commits []api.CommitID
+	for _, line := range bytes.Split(bytes.TrimSpace(out), []byte("\n")) {
+		if sign, commit, ok := bytes.Cut(line, []byte(" ")); ok && string(sign) == "+" {
+			commits = append(commits, api.CommitID(commit))
+		}
+	}
+	return commits, nil
+}
+
 // RevList makes a git rev-list call and iterates through the resulting commits, calling the provided onCommit function for each.
 func (c *clientImplementor) RevList(ctx context.Context, repo string, commit string, onCommit func(commit string) (shouldContinue bool, err error)) (err error) {
 	ctx, _, endObservation := c.operations.revList.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
@@ -1019,6 +1019,57 @@ func TestMerger_MergeBase(t *testing.T) {
 	}
 }
 
+func TestUnappliedCommits(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	ctx := context.Background()
+	client := NewClient()
+
+	repo := MakeGitRepository(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m base",
+		"git checkout -b b2",
+		"echo line2 >> f",
+		"git add f",
+		"git commit -m applied",
+		"echo line1 > g",
+		"git add g",
+		"git commit -m unapplied",
+		"git checkout master",
+		"echo line1 > h",
+		"git add h",
+		"git commit -m other",
+		"git cherry-pick b2~1",
+	)
+
+	resolve := func(spec string) api.CommitID {
+		t.Helper()
+		oid, err := client.ResolveRevision(ctx, repo, spec, ResolveRevisionOptions{})
+		if err != nil {
+			t.Fatalf("ResolveRevision(%q): %s", spec, err)
+		}
+		return oid
+	}
+
+	commits, err := client.UnappliedCommits(ctx, repo, resolve("master"), resolve("b2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []api.CommitID{resolve("b2")}; !reflect.DeepEqual(commits, want) {
+		t.Errorf("got %v, want %v", commits, want)
+	}
+
+	commits, err = client.UnappliedCommits(ctx, repo, resolve("b2"), resolve("b2~1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 0 {
+		t.Errorf("got %v, want no commits", commits)
+	}
+}
+
 func TestRepository_FileSystem_Symlinks(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
@@ -32,6 +32,7 @@ var (
 		"for-each-ref": {"--format", "--points-at"},
 		"tag":          {"--list", "--sort", "-creatordate", "--format", "--points-at"},
 		"merge-base":   {"--"},
+		"cherry":       {},
 		"show-ref":     {"--heads"},
 		"shortlog":     {"-s", "-n", "-e", "--no-merges", "--after", "--before"},
 		"cat-file":     {"-p"},
@@ -184,6 +184,9 @@ type MockClient struct {
 	// StreamBlameFileFunc is an instance of a mock function object
 	// controlling the behavior of the method StreamBlameFile.
 	StreamBlameFileFunc *ClientStreamBlameFileFunc
+	// UnappliedCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method UnappliedCommits.
+	UnappliedCommitsFunc *ClientUnappliedCommitsFunc
 }
 
 // NewMockClient creates a new mock of the Client interface. All methods
@@ -455,6 +458,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		UnappliedCommitsFunc: &ClientUnappliedCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (r0 []api.CommitID, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -727,6 +735,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.StreamBlameFile")
 			},
 		},
+		UnappliedCommitsFunc: &ClientUnappliedCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+				panic("unexpected invocation of MockClient.UnappliedCommits")
+			},
+		},
 	}
 }
 
@@ -893,6 +906,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		StreamBlameFileFunc: &ClientStreamBlameFileFunc{
 			defaultHook: i.StreamBlameFile,
 		},
+		UnappliedCommitsFunc: &ClientUnappliedCommitsFunc{
+			defaultHook: i.UnappliedCommits,
+		},
 	}
 }
 
@@ -6869,3 +6885,117 @@ func (c ClientStreamBlameFileFuncCall) Args() []interface{} {
 func (c ClientStreamBlameFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// ClientUnappliedCommitsFunc describes the behavior when the
+// UnappliedCommits method of the parent MockClient instance is invoked.
+type ClientUnappliedCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)
+	history     []ClientUnappliedCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// UnappliedCommits delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) UnappliedCommits(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID) ([]api.CommitID, error) {
+	r0, r1 := m.UnappliedCommitsFunc.nextHook()(v0, v1, v2, v3)
+	m.UnappliedCommitsFunc.appendCall(ClientUnappliedCommitsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UnappliedCommits
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientUnappliedCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UnappliedCommits method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientUnappliedCommitsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientUnappliedCommitsFunc) SetDefaultReturn(r0 []api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientUnappliedCommitsFunc) PushReturn(r0 []api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientUnappliedCommitsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientUnappliedCommitsFunc) appendCall(r0 ClientUnappliedCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientUnappliedCommitsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientUnappliedCommitsFunc) History() []ClientUnappliedCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientUnappliedCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientUnappliedCommitsFuncCall is an object that describes an invocation of
+// method UnappliedCommits on an instance of MockClient.
+type ClientUnappliedCommitsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientUnappliedCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientUnappliedCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -31,6 +31,7 @@ type operations struct {
 	listTags         *observation.Operation
 	lstat            *observation.Operation
 	mergeBase        *observation.Operation
+	unappliedCommits *observation.Operation
 	newFileReader    *observation.Operation
 	p4Exec           *observation.Operation
 	readDir          *observation.Operation
@@ -106,6 +107,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		listTags:         op("ListTags"),
 		lstat:            subOp("lStat"),
 		mergeBase:        op("MergeBase"),
+		unappliedCommits: op("UnappliedCommits"),
 		newFileReader:    op("NewFileReader"),
 		p4Exec:           op("P4Exec"),
 		readDir:          op("ReadDir"),
@@ -0,0 +1,252 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GiteaSource yields repositories from a single Gitea or Forgejo connection
+// configured in Sourcegraph via the external services configuration.
+type GiteaSource struct {
+	svc       *types.ExternalService
+	cli       gitea.Client
+	serviceID string
+	config    *schema.GiteaConnection
+	logger    log.Logger
+	exclude   excludeFunc
+}
+
+var _ UserSource = &GiteaSource{}
+
+// NewGiteaSource returns a new GiteaSource from the given external service.
+func NewGiteaSource(ctx context.Context, logger log.Logger, svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config", svc.ID)
+	}
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Gitea URL")
+	}
+
+	cli, err := gitea.NewClient(svc.URN(), u, &auth.OAuthBearerToken{Token: c.Token}, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	excludeArchived := func(repo any) bool {
+		if r, ok := repo.(*gitea.Repository); ok {
+			return r.Archived
+		}
+		return false
+	}
+	excludeFork := func(repo any) bool {
+		if r, ok := repo.(*gitea.Repository); ok {
+			return r.Fork
+		}
+		return false
+	}
+	for _, r := range c.Exclude {
+		if r.Archived {
+			eb.Generic(excludeArchived)
+		}
+		if r.Forks {
+			eb.Generic(excludeFork)
+		}
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+	}
+
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiteaSource{
+		svc:       svc,
+		cli:       cli,
+		serviceID: extsvc.NormalizeBaseURL(cli.GetURL()).String(),
+		config:    &c,
+		logger:    logger,
+		exclude:   exclude,
+	}, nil
+}
+
+// CheckConnection returns an error if the Gitea instance cannot be reached or
+// the configured token is invalid.
+func (s *GiteaSource) CheckConnection(ctx context.Context) error {
+	_, err := s.cli.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "connection check failed. could not fetch authenticated user")
+	}
+	return nil
+}
+
+// ListRepos returns all Gitea repositories configured with this GiteaSource's
+// config. If neither orgs nor repos are configured, all repositories visible to
+// the token are returned.
+func (s *GiteaSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[int64]struct{})
+	send := func(r *gitea.Repository) {
+		if _, ok := seen[r.ID]; ok {
+			return
+		}
+		seen[r.ID] = struct{}{}
+		if s.excludes(r) {
+			return
+		}
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+	}
+
+	for _, name := range s.config.Repos {
+		owner, repoName, ok := strings.Cut(name, "/")
+		if !ok {
+			results <- SourceResult{Source: s, Err: errors.Errorf("invalid repository name %q", name)}
+			continue
+		}
+		r, err := s.cli.GetRepo(ctx, owner, repoName)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "fetching repository %q", name)}
+			continue
+		}
+		send(r)
+	}
+
+	for _, org := range s.config.Orgs {
+		s.paginate(ctx, results, send, func(page int) ([]*gitea.Repository, bool, error) {
+			return s.cli.ListOrgRepos(ctx, org, page)
+		})
+	}
+
+	if len(s.config.Repos) == 0 && len(s.config.Orgs) == 0 {
+		s.paginate(ctx, results, send, func(page int) ([]*gitea.Repository, bool, error) {
+			return s.cli.SearchRepos(ctx, page)
+		})
+	}
+}
+
+func (s *GiteaSource) paginate(ctx context.Context, results chan SourceResult, send func(*gitea.Repository), list func(page int) ([]*gitea.Repository, bool, error)) {
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+
+		repos, hasNext, err := list(page)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+		for _, r := range repos {
+			send(r)
+		}
+		if !hasNext {
+			return
+		}
+	}
+}
+
+// GetRepo returns the Gitea repository with the given "owner/name".
+func (s *GiteaSource) GetRepo(ctx context.Context, name string) (*types.Repo, error) {
+	owner, repoName, ok := strings.Cut(name, "/")
+	if !ok {
+		return nil, errors.Errorf("invalid repository name %q", name)
+	}
+	r, err := s.cli.GetRepo(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+	return s.makeRepo(r), nil
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GiteaSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s *GiteaSource) WithAuthenticator(a auth.Authenticator) (Source, error) {
+	sc := *s
+	cli, err := sc.cli.WithAuthenticator(a)
+	if err != nil {
+		return nil, err
+	}
+	sc.cli = cli
+
+	return &sc, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+func (s *GiteaSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.cli.GetAuthenticatedUser(ctx)
+	return err
+}
+
+func (s *GiteaSource) excludes(r *gitea.Repository) bool {
+	return s.exclude(r.FullName) || s.exclude(r)
+}
+
+func (s *GiteaSource) makeRepo(r *gitea.Repository) *types.Repo {
+	urn := s.svc.URN()
+	host := s.cli.GetURL().Host
+	name := path.Join(host, r.FullName)
+
+	cloneURL := r.CloneURL
+	if s.config.GitURLType == "ssh" {
+		cloneURL = r.SSHURL
+	}
+
+	return &types.Repo{
+		Name:        api.RepoName(name),
+		URI:         name,
+		Description: r.Description,
+		Fork:        r.Fork,
+		Archived:    r.Archived,
+		Stars:       r.StarsCount,
+		Private:     r.Private,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          strconv.FormatInt(r.ID, 10),
+			ServiceType: extsvc.VariantGitea.AsType(),
+			ServiceID:   s.serviceID,
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL,
+			},
+		},
+		Metadata: r,
+	}
+}
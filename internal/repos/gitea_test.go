@@ -0,0 +1,51 @@
+package repos
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// To update this test run:
+//  1. Set the env GITEA_TOKEN to a token for https://gitea.sgdev.org
+//  2. Run the test with the -update flag:
+//     `go test -run='TestGiteaSource_ListRepos' -update=TestGiteaSource_ListRepos`
+func TestGiteaSource_ListRepos(t *testing.T) {
+	conf := &schema.GiteaConnection{
+		Url:   "https://gitea.sgdev.org",
+		Token: os.Getenv("GITEA_TOKEN"),
+		Repos: []string{"sourcegraph/automation-testing"},
+		Orgs:  []string{"sourcegraph"},
+		Exclude: []*schema.ExcludedGiteaRepo{
+			{Archived: true},
+			{Name: "sourcegraph/scratch"},
+		},
+	}
+	cf, save := NewClientFactory(t, t.Name())
+	defer save(t)
+
+	svc := &types.ExternalService{
+		Kind:   extsvc.VariantGitea.AsKind(),
+		Config: extsvc.NewUnencryptedConfig(MarshalJSON(t, conf)),
+	}
+
+	ctx := context.Background()
+	src, err := NewGiteaSource(ctx, nil, svc, cf)
+	require.NoError(t, err)
+
+	repos, err := ListAll(ctx, src)
+	require.NoError(t, err)
+
+	// automation-testing is both listed explicitly and part of the org, but must
+	// only be yielded once. src-cli is archived and scratch is excluded by name.
+	require.Len(t, repos, 2)
+
+	testutil.AssertGolden(t, "testdata/sources/GITEA/"+t.Name(), Update(t.Name()), repos)
+}
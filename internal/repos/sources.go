@@ -51,6 +51,8 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewAzureDevOpsSource(ctx, logger.Scoped("AzureDevOpsSource", "GitLab repo source"), svc, cf)
 	case extsvc.KindGerrit:
 		return NewGerritSource(ctx, svc, cf)
+	case extsvc.VariantGitea.AsKind():
+		return NewGiteaSource(ctx, logger.Scoped("GiteaSource", "gitea repo source"), svc, cf)
 	case extsvc.KindBitbucketServer:
 		return NewBitbucketServerSource(ctx, logger.Scoped("BitbucketServerSource", "bitbucket server repo source"), svc, cf)
 	case extsvc.KindBitbucketCloud:
@@ -46,6 +46,11 @@ func TestExternalService_RedactedConfig(t *testing.T) {
 			in:   schema.BitbucketCloudConnection{AppPassword: "foobar", Url: "https://bitbucket.org"},
 			out:  schema.BitbucketCloudConnection{AppPassword: RedactedSecret, Url: "https://bitbucket.org"},
 		},
+		{
+			kind: extsvc.VariantGitea.AsKind(),
+			in:   schema.GiteaConnection{Token: "foobar", Url: "https://gitea.com"},
+			out:  schema.GiteaConnection{Token: RedactedSecret, Url: "https://gitea.com"},
+		},
 		{
 			kind: extsvc.KindAWSCodeCommit,
 			in: schema.AWSCodeCommitConnection{
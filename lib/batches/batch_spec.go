@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 	"github.com/sourcegraph/sourcegraph/lib/batches/yaml"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 )
 
 // Some general notes about the struct definitions below.
@@ -89,13 +90,15 @@ func (oqor *OnQueryOrRepository) GetBranches() ([]string, error) {
 }
 
 type Step struct {
-	Run       string            `json:"run,omitempty" yaml:"run"`
-	Container string            `json:"container,omitempty" yaml:"container"`
-	Env       env.Environment   `json:"env,omitempty" yaml:"env"`
-	Files     map[string]string `json:"files,omitempty" yaml:"files,omitempty"`
-	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
-	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
-	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
+	Run       string                  `json:"run,omitempty" yaml:"run"`
+	Container string                  `json:"container,omitempty" yaml:"container"`
+	Env       env.Environment         `json:"env,omitempty" yaml:"env"`
+	Files     map[string]string       `json:"files,omitempty" yaml:"files,omitempty"`
+	Outputs   Outputs                 `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+	Mount     []Mount                 `json:"mount,omitempty" yaml:"mount,omitempty"`
+	If        any                     `json:"if,omitempty" yaml:"if,omitempty"`
+	Foreach   any                     `json:"foreach,omitempty" yaml:"foreach,omitempty"`
+	Resources *executor.StepResources `json:"resources,omitempty" yaml:"resources,omitempty"`
 }
 
 func (s *Step) IfCondition() string {
@@ -175,6 +178,11 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount mountpoint contains invalid characters", i+1)))
 			}
 		}
+		if step.Resources != nil {
+			if err := step.Resources.Validate(); err != nil {
+				errs = errors.Append(errs, NewValidationError(errors.Wrapf(err, "step %d resources", i+1)))
+			}
+		}
 	}
 
 	return &spec, errs
@@ -211,6 +211,27 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("resource request exceeds limit", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+    resources:
+      cpuRequest: "2"
+      cpuLimit: 500m
+changesetTemplate:
+  title: Test Resources
+  body: Test resources
+  branch: test
+  commit:
+    message: Test
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, `step 1 resources: cpuRequest "2" exceeds cpuLimit "500m"`, err.Error())
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
@@ -155,6 +155,26 @@ func (e Environment) Resolve(outer []string) (map[string]string, error) {
 	return resolved, nil
 }
 
+// MapValues returns a copy of the environment in which every value defined in
+// the environment itself is replaced by the result of f. Variables that are
+// resolved from the outer environment are copied as-is.
+func (e Environment) MapValues(f func(string) string) Environment {
+	if e.vars == nil {
+		return e
+	}
+
+	vars := make([]variable, len(e.vars))
+	for i, v := range e.vars {
+		vars[i].name = v.name
+		if v.value != nil {
+			value := f(*v.value)
+			vars[i].value = &value
+		}
+	}
+
+	return Environment{vars: vars}
+}
+
 // Equal verifies if two environments are equal.
 func (e Environment) Equal(other Environment) bool {
 	return cmp.Equal(e.mapify(), other.mapify())
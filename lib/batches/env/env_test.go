@@ -2,6 +2,7 @@ package env
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -291,3 +292,22 @@ func TestEnvironment_OuterVars(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironment_MapValues(t *testing.T) {
+	in := Environment{vars: []variable{
+		{name: "foo", value: pointers.Ptr("bar")},
+		{name: "quux", value: nil},
+	}}
+
+	have := in.MapValues(strings.ToUpper)
+	want := Environment{vars: []variable{
+		{name: "foo", value: pointers.Ptr("BAR")},
+		{name: "quux", value: nil},
+	}}
+	if !have.Equal(want) {
+		t.Errorf("unexpected environment: have=%v want=%v", have.mapify(), want.mapify())
+	}
+	if *in.vars[0].value != "bar" {
+		t.Errorf("original environment was modified: %q", *in.vars[0].value)
+	}
+}
@@ -0,0 +1,247 @@
+package batches
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// HasForeach returns true if the step is run once per element of a list.
+func (s *Step) HasForeach() bool {
+	return s.Foreach != nil
+}
+
+// HasForeachSteps returns true if any step of the batch spec is run once per element
+// of a list.
+func (spec *BatchSpec) HasForeachSteps() bool {
+	for i := range spec.Steps {
+		if spec.Steps[i].HasForeach() {
+			return true
+		}
+	}
+	return false
+}
+
+// ForeachItems returns the elements of the list the step is run for. Lists in the
+// batch spec are returned as-is, templates are rendered in the given context and
+// split on whitespace. It returns nil if the step has no foreach list.
+func (s *Step) ForeachItems(stepCtx *template.StepContext) ([]any, error) {
+	switch v := s.Foreach.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		return v, nil
+	case string:
+		var out bytes.Buffer
+		if err := template.RenderStepTemplate("step-foreach", v, &out, stepCtx); err != nil {
+			return nil, errors.Wrap(err, "parsing step foreach")
+		}
+
+		fields := strings.Fields(out.String())
+		items := make([]any, len(fields))
+		for i, field := range fields {
+			items[i] = field
+		}
+		return items, nil
+	default:
+		return nil, errors.Newf("invalid foreach value of type %T", v)
+	}
+}
+
+// ForeachStepContext returns a copy of the given step context for the element of the
+// step's foreach list at the given index.
+func ForeachStepContext(stepCtx template.StepContext, items []any, index int) template.StepContext {
+	stepCtx.Foreach = template.ForeachContext{
+		Item:  items[index],
+		Index: index,
+	}
+	return stepCtx
+}
+
+// SetForeachOutputs renders the outputs of a step that was run for each element of a
+// foreach list. Every output is rendered once per element, and the global outputs map
+// is set to the list of rendered values in the order of the elements.
+func SetForeachOutputs(stepOutputs Outputs, global map[string]any, stepCtxs []*template.StepContext) error {
+	aggregated := make(map[string][]any, len(stepOutputs))
+	for name := range stepOutputs {
+		aggregated[name] = make([]any, 0, len(stepCtxs))
+	}
+
+	for i, stepCtx := range stepCtxs {
+		item := make(map[string]any, len(stepOutputs))
+		if err := SetOutputs(stepOutputs, item, stepCtx); err != nil {
+			return errors.Wrapf(err, "setting outputs for foreach element %d", i)
+		}
+		for name, value := range item {
+			aggregated[name] = append(aggregated[name], value)
+		}
+	}
+
+	for name, values := range aggregated {
+		global[name] = values
+	}
+
+	return nil
+}
+
+// ExpandForeachSteps returns the steps with every step that has a foreach list
+// replaced by one step per element of the list, so that they can be executed by
+// src-cli, which runs every step once. References to foreach.item and
+// foreach.index in the run, env, files and outputs templates of a step are
+// replaced with the values of its element. If the step has outputs, a final step
+// aggregates the values of the elements into lists, like native execution does.
+//
+// The foreach lists are rendered in the given step context before any step is
+// executed, so they can only depend on the repository, the batch change and the
+// workspace path.
+func ExpandForeachSteps(steps []Step, stepCtx *template.StepContext) ([]Step, error) {
+	expanded := make([]Step, 0, len(steps))
+	for i, step := range steps {
+		if !step.HasForeach() {
+			expanded = append(expanded, step)
+			continue
+		}
+
+		if tmpl, ok := step.Foreach.(string); ok && dependsOnExecution(tmpl) {
+			return nil, errors.Newf("step %d: foreach depends on the results of previous steps and can only be used with native execution", i+1)
+		}
+		items, err := step.ForeachItems(stepCtx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "step %d", i+1)
+		}
+
+		for j := range items {
+			expanded = append(expanded, foreachElementStep(step, items, j))
+		}
+		if len(step.Outputs) > 0 {
+			expanded = append(expanded, foreachOutputsStep(step, len(items)))
+		}
+	}
+
+	return expanded, nil
+}
+
+var (
+	// templateActionRe matches the actions of a step template.
+	templateActionRe = regexp.MustCompile(`(?s)\$\{\{.*?\}\}`)
+	// foreachRefRe matches references to the foreach element in a template
+	// action, including field accesses on foreach.item.
+	foreachRefRe = regexp.MustCompile(`\bforeach\.(index\b|item((?:\.[A-Za-z_]\w*)*))`)
+	// executionRefRe matches references to values that are only known once
+	// previous steps have been executed.
+	executionRefRe = regexp.MustCompile(`\b(outputs|previous_step|step)\b|\bsteps\.(modified|added|deleted|renamed)_files\b`)
+)
+
+func dependsOnExecution(tmpl string) bool {
+	for _, action := range templateActionRe.FindAllString(tmpl, -1) {
+		if executionRefRe.MatchString(action) {
+			return true
+		}
+	}
+	return false
+}
+
+// foreachElementStep returns the step that runs the given step for the element
+// of items at the given index.
+func foreachElementStep(step Step, items []any, index int) Step {
+	substitute := func(tmpl string) string {
+		return templateActionRe.ReplaceAllStringFunc(tmpl, func(action string) string {
+			return foreachRefRe.ReplaceAllStringFunc(action, func(ref string) string {
+				if ref == "foreach.index" {
+					return strconv.Itoa(index)
+				}
+				return foreachLiteral(items[index], strings.Split(ref, ".")[2:])
+			})
+		})
+	}
+
+	element := step
+	element.Foreach = nil
+	element.Run = substitute(step.Run)
+	element.Env = step.Env.MapValues(substitute)
+	if step.Files != nil {
+		element.Files = make(map[string]string, len(step.Files))
+		for name, content := range step.Files {
+			element.Files[name] = substitute(content)
+		}
+	}
+	if step.Outputs != nil {
+		// Every element stores the raw value of the outputs under its own name,
+		// they are parsed and aggregated by foreachOutputsStep.
+		element.Outputs = make(Outputs, len(step.Outputs))
+		for name, output := range step.Outputs {
+			element.Outputs[foreachOutputName(name, index)] = Output{Value: substitute(output.Value)}
+		}
+	}
+
+	return element
+}
+
+// foreachLiteral returns the template literal for the field of item at the given
+// path.
+func foreachLiteral(item any, path []string) string {
+	for _, field := range path {
+		m, ok := item.(map[string]any)
+		if !ok {
+			item = nil
+			break
+		}
+		item = m[field]
+	}
+
+	switch v := item.(type) {
+	case string:
+		return strconv.Quote(v)
+	case int, int64, float64, bool:
+		return fmt.Sprint(v)
+	case nil:
+		return strconv.Quote("<no value>")
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
+
+// foreachOutputsStep returns the step that sets the outputs of the given step to
+// the lists of the values set by its elements.
+func foreachOutputsStep(step Step, elements int) Step {
+	outputs := make(Outputs, len(step.Outputs))
+	for name, output := range step.Outputs {
+		if elements == 0 {
+			outputs[name] = Output{Value: "[]", Format: "yaml"}
+			continue
+		}
+
+		var value strings.Builder
+		for i := 0; i < elements; i++ {
+			ref := fmt.Sprintf("(index outputs %s)", strconv.Quote(foreachOutputName(name, i)))
+			switch output.Format {
+			case "yaml", "json":
+				// JSON is valid YAML, so both are nested into the list by
+				// indenting them.
+				fmt.Fprintf(&value, "-\n  ${{ replace %s \"\\n\" \"\\n  \" }}\n", ref)
+			default:
+				// The escape sequences of Go are valid in double-quoted YAML
+				// strings.
+				fmt.Fprintf(&value, "- ${{ printf \"%%q\" %s }}\n", ref)
+			}
+		}
+		outputs[name] = Output{Value: value.String(), Format: "yaml"}
+	}
+
+	return Step{
+		Run:       "true",
+		Container: step.Container,
+		If:        step.If,
+		Outputs:   outputs,
+	}
+}
+
+func foreachOutputName(name string, index int) string {
+	return fmt.Sprintf("%s[%d]", name, index)
+}
@@ -0,0 +1,267 @@
+package batches
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/batches/env"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+)
+
+func TestParseBatchSpec_Foreach(t *testing.T) {
+	const spec = `
+name: hello-world
+on:
+  - repositoriesMatchingQuery: file:go.mod
+steps:
+  - run: cd ${{ foreach.item }} && go mod tidy
+    container: golang:1.20
+    foreach:
+      - service-a
+      - service-b
+  - run: echo ${{ foreach.item }}
+    container: alpine:3
+    foreach: ${{ repository.search_result_paths }}
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Tidy all modules
+`
+
+	parsed, err := ParseBatchSpec([]byte(spec))
+	if err != nil {
+		t.Fatalf("parsing valid spec returned error: %s", err)
+	}
+
+	if diff := cmp.Diff([]any{"service-a", "service-b"}, parsed.Steps[0].Foreach); diff != "" {
+		t.Errorf("unexpected foreach (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("${{ repository.search_result_paths }}", parsed.Steps[1].Foreach); diff != "" {
+		t.Errorf("unexpected foreach (-want +got):\n%s", diff)
+	}
+	if !parsed.HasForeachSteps() {
+		t.Error("expected spec to have foreach steps")
+	}
+	if (&BatchSpec{Steps: []Step{{Run: "echo", Container: "alpine:3"}}}).HasForeachSteps() {
+		t.Error("expected spec without foreach steps")
+	}
+}
+
+func TestStep_ForeachItems(t *testing.T) {
+	stepCtx := &template.StepContext{
+		Repository: template.Repository{
+			Name:        "github.com/sourcegraph/src-cli",
+			FileMatches: []string{"b/go.mod", "a/go.mod"},
+		},
+		Outputs: map[string]any{"dirs": "x y\nz"},
+	}
+
+	tests := []struct {
+		name    string
+		foreach any
+		want    []any
+	}{
+		{name: "no foreach", foreach: nil, want: nil},
+		{name: "list", foreach: []any{"a", 1, map[string]any{"b": "c"}}, want: []any{"a", 1, map[string]any{"b": "c"}}},
+		{name: "empty list", foreach: []any{}, want: []any{}},
+		{name: "template", foreach: "${{ repository.search_result_paths }}", want: []any{"a/go.mod", "b/go.mod"}},
+		{name: "template with outputs", foreach: "${{ outputs.dirs }}", want: []any{"x", "y", "z"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			step := Step{Foreach: tc.foreach}
+			have, err := step.ForeachItems(stepCtx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected items (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("invalid template", func(t *testing.T) {
+		step := Step{Foreach: "${{ unknown.field }}"}
+		if _, err := step.ForeachItems(stepCtx); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
+
+func TestSetForeachOutputs(t *testing.T) {
+	items := []any{"service-a", "service-b"}
+	base := template.StepContext{}
+
+	var stepCtxs []*template.StepContext
+	for i := range items {
+		stepCtx := ForeachStepContext(base, items, i)
+		stepCtx.Step = execution.AfterStepResult{Stdout: "ok " + items[i].(string)}
+		stepCtxs = append(stepCtxs, &stepCtx)
+	}
+
+	outputs := Outputs{
+		"results": {Value: "${{ step.stdout }}"},
+		"indexes": {Value: "${{ foreach.index }}"},
+	}
+	global := map[string]any{"previous": "value"}
+
+	if err := SetForeachOutputs(outputs, global, stepCtxs); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]any{
+		"previous": "value",
+		"results":  []any{"ok service-a", "ok service-b"},
+		"indexes":  []any{"0", "1"},
+	}
+	if diff := cmp.Diff(want, global); diff != "" {
+		t.Errorf("unexpected outputs (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpandForeachSteps(t *testing.T) {
+	stepCtx := &template.StepContext{
+		Repository: template.Repository{
+			Name:        "github.com/sourcegraph/src-cli",
+			FileMatches: []string{"b/go.mod", "a/go.mod"},
+		},
+	}
+
+	var stepEnv env.Environment
+	if err := json.Unmarshal([]byte(`{"DIR": "${{ foreach.item.dir }}"}`), &stepEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []Step{
+		{Run: "echo before", Container: "alpine:3"},
+		{
+			Run:       "cd ${{ foreach.item.dir }} && echo ${{ foreach.index }}",
+			Container: "golang:1.20",
+			Env:       stepEnv,
+			Files:     map[string]string{"name.txt": "${{ foreach.item.name }}"},
+			Outputs: Outputs{
+				"results": {Value: "${{ step.stdout }}"},
+				"parsed":  {Value: "dir: ${{ foreach.item.dir }}", Format: "yaml"},
+			},
+			Foreach: []any{
+				map[string]any{"dir": "a", "name": `say "hi"`},
+				map[string]any{"dir": "b", "name": "line\nbreak"},
+			},
+		},
+		{Run: "wc -l < ${{ foreach.item }}", Container: "alpine:3", Foreach: "${{ repository.search_result_paths }}"},
+	}
+
+	have, err := ExpandForeachSteps(steps, stepCtx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var runs []string
+	for _, step := range have {
+		if step.HasForeach() {
+			t.Errorf("expanded step still has foreach: %+v", step)
+		}
+		runs = append(runs, step.Run)
+	}
+	wantRuns := []string{
+		"echo before",
+		`cd ${{ "a" }} && echo ${{ 0 }}`,
+		`cd ${{ "b" }} && echo ${{ 1 }}`,
+		"true",
+		`wc -l < ${{ "a/go.mod" }}`,
+		`wc -l < ${{ "b/go.mod" }}`,
+	}
+	if diff := cmp.Diff(wantRuns, runs); diff != "" {
+		t.Fatalf("unexpected steps (-want +got):\n%s", diff)
+	}
+
+	resolved, err := have[2].Env.Resolve(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"DIR": `${{ "b" }}`}, resolved); diff != "" {
+		t.Errorf("unexpected env (-want +got):\n%s", diff)
+	}
+
+	// Execute the expanded steps like src-cli does and compare the outputs with the
+	// ones of native execution.
+	global := map[string]any{}
+	for _, step := range have[1:4] {
+		stepCtx := &template.StepContext{Outputs: global}
+
+		var run bytes.Buffer
+		if err := template.RenderStepTemplate("run", step.Run, &run, stepCtx); err != nil {
+			t.Fatal(err)
+		}
+		files, err := template.RenderStepMap(step.Files, stepCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stepCtx.Step = execution.AfterStepResult{Stdout: run.String() + " " + files["name.txt"]}
+		if err := SetOutputs(step.Outputs, global, stepCtx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := map[string]any{}
+	var stepCtxs []*template.StepContext
+	for i := range steps[1].Foreach.([]any) {
+		stepCtx := ForeachStepContext(template.StepContext{}, steps[1].Foreach.([]any), i)
+		var run bytes.Buffer
+		if err := template.RenderStepTemplate("run", steps[1].Run, &run, &stepCtx); err != nil {
+			t.Fatal(err)
+		}
+		files, err := template.RenderStepMap(steps[1].Files, &stepCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stepCtx.Step = execution.AfterStepResult{Stdout: run.String() + " " + files["name.txt"]}
+		stepCtxs = append(stepCtxs, &stepCtx)
+	}
+	if err := SetForeachOutputs(steps[1].Outputs, want, stepCtxs); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, value := range want {
+		if diff := cmp.Diff(value, global[name]); diff != "" {
+			t.Errorf("unexpected output %q (-want +got):\n%s", name, diff)
+		}
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		have, err := ExpandForeachSteps([]Step{{
+			Run:       "echo ${{ foreach.item }}",
+			Container: "alpine:3",
+			Outputs:   Outputs{"results": {Value: "${{ step.stdout }}"}},
+			Foreach:   []any{},
+		}}, stepCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 1 {
+			t.Fatalf("expected only the outputs step, got %d steps", len(have))
+		}
+
+		global := map[string]any{}
+		if err := SetOutputs(have[0].Outputs, global, &template.StepContext{Outputs: global}); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(map[string]any{"results": []any{}}, global); diff != "" {
+			t.Errorf("unexpected outputs (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("foreach depending on previous steps", func(t *testing.T) {
+		_, err := ExpandForeachSteps([]Step{{Run: "echo", Container: "alpine:3", Foreach: "${{ outputs.dirs }}"}}, stepCtx)
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
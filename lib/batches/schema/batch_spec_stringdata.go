@@ -217,6 +217,26 @@ const BatchSpecJSON = `{
               "${{ eq previous_step.stdout \"success\" }}"
             ]
           },
+          "foreach": {
+            "oneOf": [
+              {
+                "type": "array",
+                "items": {}
+              },
+              {
+                "type": "string"
+              },
+              {
+                "type": "null"
+              }
+            ],
+            "description": "Runs the step once per element of a list, in the same workspace and in order. Either a list of values or a template that renders to a whitespace-separated list. The current element and its index are available as foreach.item and foreach.index in the step's run, env, files and outputs. Outputs of the step are aggregated into lists with one value per element.",
+            "examples": [
+              ["service-a", "service-b"],
+              "${{ repository.search_result_paths }}",
+              "${{ join previous_step.modified_files \" \" }}"
+            ]
+          },
           "mount": {
             "description": "Files that are mounted to the Docker container.",
             "type": ["array", "null"],
@@ -237,6 +257,33 @@ const BatchSpecJSON = `{
                 }
               }
             }
+          },
+          "resources": {
+            "type": ["object", "null"],
+            "description": "The CPU and memory requests and limits of the container. Values are Kubernetes quantities. Only honored when the batch spec is executed server-side by executors running on Kubernetes.",
+            "additionalProperties": false,
+            "properties": {
+              "cpuRequest": {
+                "type": "string",
+                "description": "The requested CPU of the container.",
+                "examples": ["500m", "2"]
+              },
+              "cpuLimit": {
+                "type": "string",
+                "description": "The CPU limit of the container.",
+                "examples": ["1", "4"]
+              },
+              "memoryRequest": {
+                "type": "string",
+                "description": "The requested memory of the container.",
+                "examples": ["512Mi", "2Gi"]
+              },
+              "memoryLimit": {
+                "type": "string",
+                "description": "The memory limit of the container.",
+                "examples": ["1Gi", "8Gi"]
+              }
+            }
           }
         }
       }
@@ -190,6 +190,17 @@ type StepContext struct {
 	PreviousStep execution.AfterStepResult
 	// Repository is the Sourcegraph repository in which the steps are executed.
 	Repository Repository
+	// Foreach is the element of the step's foreach list that is currently being
+	// evaluated. Empty when the step has no foreach list.
+	Foreach ForeachContext
+}
+
+// ForeachContext is the element of a step's foreach list that a step is run for.
+type ForeachContext struct {
+	// Item is the current element of the list.
+	Item any
+	// Index is the index of Item in the list.
+	Index int
 }
 
 // ToFuncMap returns a template.FuncMap to access fields on the StepContext in a
@@ -246,6 +257,12 @@ func (stepCtx *StepContext) ToFuncMap() template.FuncMap {
 				"description": stepCtx.BatchChange.Description,
 			}
 		},
+		"foreach": func() map[string]any {
+			return map[string]any{
+				"item":  stepCtx.Foreach.Item,
+				"index": stepCtx.Foreach.Index,
+			}
+		},
 	}
 }
 
@@ -41,6 +41,9 @@ func UnmarshalJSON(data []byte) (IndexConfiguration, error) {
 	if err := jsonUnmarshal(string(data), &configuration); err != nil {
 		return IndexConfiguration{}, errors.Errorf("invalid JSON: %v", err)
 	}
+	if err := validateResources(configuration); err != nil {
+		return IndexConfiguration{}, err
+	}
 	return configuration, nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/executor"
 )
 
 const jsonTestInput = `
@@ -15,6 +17,7 @@ const jsonTestInput = `
 					// Comments are the future
 					"image": "go:latest",
 					"commands": ["go mod vendor"],
+					"resources": {"memoryLimit": "8Gi"},
 				}
 			],
 			"indexer": "lsif-go",
@@ -25,6 +28,7 @@ const jsonTestInput = `
 			"indexer": "scip-typescript",
 			"indexer_args": ["index", "--yarn-workspaces"],
 			"outfile": "lsif.dump",
+			"indexer_resources": {"cpuRequest": "2", "cpuLimit": "4"},
 		},
 	]
 }
@@ -41,9 +45,10 @@ func TestUnmarshalJSON(t *testing.T) {
 			{
 				Steps: []DockerStep{
 					{
-						Root:     "",
-						Image:    "go:latest",
-						Commands: []string{"go mod vendor"},
+						Root:      "",
+						Image:     "go:latest",
+						Commands:  []string{"go mod vendor"},
+						Resources: &executor.StepResources{MemoryLimit: "8Gi"},
 					},
 				},
 				Indexer:     "lsif-go",
@@ -55,6 +60,10 @@ func TestUnmarshalJSON(t *testing.T) {
 				Indexer:     "scip-typescript",
 				IndexerArgs: []string{"index", "--yarn-workspaces"},
 				Outfile:     "lsif.dump",
+				IndexerResources: &executor.StepResources{
+					CPURequest: "2",
+					CPULimit:   "4",
+				},
 			},
 		},
 	}
@@ -63,6 +72,22 @@ func TestUnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnmarshalJSONInvalidResources(t *testing.T) {
+	const input = `
+	{
+		"index_jobs": [
+			{
+				"indexer": "scip-typescript",
+				"indexer_resources": {"memoryRequest": "16Gi", "memoryLimit": "8Gi"},
+			},
+		]
+	}`
+
+	if _, err := UnmarshalJSON([]byte(input)); err == nil {
+		t.Fatalf("expected an error for a memory request exceeding the limit")
+	}
+}
+
 func TestJsonUnmarshal(t *testing.T) {
 	const input = `
 	{
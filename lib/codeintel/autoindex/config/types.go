@@ -1,6 +1,11 @@
 package config
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/executor"
+)
 
 type IndexConfiguration struct {
 	IndexJobs []IndexJob `json:"index_jobs" yaml:"index_jobs"`
@@ -14,6 +19,9 @@ type IndexJob struct {
 	IndexerArgs      []string     `json:"indexer_args" yaml:"indexer_args"`
 	Outfile          string       `json:"outfile" yaml:"outfile"`
 	RequestedEnvVars []string     `json:"requestedEnvVars" yaml:"requestedEnvVars"`
+
+	// IndexerResources are the resources of the container running the indexer.
+	IndexerResources *executor.StepResources `json:"indexer_resources,omitempty" yaml:"indexer_resources,omitempty"`
 }
 
 func (j IndexJob) GetRoot() string {
@@ -29,9 +37,32 @@ func (j IndexJob) GetIndexerName() string {
 }
 
 type DockerStep struct {
-	Root     string   `json:"root" yaml:"root"`
-	Image    string   `json:"image" yaml:"image"`
-	Commands []string `json:"commands" yaml:"commands"`
+	Root      string                  `json:"root" yaml:"root"`
+	Image     string                  `json:"image" yaml:"image"`
+	Commands  []string                `json:"commands" yaml:"commands"`
+	Resources *executor.StepResources `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// validateResources returns an error if the resources of any step of the configuration
+// are invalid.
+func validateResources(config IndexConfiguration) error {
+	var errs error
+	for i, job := range config.IndexJobs {
+		for j, step := range job.Steps {
+			if step.Resources != nil {
+				if err := step.Resources.Validate(); err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "index job %d: step %d resources", i+1, j+1))
+				}
+			}
+		}
+		if job.IndexerResources != nil {
+			if err := job.IndexerResources.Validate(); err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "index job %d: indexer resources", i+1))
+			}
+		}
+	}
+
+	return errs
 }
 
 type HintConfidence int
@@ -10,6 +10,9 @@ func UnmarshalYAML(data []byte) (IndexConfiguration, error) {
 	if err := yaml.Unmarshal(data, &configuration); err != nil {
 		return IndexConfiguration{}, errors.Errorf("invalid YAML: %v", err)
 	}
+	if err := validateResources(configuration); err != nil {
+		return IndexConfiguration{}, err
+	}
 
 	return configuration, nil
 }
@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"math/big"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// StepResources are the CPU and memory requests and limits of the container of a step,
+// as Kubernetes quantities (e.g. "500m", "2", "4Gi"). Values that are left empty fall back
+// to the defaults configured on the executor.
+//
+// The same type is used by batch specs, auto-indexing configuration, the records derived
+// from them and the jobs handed to executors.
+type StepResources struct {
+	CPURequest    string `json:"cpuRequest,omitempty" yaml:"cpuRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty" yaml:"cpuLimit,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty" yaml:"memoryRequest,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty" yaml:"memoryLimit,omitempty"`
+}
+
+// Validate returns an error if any of the values is not a valid quantity, or if a request
+// exceeds the corresponding limit.
+func (r StepResources) Validate() error {
+	var errs error
+	for _, pair := range []struct {
+		name           string
+		request, limit string
+	}{
+		{name: "cpu", request: r.CPURequest, limit: r.CPULimit},
+		{name: "memory", request: r.MemoryRequest, limit: r.MemoryLimit},
+	} {
+		request, err := parseQuantity(pair.request)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "invalid %sRequest", pair.name))
+		}
+		limit, err := parseQuantity(pair.limit)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "invalid %sLimit", pair.name))
+		}
+
+		if request != nil && limit != nil && request.Cmp(limit) > 0 {
+			errs = errors.Append(errs, errors.Newf("%sRequest %q exceeds %sLimit %q", pair.name, pair.request, pair.name, pair.limit))
+		}
+	}
+
+	return errs
+}
+
+var quantityPattern = regexp.MustCompile(`^([+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+))(?:([eE][+-]?[0-9]+)|(Ki|Mi|Gi|Ti|Pi|Ei|n|u|m|k|M|G|T|P|E))?$`)
+
+var quantitySuffixes = map[string]*big.Rat{
+	"n":  big.NewRat(1, 1_000_000_000),
+	"u":  big.NewRat(1, 1_000_000),
+	"m":  big.NewRat(1, 1_000),
+	"":   big.NewRat(1, 1),
+	"k":  big.NewRat(1_000, 1),
+	"M":  big.NewRat(1_000_000, 1),
+	"G":  big.NewRat(1_000_000_000, 1),
+	"T":  big.NewRat(1_000_000_000_000, 1),
+	"P":  big.NewRat(1_000_000_000_000_000, 1),
+	"E":  big.NewRat(1_000_000_000_000_000_000, 1),
+	"Ki": big.NewRat(1<<10, 1),
+	"Mi": big.NewRat(1<<20, 1),
+	"Gi": big.NewRat(1<<30, 1),
+	"Ti": big.NewRat(1<<40, 1),
+	"Pi": big.NewRat(1<<50, 1),
+	"Ei": big.NewRat(1<<60, 1),
+}
+
+// parseQuantity returns the value of the given Kubernetes quantity. An empty quantity is
+// returned as nil.
+func parseQuantity(quantity string) (*big.Rat, error) {
+	if quantity == "" {
+		return nil, nil
+	}
+
+	match := quantityPattern.FindStringSubmatch(quantity)
+	if match == nil {
+		return nil, errors.Newf("%q is not a valid quantity", quantity)
+	}
+
+	// big.Rat accepts decimal exponents as part of the number.
+	value, ok := new(big.Rat).SetString(match[1] + match[2])
+	if !ok {
+		return nil, errors.Newf("%q is not a valid quantity", quantity)
+	}
+	if value.Sign() < 0 {
+		return nil, errors.Newf("%q must not be negative", quantity)
+	}
+
+	return value.Mul(value, quantitySuffixes[match[3]]), nil
+}
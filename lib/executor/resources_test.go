@@ -0,0 +1,31 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepResourcesValidate(t *testing.T) {
+	for _, resources := range []StepResources{
+		{},
+		{CPURequest: "500m", CPULimit: "1", MemoryRequest: "512Mi", MemoryLimit: "1Gi"},
+		{CPURequest: "2", CPULimit: "2"},
+		{MemoryRequest: "1G", MemoryLimit: "1Gi"},
+		{MemoryRequest: "1e9", MemoryLimit: "1Gi"},
+		{CPURequest: "4"},
+		{MemoryLimit: "8Gi"},
+	} {
+		assert.NoError(t, resources.Validate(), "%+v", resources)
+	}
+
+	for _, resources := range []StepResources{
+		{CPURequest: "2", CPULimit: "1500m"},
+		{MemoryRequest: "1Gi", MemoryLimit: "1G"},
+		{CPURequest: "lots"},
+		{MemoryLimit: "4GB"},
+		{CPULimit: "-1"},
+	} {
+		assert.Error(t, resources.Validate(), "%+v", resources)
+	}
+}
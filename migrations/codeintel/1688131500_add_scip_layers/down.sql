@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS codeintel_scip_document_tombstones;
+DROP TABLE IF EXISTS codeintel_scip_layers;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS codeintel_scip_layers (
+    upload_id integer NOT NULL PRIMARY KEY,
+    base_upload_id integer NOT NULL,
+    depth integer NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS codeintel_scip_layers_base_upload_id ON codeintel_scip_layers(base_upload_id);
+
+COMMENT ON TABLE codeintel_scip_layers IS 'Associates a partial SCIP index with the upload it is layered on top of. Documents absent from the partial index are resolved from its base upload.';
+COMMENT ON COLUMN codeintel_scip_layers.upload_id IS 'The identifier of the upload that provided the partial SCIP index.';
+COMMENT ON COLUMN codeintel_scip_layers.base_upload_id IS 'The identifier of the upload the partial SCIP index is layered on top of.';
+COMMENT ON COLUMN codeintel_scip_layers.depth IS 'The number of layers below this one, bounding the number of uploads a document lookup may traverse.';
+
+CREATE TABLE IF NOT EXISTS codeintel_scip_document_tombstones (
+    upload_id integer NOT NULL,
+    document_path text NOT NULL,
+    PRIMARY KEY (upload_id, document_path)
+);
+
+COMMENT ON TABLE codeintel_scip_document_tombstones IS 'Hides documents of the layers below a partial SCIP index that were deleted or changed but not re-indexed at the commit of the partial index.';
+COMMENT ON COLUMN codeintel_scip_document_tombstones.upload_id IS 'The identifier of the upload that provided the partial SCIP index.';
+COMMENT ON COLUMN codeintel_scip_document_tombstones.document_path IS 'The path of the hidden document relative to the index root.';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS codeintel_dead_code_definitions;
+DROP TABLE IF EXISTS codeintel_dead_code_reports;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS codeintel_dead_code_reports (
+    upload_id integer NOT NULL PRIMARY KEY REFERENCES lsif_uploads(id) ON DELETE CASCADE,
+    computed_at timestamp with time zone NOT NULL DEFAULT NOW(),
+    num_definitions integer NOT NULL
+);
+
+COMMENT ON TABLE codeintel_dead_code_reports IS 'Tracks the uploads visible at the tip of the default branch of their repository for which unreferenced definitions have been computed.';
+COMMENT ON COLUMN codeintel_dead_code_reports.num_definitions IS 'The number of definitions of global symbols within the upload.';
+
+CREATE TABLE IF NOT EXISTS codeintel_dead_code_definitions (
+    id bigserial PRIMARY KEY,
+    upload_id integer NOT NULL REFERENCES codeintel_dead_code_reports(upload_id) ON DELETE CASCADE,
+    symbol_name text NOT NULL,
+    document_path text NOT NULL,
+    start_line integer NOT NULL,
+    start_character integer NOT NULL,
+    end_line integer NOT NULL,
+    end_character integer NOT NULL,
+    exported boolean NOT NULL
+);
+
+COMMENT ON TABLE codeintel_dead_code_definitions IS 'Definitions of global symbols that are not referenced by their own upload, nor by any upload visible at the tip of the default branch of its repository.';
+COMMENT ON COLUMN codeintel_dead_code_definitions.document_path IS 'The path of the document defining the symbol, relative to the root of the upload.';
+COMMENT ON COLUMN codeintel_dead_code_definitions.exported IS 'Whether the symbol belongs to a package provided by the upload, which other repositories can depend on.';
+
+CREATE INDEX IF NOT EXISTS codeintel_dead_code_definitions_upload_id_document_path ON codeintel_dead_code_definitions(upload_id, document_path);
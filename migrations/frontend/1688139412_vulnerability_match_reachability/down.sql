@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vulnerability_matches_unanalyzed;
+
+ALTER TABLE vulnerability_matches
+DROP COLUMN IF EXISTS reachability,
+DROP COLUMN IF EXISTS reachability_call_sites;
@@ -0,0 +1,8 @@
+ALTER TABLE vulnerability_matches
+ADD COLUMN IF NOT EXISTS reachability text,
+ADD COLUMN IF NOT EXISTS reachability_call_sites jsonb NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN vulnerability_matches.reachability IS 'Whether or not the index references a symbol affected by the vulnerability (reachable, unreachable, or unknown). Null if the match has not yet been analyzed.';
+COMMENT ON COLUMN vulnerability_matches.reachability_call_sites IS 'A sample of the locations in the index that reference a symbol affected by the vulnerability.';
+
+CREATE INDEX IF NOT EXISTS vulnerability_matches_unanalyzed ON vulnerability_matches(id) WHERE reachability IS NULL;
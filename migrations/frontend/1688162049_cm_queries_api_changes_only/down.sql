@@ -0,0 +1,2 @@
+ALTER TABLE cm_queries
+DROP COLUMN IF EXISTS api_changes_only;
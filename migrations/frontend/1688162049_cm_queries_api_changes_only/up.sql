@@ -0,0 +1,4 @@
+ALTER TABLE cm_queries
+ADD COLUMN IF NOT EXISTS api_changes_only boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN cm_queries.api_changes_only IS 'Whether the trigger only fires for matched commits that change the public API of the repository according to its precise code intelligence indexes.';
@@ -566,7 +566,7 @@ type Completions struct {
 	CompletionModelMaxTokens int `json:"completionModelMaxTokens,omitempty"`
 	// Enabled description: DEPRECATED. Use cody.enabled instead to turn Cody on/off.
 	Enabled *bool `json:"enabled,omitempty"`
-	// Endpoint description: The endpoint under which to reach the provider. Currently only used for provider types "sourcegraph", "openai" and "anthropic". The default values are "https://cody-gateway.sourcegraph.com", "https://api.openai.com/v1/chat/completions", and "https://api.anthropic.com/v1/complete" for Sourcegraph, OpenAI, and Anthropic, respectively.
+	// Endpoint description: The endpoint under which to reach the provider. The default values are "https://cody-gateway.sourcegraph.com", "https://api.openai.com/v1/chat/completions", and "https://api.anthropic.com/v1/complete" for Sourcegraph, OpenAI, and Anthropic, respectively. For the local provider, this is the base URL of the OpenAI-compatible API of the model server, such as "http://vllm:8000/v1", and has no default.
 	Endpoint string `json:"endpoint,omitempty"`
 	// FastChatModel description: The model used for fast chat completions.
 	FastChatModel string `json:"fastChatModel,omitempty"`
@@ -578,10 +578,30 @@ type Completions struct {
 	PerUserCodeCompletionsDailyLimit int `json:"perUserCodeCompletionsDailyLimit,omitempty"`
 	// PerUserDailyLimit description: If > 0, enables the maximum number of completions requests allowed to be made by a single user account in a day. On instances that allow anonymous requests, the rate limit is enforced by IP.
 	PerUserDailyLimit int `json:"perUserDailyLimit,omitempty"`
-	// Provider description: The external completions provider. Defaults to 'sourcegraph'.
+	// PromptTemplates description: Prompt templates for models of the local provider. Requests to a model with a template are sent to the text completions API with a prompt rendered from the template. Requests to other models are sent to the chat completions API, and the model server applies its own chat template.
+	PromptTemplates []*CompletionsPromptTemplate `json:"promptTemplates,omitempty"`
+	// Provider description: The external completions provider. Defaults to 'sourcegraph'. Use local for a self-hosted model server exposing an OpenAI-compatible API, which requires endpoint and chatModel to be set.
 	Provider string `json:"provider,omitempty"`
 }
 
+// CompletionsPromptTemplate description: The prompt format of a model served by the local provider.
+type CompletionsPromptTemplate struct {
+	// AssistantMessagePrefix description: The text inserted before each assistant message. The prompt always ends with this prefix, so that the model responds as the assistant.
+	AssistantMessagePrefix string `json:"assistantMessagePrefix,omitempty"`
+	// AssistantMessageSuffix description: The text inserted after each assistant message, except the last one.
+	AssistantMessageSuffix string `json:"assistantMessageSuffix,omitempty"`
+	// ContextWindow description: The maximum number of tokens of the prompt and completion combined. If set, the number of tokens to sample is reduced to fit the prompt into the context window.
+	ContextWindow int `json:"contextWindow,omitempty"`
+	// HumanMessagePrefix description: The text inserted before each human message.
+	HumanMessagePrefix string `json:"humanMessagePrefix,omitempty"`
+	// HumanMessageSuffix description: The text inserted after each human message.
+	HumanMessageSuffix string `json:"humanMessageSuffix,omitempty"`
+	// Model description: The name of the model the template applies to.
+	Model string `json:"model"`
+	// StopSequences description: Additional sequences that end a completion, such as the end of turn token of the model.
+	StopSequences []string `json:"stopSequences,omitempty"`
+}
+
 // CustomGitFetchMapping description: Mapping from Git clone URl domain/path to git fetch command. The `domainPath` field contains the Git clone URL domain/path part. The `fetch` field contains the custom git fetch command.
 type CustomGitFetchMapping struct {
 	// DomainPath description: Git clone URL domain/path
@@ -630,6 +650,10 @@ type EmailTemplates struct {
 type Embeddings struct {
 	// AccessToken description: The access token used to authenticate with the external embedding API service. For provider sourcegraph, this is optional.
 	AccessToken string `json:"accessToken,omitempty"`
+	// ApiFormat description: The API spoken by the endpoint of the local provider. Use openai for servers exposing an OpenAI-compatible embeddings API, and tei for Hugging Face Text Embeddings Inference servers.
+	ApiFormat string `json:"apiFormat,omitempty"`
+	// BatchSize description: The maximum number of chunks sent to the provider in a single embeddings request. Defaults to 512.
+	BatchSize int `json:"batchSize,omitempty"`
 	// Dimensions description: The dimensionality of the embedding vectors. Required field if not using the sourcegraph provider.
 	Dimensions int `json:"dimensions,omitempty"`
 	// Enabled description: Toggles whether embedding service is enabled.
@@ -638,10 +662,14 @@ type Embeddings struct {
 	Endpoint string `json:"endpoint,omitempty"`
 	// ExcludedFilePathPatterns description: A list of glob patterns that match file paths you want to exclude from embeddings. This is useful to exclude files with low information value (e.g., SVG files, test fixtures, mocks, auto-generated files, etc.).
 	ExcludedFilePathPatterns []string `json:"excludedFilePathPatterns,omitempty"`
+	// FullIndexInterval description: The maximum time between full (non-incremental) indexes of a repository when incremental embeddings are enabled. Periodically re-embedding the whole repository ensures that errors do not accumulate in incrementally updated indexes. Valid time units are "s", "m", "h". Example values: "72h", "168h".
+	FullIndexInterval string `json:"fullIndexInterval,omitempty"`
 	// Incremental description: Whether to generate embeddings incrementally. If true, only files that have changed since the last run will be processed.
 	Incremental *bool `json:"incremental,omitempty"`
 	// MaxCodeEmbeddingsPerRepo description: The maximum number of embeddings for code files to generate per repo
 	MaxCodeEmbeddingsPerRepo int `json:"maxCodeEmbeddingsPerRepo,omitempty"`
+	// MaxInputTokens description: The maximum number of tokens the model accepts for a single input. Files are split into smaller chunks when this is below the default chunk size, and longer inputs are truncated by the local provider.
+	MaxInputTokens int `json:"maxInputTokens,omitempty"`
 	// MaxTextEmbeddingsPerRepo description: The maximum number of embeddings for text files to generate per repo
 	MaxTextEmbeddingsPerRepo int `json:"maxTextEmbeddingsPerRepo,omitempty"`
 	// MinimumInterval description: The time to wait between runs. Valid time units are "s", "m", "h". Example values: "30s", "5m", "1h".
@@ -650,7 +678,7 @@ type Embeddings struct {
 	Model string `json:"model,omitempty"`
 	// PolicyRepositoryMatchLimit description: The maximum number of repositories that can be matched by a global embeddings policy
 	PolicyRepositoryMatchLimit *int `json:"policyRepositoryMatchLimit,omitempty"`
-	// Provider description: The provider to use for generating embeddings. Defaults to sourcegraph.
+	// Provider description: The provider to use for generating embeddings. Defaults to sourcegraph. Use local for a self-hosted model server, which requires endpoint and dimensions to be set.
 	Provider string `json:"provider,omitempty"`
 	// Url description: The url to the external embedding API service. Deprecated, use endpoint instead.
 	Url string `json:"url,omitempty"`
@@ -764,6 +792,16 @@ type ExcludedGitLabProject struct {
 	// Pattern description: Regular expression which matches against the name of a GitLab project ("group/name").
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedGiteaRepo struct {
+	// Archived description: If set to true, archived repositories will be excluded.
+	Archived bool `json:"archived,omitempty"`
+	// Forks description: If set to true, forks will be excluded.
+	Forks bool `json:"forks,omitempty"`
+	// Name description: The name of a Gitea repository ("owner/name") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Gitea repository ("owner/name").
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedGitoliteRepo struct {
 	// Name description: The name of a Gitolite repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1273,6 +1311,42 @@ type GitRecorder struct {
 	Size int `json:"size,omitempty"`
 }
 
+// GiteaAuthorization description: If non-null, enforces Gitea repository permissions. Sourcegraph users are matched to Gitea users by username, and the configured token must belong to a Gitea site admin.
+type GiteaAuthorization struct {
+}
+
+// GiteaConnection description: Configuration for a connection to Gitea or Forgejo.
+type GiteaConnection struct {
+	// Authorization description: If non-null, enforces Gitea repository permissions. Sourcegraph users are matched to Gitea users by username, and the configured token must belong to a Gitea site admin.
+	Authorization *GiteaAuthorization `json:"authorization,omitempty"`
+	// Exclude description: A list of repositories to never mirror from this Gitea instance.
+	Exclude []*ExcludedGiteaRepo `json:"exclude,omitempty"`
+	// GitURLType description: The type of Git URLs to use for cloning and fetching Git repositories on this Gitea instance.
+	//
+	// If "http", Sourcegraph will access Gitea repositories using Git URLs of the form http(s)://gitea.example.com/myorg/myrepo.git (using https: if the Gitea instance uses HTTPS).
+	//
+	// If "ssh", Sourcegraph will access Gitea repositories using Git URLs of the form git@gitea.example.com:myorg/myrepo.git. See the documentation for how to provide SSH private keys and known_hosts: https://docs.sourcegraph.com/admin/repo/auth#repositories-that-need-http-s-or-ssh-authentication.
+	GitURLType string `json:"gitURLType,omitempty"`
+	// Orgs description: An array of organization names identifying Gitea organizations whose repositories should be mirrored on Sourcegraph.
+	Orgs []string `json:"orgs,omitempty"`
+	// RateLimit description: Rate limit applied when making API requests to Gitea.
+	RateLimit *GiteaRateLimit `json:"rateLimit,omitempty"`
+	// Repos description: An array of repository "owner/name" strings specifying which Gitea repositories to mirror on Sourcegraph. If neither `orgs` nor `repos` is set, all repositories visible to the token are mirrored.
+	Repos []string `json:"repos,omitempty"`
+	// Token description: An access token for the Gitea instance with the read:repository and read:organization scopes. To sync permissions, the token must belong to a site admin, so that Sourcegraph can act on behalf of other users.
+	Token string `json:"token"`
+	// Url description: URL of a Gitea or Forgejo instance, such as https://gitea.example.com.
+	Url string `json:"url"`
+}
+
+// GiteaRateLimit description: Rate limit applied when making API requests to Gitea.
+type GiteaRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // Github description: GitHub configuration, both for queries and receiving release webhooks.
 type Github struct {
 	// Repository description: The repository to get the latest version of.
@@ -2394,6 +2468,8 @@ type SiteConfiguration struct {
 	BatchChangesEnabled *bool `json:"batchChanges.enabled,omitempty"`
 	// BatchChangesEnforceForks description: When enabled, all branches created by batch changes will be pushed to forks of the original repository.
 	BatchChangesEnforceForks bool `json:"batchChanges.enforceForks,omitempty"`
+	// BatchChangesRebaseOnBaseChange description: When enabled, published changesets are rebased onto the new head of their base branch when it changes files the changeset touches: the stored changeset diff is re-applied and force-pushed, and changesets whose diff no longer applies are marked as failed with the list of conflicting files.
+	BatchChangesRebaseOnBaseChange bool `json:"batchChanges.rebaseOnBaseChange,omitempty"`
 	// BatchChangesRestrictToAdmins description: When enabled, only site admins can create and apply batch changes.
 	BatchChangesRestrictToAdmins *bool `json:"batchChanges.restrictToAdmins,omitempty"`
 	// BatchChangesRolloutWindows description: Specifies specific windows, which can have associated rate limits, to be used when reconciling published changesets (creating or updating). All days and times are handled in UTC.
@@ -2420,6 +2496,8 @@ type SiteConfiguration struct {
 	CodeIntelRankingDocumentReferenceCountsEnabled *bool `json:"codeIntelRanking.documentReferenceCountsEnabled,omitempty"`
 	// CodeIntelRankingDocumentReferenceCountsGraphKey description: An arbitrary identifier used to group calculated rankings from SCIP data (including the SCIP export).
 	CodeIntelRankingDocumentReferenceCountsGraphKey string `json:"codeIntelRanking.documentReferenceCountsGraphKey,omitempty"`
+	// CodeIntelRankingSignalWeights description: Weights used to blend ranking signals into document ranks. Supported signals are referenceCount (default weight 1), recentActivity, recentViews, recentContributors, and fileSize (default weight 0). Negative weights penalize high signal values.
+	CodeIntelRankingSignalWeights map[string]float64 `json:"codeIntelRanking.signalWeights,omitempty"`
 	// CodeIntelRankingStaleResultsAge description: The interval at which to run the reduce job that computes document reference counts. Default is 24hrs.
 	CodeIntelRankingStaleResultsAge int `json:"codeIntelRanking.staleResultsAge,omitempty"`
 	// CodyEnabled description: Enable or disable Cody instance-wide. When Cody is disabled, all Cody endpoints and GraphQL queries will return errors, Cody will not show up in the site-admin sidebar, and Cody in the global navbar will only show a call-to-action for site-admins to enable Cody.
@@ -2663,6 +2741,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "batchChanges.disableWebhooksWarning")
 	delete(m, "batchChanges.enabled")
 	delete(m, "batchChanges.enforceForks")
+	delete(m, "batchChanges.rebaseOnBaseChange")
 	delete(m, "batchChanges.restrictToAdmins")
 	delete(m, "batchChanges.rolloutWindows")
 	delete(m, "branding")
@@ -2795,6 +2874,8 @@ type Step struct {
 	Env any `json:"env,omitempty"`
 	// Files description: Files that should be mounted into or be created inside the Docker container.
 	Files map[string]string `json:"files,omitempty"`
+	// Foreach description: Runs the step once per element of a list, in the same workspace and in order. Either a list of values or a template that renders to a whitespace-separated list. The current element and its index are available as foreach.item and foreach.index in the step's run, env, files and outputs. Outputs of the step are aggregated into lists with one value per element.
+	Foreach any `json:"foreach,omitempty"`
 	// If description: A condition to check before executing steps. Supports templating. The value 'true' is interpreted as true.
 	If any `json:"if,omitempty"`
 	// Mount description: Files that are mounted to the Docker container.